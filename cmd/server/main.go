@@ -20,6 +20,10 @@ import (
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 // @securityDefinitions.basic BasicAuth
+// @securityDefinitions.apikey WebhookSecret
+// @in header
+// @name X-Webhook-Secret
+// @description Webhook secret, sent by the open finance provider as a custom header.
 func main() {
 	v := validator.New()
 	e := config.LoadConfig(v)
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/worker"
+	"github.com/danielmesquitta/api-finance-manager/internal/config"
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+)
+
+func main() {
+	v := validator.New()
+	e := config.LoadConfig(v)
+
+	var app *worker.App
+	switch e.Environment {
+	case env.EnvironmentProduction:
+		app = worker.NewProd(v, e)
+
+	case env.EnvironmentTest:
+		app = worker.NewTest(v, e)
+
+	case env.EnvironmentStaging:
+		app = worker.NewStaging(v, e)
+
+	default:
+		app = worker.NewDev(v, e)
+	}
+
+	app.Start()
+	slog.Info("worker: started")
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	slog.Info("worker: shutting down, waiting for running jobs")
+	<-app.Stop().Done()
+}
@@ -6,3 +6,4 @@ package root
 //go:generate prisma-go-tools entities --schema ./sql/schema.prisma --output ./internal/domain/entity
 //go:generate prisma-go-tools tables --schema ./sql/schema.prisma --output ./internal/provider/db/schema
 //go:generate wire-config -c internal/config/wire/wire.go -o internal/app/server/wire.go -m github.com/danielmesquitta/api-finance-manager/internal/app/server -e dev,staging,test,prod
+//go:generate wire-config -c internal/config/wire/worker/wire.go -o internal/app/worker/wire.go -m github.com/danielmesquitta/api-finance-manager/internal/app/worker -e dev,staging,test,prod
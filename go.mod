@@ -18,6 +18,7 @@ require (
 	github.com/jinzhu/copier v0.4.0
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/redis/go-redis/v9 v9.7.3
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/sashabaranov/go-openai v1.38.1
 	github.com/spf13/viper v1.20.1
 	github.com/stretchr/testify v1.10.0
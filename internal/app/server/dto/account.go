@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
 )
 
@@ -11,3 +12,35 @@ type CreateAccountsRequest struct {
 type GetAccountsBalanceResponse struct {
 	account.GetAccountsBalanceUseCaseOutput
 }
+
+type GetAccountsBalanceHistoryResponse struct {
+	account.GetAccountsBalanceHistoryUseCaseOutput
+}
+
+type GetAccountsSyncStatusResponse struct {
+	account.GetAccountsSyncStatusUseCaseOutput
+}
+
+type ListCreditCardBillsResponse struct {
+	account.ListCreditCardBillsUseCaseOutput
+}
+
+type GetInstallmentCommitmentsResponse struct {
+	account.GetInstallmentCommitmentsUseCaseOutput
+}
+
+type ListManualAccountsResponse struct {
+	account.ListManualAccountsUseCaseOutput
+}
+
+type CreateManualAccountRequest struct {
+	account.CreateManualAccountUseCaseInput
+}
+
+type CreateManualAccountResponse struct {
+	entity.ManualAccount
+}
+
+type UpdateManualAccountRequest struct {
+	account.UpdateManualAccountUseCaseInput
+}
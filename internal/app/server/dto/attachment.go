@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+type UploadAttachmentResponse struct {
+	entity.Attachment
+}
+
+type ListAttachmentsResponse struct {
+	Items []entity.Attachment `json:"items"`
+}
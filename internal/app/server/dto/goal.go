@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+)
+
+type CreateGoalRequest struct {
+	goal.CreateGoalUseCaseInput
+}
+
+type CreateGoalResponse struct {
+	entity.Goal
+}
+
+type UpdateGoalRequest struct {
+	goal.UpdateGoalUseCaseInput
+}
+
+type GetGoalResponse struct {
+	goal.GoalProgress
+}
+
+type ListGoalsResponse struct {
+	goal.ListGoalsUseCaseOutput
+}
+
+type CreateGoalContributionRequest struct {
+	goal.CreateGoalContributionUseCaseInput
+}
+
+type CreateGoalContributionResponse struct {
+	entity.GoalContribution
+}
+
+type ListGoalContributionsResponse struct {
+	goal.ListGoalContributionsUseCaseOutput
+}
@@ -0,0 +1,9 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/imports"
+)
+
+type ImportTransactionsResponse struct {
+	imports.ImportTransactionsUseCaseOutput
+}
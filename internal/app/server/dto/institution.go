@@ -2,8 +2,25 @@ package dto
 
 import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
 )
 
 type ListInstitutionsResponse struct {
 	entity.PaginatedList[entity.Institution]
 }
+
+type ListUserInstitutionsResponse struct {
+	entity.PaginatedList[entity.FullInstitution]
+}
+
+type CreateConnectTokenRequest struct {
+	institution.CreateConnectTokenUseCaseInput
+}
+
+type CreateConnectTokenResponse struct {
+	institution.CreateConnectTokenUseCaseOutput
+}
+
+type HandleOpenFinanceWebhookRequest struct {
+	institution.HandleOpenFinanceWebhookUseCaseInput
+}
@@ -0,0 +1,9 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
+)
+
+type ListMerchantsResponse struct {
+	merchant.ListMerchantsUseCaseOutput
+}
@@ -0,0 +1,9 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+)
+
+type ListRecurringSeriesResponse struct {
+	recurring.ListRecurringSeriesUseCaseOutput
+}
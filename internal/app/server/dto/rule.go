@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+)
+
+type CreateRuleRequest struct {
+	rule.CreateRuleUseCaseInput
+}
+
+type CreateRuleResponse struct {
+	entity.Rule
+}
+
+type UpdateRuleRequest struct {
+	rule.UpdateRuleUseCaseInput
+}
+
+type GetRuleResponse struct {
+	entity.Rule
+}
+
+type ListRulesResponse struct {
+	entity.PaginatedList[entity.Rule]
+}
+
+type ApplyRulesResponse struct {
+	rule.ApplyRulesUseCaseOutput
+}
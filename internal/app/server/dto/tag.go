@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
+)
+
+type CreateTagRequest struct {
+	tag.CreateTagUseCaseInput
+}
+
+type CreateTagResponse struct {
+	entity.Tag
+}
+
+type UpdateTagRequest struct {
+	tag.UpdateTagUseCaseInput
+}
+
+type ListTagsResponse struct {
+	entity.PaginatedList[entity.Tag]
+}
@@ -20,3 +20,23 @@ type UpdateTransactionRequest struct {
 type CreateTransactionRequest struct {
 	transaction.CreateTransactionUseCaseInput
 }
+
+type SplitTransactionRequest struct {
+	transaction.SplitTransactionUseCaseInput
+}
+
+type SplitTransactionResponse struct {
+	Splits []entity.TransactionSplit `json:"splits"`
+}
+
+type BulkUpdateTransactionsRequest struct {
+	transaction.BulkUpdateTransactionsUseCaseInput
+}
+
+type BulkUpdateTransactionsResponse struct {
+	transaction.BulkUpdateTransactionsUseCaseOutput
+}
+
+type ListDeletedTransactionsResponse struct {
+	transaction.ListDeletedTransactionsUseCaseOutput
+}
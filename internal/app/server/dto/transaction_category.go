@@ -2,8 +2,29 @@ package dto
 
 import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
 )
 
 type ListTransactionCategoriesResponse struct {
 	entity.PaginatedList[entity.TransactionCategory]
 }
+
+type CreateTransactionCategoryRequest struct {
+	transactioncategory.CreateTransactionCategoryUseCaseInput
+}
+
+type CreateTransactionCategoryResponse struct {
+	entity.TransactionCategory
+}
+
+type UpdateTransactionCategoryRequest struct {
+	transactioncategory.UpdateTransactionCategoryUseCaseInput
+}
+
+type HideTransactionCategoryRequest struct {
+	transactioncategory.HideTransactionCategoryUseCaseInput
+}
+
+type MergeTransactionCategoriesRequest struct {
+	transactioncategory.MergeTransactionCategoriesUseCaseInput
+}
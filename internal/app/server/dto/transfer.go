@@ -0,0 +1,9 @@
+package dto
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
+)
+
+type ListTransfersResponse struct {
+	transfer.ListTransfersUseCaseOutput
+}
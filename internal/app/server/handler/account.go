@@ -3,8 +3,11 @@ package handler
 import (
 	"net/http"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -12,35 +15,60 @@ type AccountHandler struct {
 	ca  *account.CreateAccountsUseCase
 	gab *account.GetAccountsBalanceUseCase
 	sab *account.SyncAccountsBalancesUseCase
+	gss *account.GetAccountsSyncStatusUseCase
+	lcb *account.ListCreditCardBillsUseCase
+	gic *account.GetInstallmentCommitmentsUseCase
+	lma *account.ListManualAccountsUseCase
+	cma *account.CreateManualAccountUseCase
+	uma *account.UpdateManualAccountUseCase
+	dma *account.DeleteManualAccountUseCase
+	gbh *account.GetAccountsBalanceHistoryUseCase
 }
 
 func NewAccountHandler(
 	ca *account.CreateAccountsUseCase,
 	gab *account.GetAccountsBalanceUseCase,
 	sab *account.SyncAccountsBalancesUseCase,
+	gss *account.GetAccountsSyncStatusUseCase,
+	lcb *account.ListCreditCardBillsUseCase,
+	gic *account.GetInstallmentCommitmentsUseCase,
+	lma *account.ListManualAccountsUseCase,
+	cma *account.CreateManualAccountUseCase,
+	uma *account.UpdateManualAccountUseCase,
+	dma *account.DeleteManualAccountUseCase,
+	gbh *account.GetAccountsBalanceHistoryUseCase,
 ) *AccountHandler {
 	return &AccountHandler{
 		ca:  ca,
 		gab: gab,
 		sab: sab,
+		gss: gss,
+		lcb: lcb,
+		gic: gic,
+		lma: lma,
+		cma: cma,
+		uma: uma,
+		dma: dma,
+		gbh: gbh,
 	}
 }
 
 // @Summary Sync accounts from open finance
 // @Description Webhook to sync user accounts from open finance
 // @Tags Account
-// @Security BasicAuth
+// @Security WebhookSecret
 // @Accept json
 // @Produce json
 // @Param request body dto.CreateAccountsRequest true "Request body"
 // @Success 204
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /v1/admin/accounts [post]
+// @Router /v1/webhooks/open-finance/accounts [post]
 func (h *AccountHandler) Create(c *fiber.Ctx) error {
 	in := account.CreateAccountsUseCaseInput{}
 	if err := c.BodyParser(&in); err != nil {
-		return errs.New(err)
+		return errs.ErrInvalidBody
 	}
 
 	ctx := c.UserContext()
@@ -61,8 +89,11 @@ func (h *AccountHandler) Create(c *fiber.Ctx) error {
 // @Param start_date query string false "Start date" format(date)
 // @Param end_date query string false "End date" format(date)
 // @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
 // @Param category_ids query []string false "Category IDs"
 // @Param payment_method_ids query []string false "Payment method IDs"
+// @Param tag_ids query []string false "Tag IDs"
+// @Param merchant_ids query []string false "Merchant IDs"
 // @Param is_expense query bool false "Filter only expenses"
 // @Param is_income query bool false "Filter only incomes"
 // @Param is_ignored query bool false "Filter ignored or not ignored transactions"
@@ -95,6 +126,70 @@ func (h *AccountHandler) GetBalance(c *fiber.Ctx) error {
 	return c.JSON(out)
 }
 
+// @Summary Get accounts balance history
+// @Description Gets the net worth and the balance of each account or institution at the end of each day, week or month, with credit card debt subtracted
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param interval query string false "Interval" Enums(day, week, month)
+// @Param group_by query string false "Group series by" Enums(account, institution)
+// @Param start_date query string false "Start date" format(date)
+// @Param end_date query string false "End date" format(date)
+// @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
+// @Success 200 {object} dto.GetAccountsBalanceHistoryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/balances/history [get]
+func (h *AccountHandler) GetBalanceHistory(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	startDate, err := parseDateQueryParam(c, QueryParamStartDate)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	endDate, err := parseDateQueryParam(c, QueryParamEndDate)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	institutionIDs, err := parseUUIDQueryParams(c, QueryParamInstitutionIDs)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	accountIDs, err := parseUUIDQueryParams(c, QueryParamAccountIDs)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.GetAccountsBalanceHistoryUseCaseInput{
+		AccountBalanceOptions: repo.AccountBalanceOptions{
+			InstitutionIDs: institutionIDs,
+			AccountIDs:     accountIDs,
+		},
+		UserID:    userID,
+		Interval:  entity.BalanceInterval(c.Query(QueryParamInterval)),
+		GroupBy:   entity.BalanceHistoryGroupBy(c.Query(QueryParamGroupBy)),
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gbh.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
 // @Summary Sync account balances from open finance
 // @Description Sync account balances from open finance
 // @Tags Account
@@ -106,7 +201,275 @@ func (h *AccountHandler) GetBalance(c *fiber.Ctx) error {
 // @Router /v1/admin/accounts/balances/sync [post]
 func (h *AccountHandler) Sync(c *fiber.Ctx) error {
 	ctx := c.UserContext()
-	if err := h.sab.Execute(ctx); err != nil {
+	in := account.SyncAccountsBalancesUseCaseInput{}
+	if _, err := h.sab.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Get accounts sync status
+// @Description Gets the outcome of the latest sync of each user account
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetAccountsSyncStatusResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/sync-status [get]
+func (h *AccountHandler) GetSyncStatus(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.GetAccountsSyncStatusUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gss.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Get user accounts sync status
+// @Description Gets the outcome of the latest sync of each account of a user
+// @Tags Account
+// @Security BasicAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID" format(uuid)
+// @Success 200 {object} dto.GetAccountsSyncStatusResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/admin/users/{user_id}/accounts/sync-status [get]
+func (h *AccountHandler) GetUserSyncStatus(c *fiber.Ctx) error {
+	userID, err := parseUUIDPathParam(c, pathParamUserID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.GetAccountsSyncStatusUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gss.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary List credit card bills
+// @Description Lists the bills of a credit card account and its installments still to be charged per month
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "Account ID" format(uuid)
+// @Success 200 {object} dto.ListCreditCardBillsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/{account_id}/bills [get]
+func (h *AccountHandler) ListBills(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	accountID, err := parseUUIDPathParam(c, pathParamAccountID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.ListCreditCardBillsUseCaseInput{
+		UserID:    userID,
+		AccountID: accountID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lcb.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Get installment commitments
+// @Description Gets the credit card installments still to be charged, grouped by month
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetInstallmentCommitmentsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/installments [get]
+func (h *AccountHandler) GetInstallmentCommitments(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.GetInstallmentCommitmentsUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gic.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary List manual accounts
+// @Description Lists the accounts kept by the user, like the wallet, with their balances
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListManualAccountsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/manual [get]
+func (h *AccountHandler) ListManual(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.ListManualAccountsUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lma.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Create manual account
+// @Description Creates an account kept by the user, like the wallet, whose balance is the opening balance plus its transactions
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateManualAccountRequest true "Request body"
+// @Success 201 {object} dto.CreateManualAccountResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/manual [post]
+func (h *AccountHandler) CreateManual(c *fiber.Ctx) error {
+	in := account.CreateManualAccountUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.cma.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.CreateManualAccountResponse{
+		ManualAccount: *out,
+	})
+}
+
+// @Summary Update manual account
+// @Description Updates the name and opening balance of a manual account
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "Account ID" format(uuid)
+// @Param request body dto.UpdateManualAccountRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/manual/{account_id} [put]
+func (h *AccountHandler) UpdateManual(c *fiber.Ctx) error {
+	in := account.UpdateManualAccountUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	accountID, err := parseUUIDPathParam(c, pathParamAccountID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = accountID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.uma.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Delete manual account
+// @Description Deletes a manual account, moving its transactions to the trash
+// @Tags Account
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param account_id path string true "Account ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/accounts/manual/{account_id} [delete]
+func (h *AccountHandler) DeleteManual(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	accountID, err := parseUUIDPathParam(c, pathParamAccountID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := account.DeleteManualAccountUseCaseInput{
+		ID:     accountID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dma.Execute(ctx, in); err != nil {
 		return errs.New(err)
 	}
 
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/attachment"
+	"github.com/gofiber/fiber/v2"
+)
+
+const formFieldFile = "file"
+
+type AttachmentHandler struct {
+	ua *attachment.UploadAttachmentUseCase
+	la *attachment.ListAttachmentsUseCase
+	da *attachment.DownloadAttachmentUseCase
+	dl *attachment.DeleteAttachmentUseCase
+}
+
+func NewAttachmentHandler(
+	ua *attachment.UploadAttachmentUseCase,
+	la *attachment.ListAttachmentsUseCase,
+	da *attachment.DownloadAttachmentUseCase,
+	dl *attachment.DeleteAttachmentUseCase,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		ua: ua,
+		la: la,
+		da: da,
+		dl: dl,
+	}
+}
+
+// @Summary Upload transaction attachment
+// @Description Upload a receipt to a transaction, accepts JPEG, PNG, WebP and PDF files up to 10MB, limited by a storage quota per tier
+// @Tags Attachment
+// @Security BearerAuth
+// @Accept mpfd
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Param file formData file true "File"
+// @Success 201 {object} dto.UploadAttachmentResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/attachments [post]
+func (h *AttachmentHandler) Upload(c *fiber.Ctx) error {
+	userID, tier, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	fileHeader, err := c.FormFile(formFieldFile)
+	if err != nil {
+		return errs.ErrInvalidBody
+	}
+	if fileHeader.Size > attachment.MaxAttachmentSize {
+		return errs.ErrAttachmentTooLarge
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errs.New(err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(
+		io.LimitReader(file, attachment.MaxAttachmentSize+1),
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := attachment.UploadAttachmentUseCaseInput{
+		TransactionID: transactionID,
+		UserID:        userID,
+		Tier:          tier,
+		Name:          fileHeader.Filename,
+		Data:          data,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.ua.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.UploadAttachmentResponse{
+		Attachment: *out,
+	})
+}
+
+// @Summary List transaction attachments
+// @Description List the attachments of a transaction sorted by upload date
+// @Tags Attachment
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Success 200 {object} dto.ListAttachmentsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/attachments [get]
+func (h *AttachmentHandler) List(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := attachment.ListAttachmentsUseCaseInput{
+		TransactionID: transactionID,
+		UserID:        userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.la.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListAttachmentsResponse{
+		Items: out,
+	})
+}
+
+// @Summary Download transaction attachment
+// @Description Download the file of a transaction attachment
+// @Tags Attachment
+// @Security BearerAuth
+// @Produce application/pdf,image/jpeg,image/png,image/webp
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Param attachment_id path string true "Attachment ID" format(uuid)
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/attachments/{attachment_id} [get]
+func (h *AttachmentHandler) Download(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	attachmentID, err := parseUUIDPathParam(c, pathParamAttachmentID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := attachment.DownloadAttachmentUseCaseInput{
+		ID:            attachmentID,
+		TransactionID: transactionID,
+		UserID:        userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.da.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	c.Attachment(out.Name)
+	c.Set(fiber.HeaderContentType, out.ContentType)
+
+	// The body is closed by fiber once it is sent
+	return c.SendStream(out.Body, int(out.Size))
+}
+
+// @Summary Delete transaction attachment
+// @Description Delete a transaction attachment and its file
+// @Tags Attachment
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Param attachment_id path string true "Attachment ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/attachments/{attachment_id} [delete]
+func (h *AttachmentHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	attachmentID, err := parseUUIDPathParam(c, pathParamAttachmentID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := attachment.DeleteAttachmentUseCaseInput{
+		ID:            attachmentID,
+		TransactionID: transactionID,
+		UserID:        userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dl.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+	"github.com/gofiber/fiber/v2"
+)
+
+type GoalHandler struct {
+	cg  *goal.CreateGoalUseCase
+	ug  *goal.UpdateGoalUseCase
+	dg  *goal.DeleteGoalUseCase
+	gg  *goal.GetGoalUseCase
+	lg  *goal.ListGoalsUseCase
+	cgc *goal.CreateGoalContributionUseCase
+	lgc *goal.ListGoalContributionsUseCase
+	dgc *goal.DeleteGoalContributionUseCase
+}
+
+func NewGoalHandler(
+	cg *goal.CreateGoalUseCase,
+	ug *goal.UpdateGoalUseCase,
+	dg *goal.DeleteGoalUseCase,
+	gg *goal.GetGoalUseCase,
+	lg *goal.ListGoalsUseCase,
+	cgc *goal.CreateGoalContributionUseCase,
+	lgc *goal.ListGoalContributionsUseCase,
+	dgc *goal.DeleteGoalContributionUseCase,
+) *GoalHandler {
+	return &GoalHandler{
+		cg:  cg,
+		ug:  ug,
+		dg:  dg,
+		gg:  gg,
+		lg:  lg,
+		cgc: cgc,
+		lgc: lgc,
+		dgc: dgc,
+	}
+}
+
+// @Summary Create goal
+// @Description Create a savings goal, with the target set to the recommended emergency reserve when emergency_reserve is given
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateGoalRequest true "Request body"
+// @Success 201 {object} dto.CreateGoalResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals [post]
+func (h *GoalHandler) Create(c *fiber.Ctx) error {
+	in := goal.CreateGoalUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.cg.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.CreateGoalResponse{
+		Goal: *out,
+	})
+}
+
+// @Summary List goals
+// @Description List savings goals by deadline, with their progress, the monthly contribution needed to reach them and their projected completion date
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListGoalsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals [get]
+func (h *GoalHandler) List(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := goal.ListGoalsUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lg.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Get goal
+// @Description Get a savings goal with its progress, the monthly contribution needed to reach it and its projected completion date
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Success 200 {object} dto.GetGoalResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id} [get]
+func (h *GoalHandler) Get(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := goal.GetGoalUseCaseInput{
+		ID:     goalID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gg.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.GetGoalResponse{
+		GoalProgress: *out,
+	})
+}
+
+// @Summary Update goal
+// @Description Update goal
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Param request body dto.UpdateGoalRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id} [put]
+func (h *GoalHandler) Update(c *fiber.Ctx) error {
+	in := goal.UpdateGoalUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = goalID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.ug.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Delete goal
+// @Description Delete goal and its contributions
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id} [delete]
+func (h *GoalHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := goal.DeleteGoalUseCaseInput{
+		ID:     goalID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dg.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Create goal contribution
+// @Description Add a contribution, or a withdrawal with a negative amount, to a goal not linked to an account
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Param request body dto.CreateGoalContributionRequest true "Request body"
+// @Success 201 {object} dto.CreateGoalContributionResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id}/contributions [post]
+func (h *GoalHandler) CreateContribution(c *fiber.Ctx) error {
+	in := goal.CreateGoalContributionUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.GoalID = goalID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.cgc.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.CreateGoalContributionResponse{
+		GoalContribution: *out,
+	})
+}
+
+// @Summary List goal contributions
+// @Description List the contributions of a goal, the latest first
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Success 200 {object} dto.ListGoalContributionsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id}/contributions [get]
+func (h *GoalHandler) ListContributions(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := goal.ListGoalContributionsUseCaseInput{
+		GoalID: goalID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lgc.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Delete goal contribution
+// @Description Delete goal contribution
+// @Tags Goal
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param goal_id path string true "Goal ID" format(uuid)
+// @Param contribution_id path string true "Contribution ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/goals/{goal_id}/contributions/{contribution_id} [delete]
+func (h *GoalHandler) DeleteContribution(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	goalID, err := parseUUIDPathParam(c, pathParamGoalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	contributionID, err := parseUUIDPathParam(c, pathParamContributionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := goal.DeleteGoalContributionUseCaseInput{
+		ID:     contributionID,
+		GoalID: goalID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dgc.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
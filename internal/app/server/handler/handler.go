@@ -31,14 +31,32 @@ const (
 	QueryParamIsIncome         QueryParam = "is_income"
 	QueryParamIsIgnored        QueryParam = "is_ignored"
 	QueryParamPaymentMethodIDs QueryParam = "payment_method_ids"
+	QueryParamHideTransactions QueryParam = "hide_transactions"
+	QueryParamIsHidden         QueryParam = "is_hidden"
+	QueryParamTagIDs           QueryParam = "tag_ids"
+	QueryParamIsTransfer       QueryParam = "is_transfer"
+	QueryParamMerchantIDs      QueryParam = "merchant_ids"
+	QueryParamFormat           QueryParam = "format"
+	QueryParamAccountIDs       QueryParam = "account_ids"
+	QueryParamInterval         QueryParam = "interval"
+	QueryParamGroupBy          QueryParam = "group_by"
 )
 
 type PathParam = string
 
 const (
-	pathParamCategoryID    PathParam = "category_id"
-	pathParamTransactionID PathParam = "transaction_id"
-	pathParamAIChatID      PathParam = "ai_chat_id"
+	pathParamCategoryID        PathParam = "category_id"
+	pathParamTransactionID     PathParam = "transaction_id"
+	pathParamAIChatID          PathParam = "ai_chat_id"
+	pathParamUserID            PathParam = "user_id"
+	pathParamUserInstitutionID PathParam = "user_institution_id"
+	pathParamAccountID         PathParam = "account_id"
+	pathParamRuleID            PathParam = "rule_id"
+	pathParamTagID             PathParam = "tag_id"
+	pathParamAttachmentID      PathParam = "attachment_id"
+	pathParamTransferID        PathParam = "transfer_id"
+	pathParamGoalID            PathParam = "goal_id"
+	pathParamContributionID    PathParam = "contribution_id"
 )
 
 func parsePaginationParams(
@@ -202,11 +220,26 @@ func prepareTransactionOptions(
 		return nil, errs.New(err)
 	}
 
+	accountIDs, err := parseUUIDQueryParams(c, QueryParamAccountIDs)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
 	categoryIDs, err := parseUUIDQueryParams(c, QueryParamCategoryIDs)
 	if err != nil {
 		return nil, errs.New(err)
 	}
 
+	tagIDs, err := parseUUIDQueryParams(c, QueryParamTagIDs)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	merchantIDs, err := parseUUIDQueryParams(c, QueryParamMerchantIDs)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
 	isExpense := parseBoolQueryParam(c, QueryParamIsExpense)
 	isIncome := parseBoolQueryParam(c, QueryParamIsIncome)
 
@@ -215,6 +248,11 @@ func prepareTransactionOptions(
 		return nil, errs.New(err)
 	}
 
+	isTransfer, err := parseNillableBoolQueryParam(c, QueryParamIsTransfer)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
 	startDate, err := parseDateQueryParam(c, QueryParamStartDate)
 	if err != nil {
 		return nil, errs.New(err)
@@ -229,10 +267,14 @@ func prepareTransactionOptions(
 		Search:           search,
 		CategoryIDs:      categoryIDs,
 		InstitutionIDs:   institutionIDs,
+		AccountIDs:       accountIDs,
 		PaymentMethodIDs: paymentMethodIDs,
+		TagIDs:           tagIDs,
+		MerchantIDs:      merchantIDs,
 		IsExpense:        isExpense,
 		IsIncome:         isIncome,
 		IsIgnored:        isIgnored,
+		IsTransfer:       isTransfer,
 		StartDate:        startDate,
 		EndDate:          endDate,
 	}, nil
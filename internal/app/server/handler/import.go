@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/imports"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	formFieldFormat          = "format"
+	formFieldCSVPreset       = "csv_preset"
+	formFieldAccountID       = "account_id"
+	formFieldAccountName     = "account_name"
+	formFieldPaymentMethodID = "payment_method_id"
+)
+
+type ImportHandler struct {
+	it *imports.ImportTransactionsUseCase
+}
+
+func NewImportHandler(
+	it *imports.ImportTransactionsUseCase,
+) *ImportHandler {
+	return &ImportHandler{
+		it: it,
+	}
+}
+
+// @Summary Import statement
+// @Description Import the transactions of a CSV, OFX or QIF statement into a manual account, creating it when account_id is not sent. Rows already imported are skipped.
+// @Tags Import
+// @Security BearerAuth
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "File"
+// @Param format formData string false "Format, detected from the file when empty" Enums(CSV, OFX, QIF)
+// @Param csv_preset formData string false "CSV columns preset" Enums(GENERIC, NUBANK_ACCOUNT, NUBANK_CARD, INTER)
+// @Param account_id formData string false "Manual account ID" format(uuid)
+// @Param account_name formData string false "Name of the manual account to create"
+// @Param payment_method_id formData string true "Payment method ID" format(uuid)
+// @Success 201 {object} dto.ImportTransactionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/imports [post]
+func (h *ImportHandler) Create(c *fiber.Ctx) error {
+	in, err := parseImportForm(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ctx := c.UserContext()
+	out, err := h.it.Execute(ctx, *in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.ImportTransactionsResponse{
+		ImportTransactionsUseCaseOutput: *out,
+	})
+}
+
+// @Summary Preview statement import
+// @Description Parse a CSV, OFX or QIF statement and list the transactions that would be imported, flagging the ones already imported, without saving them
+// @Tags Import
+// @Security BearerAuth
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "File"
+// @Param format formData string false "Format, detected from the file when empty" Enums(CSV, OFX, QIF)
+// @Param csv_preset formData string false "CSV columns preset" Enums(GENERIC, NUBANK_ACCOUNT, NUBANK_CARD, INTER)
+// @Param account_id formData string false "Manual account ID" format(uuid)
+// @Param account_name formData string false "Name of the manual account to create"
+// @Param payment_method_id formData string true "Payment method ID" format(uuid)
+// @Success 200 {object} dto.ImportTransactionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/imports/preview [post]
+func (h *ImportHandler) Preview(c *fiber.Ctx) error {
+	in, err := parseImportForm(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.IsPreview = true
+
+	ctx := c.UserContext()
+	out, err := h.it.Execute(ctx, *in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ImportTransactionsResponse{
+		ImportTransactionsUseCaseOutput: *out,
+	})
+}
+
+func parseImportForm(
+	c *fiber.Ctx,
+) (*imports.ImportTransactionsUseCaseInput, error) {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	fileHeader, err := c.FormFile(formFieldFile)
+	if err != nil {
+		return nil, errs.ErrInvalidBody
+	}
+	if fileHeader.Size > imports.MaxImportFileSize {
+		return nil, errs.ErrImportFileTooLarge
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, imports.MaxImportFileSize+1))
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	in := imports.ImportTransactionsUseCaseInput{
+		UserID:      userID,
+		FileName:    fileHeader.Filename,
+		Data:        data,
+		Format:      entity.ImportFormat(c.FormValue(formFieldFormat)),
+		CSVPreset:   entity.ImportCSVPreset(c.FormValue(formFieldCSVPreset)),
+		AccountName: c.FormValue(formFieldAccountName),
+	}
+
+	if value := c.FormValue(formFieldAccountID); value != "" {
+		accountID, err := uuid.Parse(value)
+		if err != nil {
+			return nil, errs.ErrInvalidUUID
+		}
+		in.AccountID = &accountID
+	}
+
+	if value := c.FormValue(formFieldPaymentMethodID); value != "" {
+		paymentMethodID, err := uuid.Parse(value)
+		if err != nil {
+			return nil, errs.ErrInvalidUUID
+		}
+		in.PaymentMethodID = paymentMethodID
+	}
+
+	return &in, nil
+}
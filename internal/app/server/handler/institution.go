@@ -7,21 +7,32 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 )
 
 type InstitutionHandler struct {
-	si *institution.SyncInstitutionsUseCase
-	li *institution.ListInstitutionsUseCase
+	si  *institution.SyncInstitutionsUseCase
+	li  *institution.ListInstitutionsUseCase
+	lui *institution.ListUserInstitutionsUseCase
+	how *institution.HandleOpenFinanceWebhookUseCase
+	cct *institution.CreateConnectTokenUseCase
+	dui *institution.DeleteUserInstitutionUseCase
 }
 
 func NewInstitutionHandler(
 	si *institution.SyncInstitutionsUseCase,
 	li *institution.ListInstitutionsUseCase,
+	lui *institution.ListUserInstitutionsUseCase,
+	how *institution.HandleOpenFinanceWebhookUseCase,
+	cct *institution.CreateConnectTokenUseCase,
+	dui *institution.DeleteUserInstitutionUseCase,
 ) *InstitutionHandler {
 	return &InstitutionHandler{
-		si: si,
-		li: li,
+		si:  si,
+		li:  li,
+		lui: lui,
+		how: how,
+		cct: cct,
+		dui: dui,
 	}
 }
 
@@ -76,7 +87,7 @@ func (h InstitutionHandler) List(c *fiber.Ctx) error {
 }
 
 // @Summary List user institutions
-// @Description List user institutions
+// @Description List user institutions with their connection status
 // @Tags Institution
 // @Security BearerAuth
 // @Accept json
@@ -84,7 +95,7 @@ func (h InstitutionHandler) List(c *fiber.Ctx) error {
 // @Param search query string false "Search"
 // @Param page query int false "Page"
 // @Param page_size query int false "Page size"
-// @Success 200 {object} dto.ListInstitutionsResponse
+// @Success 200 {object} dto.ListUserInstitutionsResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /v1/users/institutions [get]
@@ -96,19 +107,122 @@ func (h InstitutionHandler) ListUserInstitutions(c *fiber.Ctx) error {
 		return errs.New(err)
 	}
 
-	in := institution.ListInstitutionsUseCaseInput{
+	in := institution.ListUserInstitutionsUseCaseInput{
 		PaginationInput: paginationIn,
-		InstitutionOptions: repo.InstitutionOptions{
-			UserIDs: []uuid.UUID{userID},
-			Search:  search,
-		},
+		Search:          search,
+		UserID:          userID,
 	}
 
 	ctx := c.UserContext()
-	res, err := h.li.Execute(ctx, in)
+	res, err := h.lui.Execute(ctx, in)
 	if err != nil {
 		return errs.New(err)
 	}
 
 	return c.JSON(res)
 }
+
+// @Summary Handle open finance webhook
+// @Description Webhook to handle open finance connection lifecycle events, item updates are synced in background
+// @Tags Institution
+// @Security WebhookSecret
+// @Accept json
+// @Produce json
+// @Param request body dto.HandleOpenFinanceWebhookRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/webhooks/open-finance [post]
+func (h InstitutionHandler) Webhook(c *fiber.Ctx) error {
+	in := institution.HandleOpenFinanceWebhookUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.ErrInvalidBody
+	}
+
+	ctx := c.UserContext()
+	if err := h.how.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Create open finance connect token
+// @Description Create a token to open the open finance connect widget, optionally scoped to an existing connection to reconnect it
+// @Tags Institution
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateConnectTokenRequest false "Request body"
+// @Success 201 {object} dto.CreateConnectTokenResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/institutions/connect-token [post]
+func (h InstitutionHandler) CreateConnectToken(c *fiber.Ctx) error {
+	userID, tier, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := institution.CreateConnectTokenUseCaseInput{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&in); err != nil {
+			return errs.ErrInvalidBody
+		}
+	}
+	in.UserID = userID
+	in.Tier = tier
+
+	ctx := c.UserContext()
+	out, err := h.cct.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(out)
+}
+
+// @Summary Delete user institution
+// @Description Disconnects an institution from the user, removing its accounts
+// @Tags Institution
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_institution_id path string true "User institution ID" format(uuid)
+// @Param hide_transactions query bool false "Hide the institution transactions"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/users/institutions/{user_institution_id} [delete]
+func (h InstitutionHandler) DeleteUserInstitution(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	userInstitutionID, err := parseUUIDPathParam(
+		c,
+		pathParamUserInstitutionID,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := institution.DeleteUserInstitutionUseCaseInput{
+		UserID:            userID,
+		UserInstitutionID: userInstitutionID,
+		HideTransactions:  parseBoolQueryParam(c, QueryParamHideTransactions),
+	}
+
+	ctx := c.UserContext()
+	if err := h.dui.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
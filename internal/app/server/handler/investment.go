@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/gofiber/fiber/v2"
+)
+
+type InvestmentHandler struct {
+	gi *investment.GetInvestmentsUseCase
+	si *investment.SyncInvestmentsUseCase
+}
+
+func NewInvestmentHandler(
+	gi *investment.GetInvestmentsUseCase,
+	si *investment.SyncInvestmentsUseCase,
+) *InvestmentHandler {
+	return &InvestmentHandler{
+		gi: gi,
+		si: si,
+	}
+}
+
+// @Summary Get investments
+// @Description Gets user investments with their latest position and totals by type and by institution
+// @Tags Investment
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.GetInvestmentsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/investments [get]
+func (h *InvestmentHandler) Get(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := investment.GetInvestmentsUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gi.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(out)
+}
+
+// @Summary Sync investments from open finance
+// @Description Sync investments positions from open finance
+// @Tags Investment
+// @Security BasicAuth
+// @Accept json
+// @Produce json
+// @Success 204
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/admin/investments/sync [post]
+func (h *InvestmentHandler) Sync(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	in := investment.SyncInvestmentsUseCaseInput{}
+	if _, err := h.si.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
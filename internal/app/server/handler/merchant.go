@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
+	"github.com/gofiber/fiber/v2"
+)
+
+type MerchantHandler struct {
+	lm *merchant.ListMerchantsUseCase
+}
+
+func NewMerchantHandler(
+	lm *merchant.ListMerchantsUseCase,
+) *MerchantHandler {
+	return &MerchantHandler{
+		lm: lm,
+	}
+}
+
+// @Summary List merchants
+// @Description List the merchants of the user transactions with their income and expense sums, biggest expenses first
+// @Tags Merchant
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search"
+// @Param start_date query string false "Start date" format(date)
+// @Param end_date query string false "End date" format(date)
+// @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
+// @Param category_ids query []string false "Category IDs"
+// @Param payment_method_ids query []string false "Payment method IDs"
+// @Param tag_ids query []string false "Tag IDs"
+// @Param merchant_ids query []string false "Merchant IDs"
+// @Param is_ignored query bool false "Filter ignored or not ignored transactions"
+// @Success 200 {object} dto.ListMerchantsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/merchants [get]
+func (h *MerchantHandler) List(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionOptions, err := prepareTransactionOptions(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := merchant.ListMerchantsUseCaseInput{
+		TransactionOptions: *transactionOptions,
+		UserID:             userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lm.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListMerchantsResponse{
+		ListMerchantsUseCaseOutput: *out,
+	})
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RecurringHandler struct {
+	lrs *recurring.ListRecurringSeriesUseCase
+	drs *recurring.DetectRecurringSeriesUseCase
+}
+
+func NewRecurringHandler(
+	lrs *recurring.ListRecurringSeriesUseCase,
+	drs *recurring.DetectRecurringSeriesUseCase,
+) *RecurringHandler {
+	return &RecurringHandler{
+		lrs: lrs,
+		drs: drs,
+	}
+}
+
+// @Summary List recurring series
+// @Description List recurring payments and incomes detected from the transaction history, sorted by the next expected date
+// @Tags Recurring
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListRecurringSeriesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/recurring [get]
+func (h *RecurringHandler) List(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := recurring.ListRecurringSeriesUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lrs.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListRecurringSeriesResponse{
+		ListRecurringSeriesUseCaseOutput: *out,
+	})
+}
+
+// @Summary Detect recurring series
+// @Description Scan the transaction history for recurring series, it already runs after each transactions sync
+// @Tags Recurring
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListRecurringSeriesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/recurring/detect [post]
+func (h *RecurringHandler) Detect(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ctx := c.UserContext()
+	if err := h.drs.Execute(
+		ctx,
+		recurring.DetectRecurringSeriesUseCaseInput{UserID: userID},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	out, err := h.lrs.Execute(
+		ctx,
+		recurring.ListRecurringSeriesUseCaseInput{UserID: userID},
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListRecurringSeriesResponse{
+		ListRecurringSeriesUseCaseOutput: *out,
+	})
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/gofiber/fiber/v2"
+)
+
+type RuleHandler struct {
+	cr *rule.CreateRuleUseCase
+	ur *rule.UpdateRuleUseCase
+	dr *rule.DeleteRuleUseCase
+	gr *rule.GetRuleUseCase
+	lr *rule.ListRulesUseCase
+	ar *rule.ApplyRulesUseCase
+}
+
+func NewRuleHandler(
+	cr *rule.CreateRuleUseCase,
+	ur *rule.UpdateRuleUseCase,
+	dr *rule.DeleteRuleUseCase,
+	gr *rule.GetRuleUseCase,
+	lr *rule.ListRulesUseCase,
+	ar *rule.ApplyRulesUseCase,
+) *RuleHandler {
+	return &RuleHandler{
+		cr: cr,
+		ur: ur,
+		dr: dr,
+		gr: gr,
+		lr: lr,
+		ar: ar,
+	}
+}
+
+// @Summary Create rule
+// @Description Create a rule to categorize, rename or ignore transactions
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateRuleRequest true "Request body"
+// @Success 201 {object} dto.CreateRuleResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules [post]
+func (h *RuleHandler) Create(c *fiber.Ctx) error {
+	in := rule.CreateRuleUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.cr.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.CreateRuleResponse{
+		Rule: *out,
+	})
+}
+
+// @Summary List rules
+// @Description List rules sorted by priority
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.ListRulesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules [get]
+func (h *RuleHandler) List(c *fiber.Ctx) error {
+	paginationIn := parsePaginationParams(c)
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := rule.ListRulesUseCaseInput{
+		PaginationInput: paginationIn,
+		RuleOptions: repo.RuleOptions{
+			UserID: userID,
+		},
+	}
+
+	ctx := c.UserContext()
+	res, err := h.lr.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(res)
+}
+
+// @Summary Get rule
+// @Description Get rule
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Rule ID" format(uuid)
+// @Success 200 {object} dto.GetRuleResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules/{rule_id} [get]
+func (h *RuleHandler) Get(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ruleID, err := parseUUIDPathParam(c, pathParamRuleID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := rule.GetRuleUseCaseInput{
+		ID:     ruleID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.gr.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.GetRuleResponse{
+		Rule: *out,
+	})
+}
+
+// @Summary Update rule
+// @Description Update rule
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Rule ID" format(uuid)
+// @Param request body dto.UpdateRuleRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules/{rule_id} [put]
+func (h *RuleHandler) Update(c *fiber.Ctx) error {
+	in := rule.UpdateRuleUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ruleID, err := parseUUIDPathParam(c, pathParamRuleID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = ruleID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.ur.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Delete rule
+// @Description Delete rule
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Rule ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules/{rule_id} [delete]
+func (h *RuleHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ruleID, err := parseUUIDPathParam(c, pathParamRuleID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := rule.DeleteRuleUseCaseInput{
+		ID:     ruleID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dr.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Apply rules
+// @Description Apply the rules to all existing transactions, keeping the fields edited by the user
+// @Tags Rule
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ApplyRulesResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/rules/apply [post]
+func (h *RuleHandler) Apply(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := rule.ApplyRulesUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.ar.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ApplyRulesResponse{
+		ApplyRulesUseCaseOutput: *out,
+	})
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TagHandler struct {
+	ct *tag.CreateTagUseCase
+	ut *tag.UpdateTagUseCase
+	dt *tag.DeleteTagUseCase
+	lt *tag.ListTagsUseCase
+}
+
+func NewTagHandler(
+	ct *tag.CreateTagUseCase,
+	ut *tag.UpdateTagUseCase,
+	dt *tag.DeleteTagUseCase,
+	lt *tag.ListTagsUseCase,
+) *TagHandler {
+	return &TagHandler{
+		ct: ct,
+		ut: ut,
+		dt: dt,
+		lt: lt,
+	}
+}
+
+// @Summary Create tag
+// @Description Create a tag to label transactions
+// @Tags Tag
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTagRequest true "Request body"
+// @Success 201 {object} dto.CreateTagResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/tags [post]
+func (h *TagHandler) Create(c *fiber.Ctx) error {
+	in := tag.CreateTagUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.ct.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).JSON(dto.CreateTagResponse{
+		Tag: *out,
+	})
+}
+
+// @Summary List tags
+// @Description List tags sorted by name
+// @Tags Tag
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param search query string false "Search"
+// @Param page query int false "Page"
+// @Param page_size query int false "Page size"
+// @Success 200 {object} dto.ListTagsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/tags [get]
+func (h *TagHandler) List(c *fiber.Ctx) error {
+	search := c.Query(QueryParamSearch)
+	paginationIn := parsePaginationParams(c)
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := tag.ListTagsUseCaseInput{
+		PaginationInput: paginationIn,
+		TagOptions: repo.TagOptions{
+			Search: search,
+			UserID: userID,
+		},
+	}
+
+	ctx := c.UserContext()
+	res, err := h.lt.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(res)
+}
+
+// @Summary Update tag
+// @Description Update tag
+// @Tags Tag
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param tag_id path string true "Tag ID" format(uuid)
+// @Param request body dto.UpdateTagRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/tags/{tag_id} [put]
+func (h *TagHandler) Update(c *fiber.Ctx) error {
+	in := tag.UpdateTagUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	tagID, err := parseUUIDPathParam(c, pathParamTagID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = tagID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.ut.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Delete tag
+// @Description Delete tag, removing it from its transactions and rules
+// @Tags Tag
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param tag_id path string true "Tag ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/tags/{tag_id} [delete]
+func (h *TagHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	tagID, err := parseUUIDPathParam(c, pathParamTagID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := tag.DeleteTagUseCaseInput{
+		ID:     tagID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dt.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
 	"github.com/gofiber/fiber/v2"
@@ -15,6 +16,13 @@ type TransactionHandler struct {
 	gt *transaction.GetTransactionUseCase
 	ut *transaction.UpdateTransactionUseCase
 	ct *transaction.CreateTransactionUseCase
+	st *transaction.SplitTransactionUseCase
+	dt *transaction.DeleteTransactionUseCase
+	ht *transaction.HideTransactionUseCase
+	ld *transaction.ListDeletedTransactionsUseCase
+	rt *transaction.RestoreTransactionUseCase
+	bu *transaction.BulkUpdateTransactionsUseCase
+	ex *transaction.ExportTransactionsUseCase
 }
 
 func NewTransactionHandler(
@@ -23,6 +31,13 @@ func NewTransactionHandler(
 	gt *transaction.GetTransactionUseCase,
 	ut *transaction.UpdateTransactionUseCase,
 	ct *transaction.CreateTransactionUseCase,
+	st *transaction.SplitTransactionUseCase,
+	dt *transaction.DeleteTransactionUseCase,
+	ht *transaction.HideTransactionUseCase,
+	ld *transaction.ListDeletedTransactionsUseCase,
+	rt *transaction.RestoreTransactionUseCase,
+	bu *transaction.BulkUpdateTransactionsUseCase,
+	ex *transaction.ExportTransactionsUseCase,
 ) *TransactionHandler {
 	return &TransactionHandler{
 		sa: sa,
@@ -30,6 +45,13 @@ func NewTransactionHandler(
 		gt: gt,
 		ut: ut,
 		ct: ct,
+		st: st,
+		dt: dt,
+		ht: ht,
+		ld: ld,
+		rt: rt,
+		bu: bu,
+		ex: ex,
 	}
 }
 
@@ -125,7 +147,7 @@ func (h *TransactionHandler) Sync(c *fiber.Ctx) error {
 	}
 
 	ctx := c.UserContext()
-	if err := h.sa.Execute(ctx, in); err != nil {
+	if _, err := h.sa.Execute(ctx, in); err != nil {
 		return errs.New(err)
 	}
 
@@ -144,11 +166,15 @@ func (h *TransactionHandler) Sync(c *fiber.Ctx) error {
 // @Param start_date query string false "Start date" format(date)
 // @Param end_date query string false "End date" format(date)
 // @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
 // @Param category_ids query []string false "Category IDs"
 // @Param payment_method_ids query []string false "Payment method IDs"
+// @Param tag_ids query []string false "Tag IDs"
+// @Param merchant_ids query []string false "Merchant IDs"
 // @Param is_expense query bool false "Filter only expenses"
 // @Param is_income query bool false "Filter only incomes"
 // @Param is_ignored query bool false "Filter ignored or not ignored transactions"
+// @Param is_transfer query bool false "Filter transfers between the user accounts or other transactions"
 // @Success 200 {object} dto.ListTransactionsResponse
 // @Failure 401 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -181,6 +207,61 @@ func (h *TransactionHandler) List(c *fiber.Ctx) error {
 	return c.JSON(res)
 }
 
+// @Summary Export transactions
+// @Description Download the transactions matching the filters as a CSV, OFX or XLSX file, with amounts formatted in the user language
+// @Tags Transaction
+// @Security BearerAuth
+// @Produce octet-stream
+// @Param format query string true "File format" Enums(csv, ofx, xlsx)
+// @Param search query string false "Search"
+// @Param start_date query string false "Start date" format(date)
+// @Param end_date query string false "End date" format(date)
+// @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
+// @Param category_ids query []string false "Category IDs"
+// @Param payment_method_ids query []string false "Payment method IDs"
+// @Param tag_ids query []string false "Tag IDs"
+// @Param merchant_ids query []string false "Merchant IDs"
+// @Param is_expense query bool false "Filter only expenses"
+// @Param is_income query bool false "Filter only incomes"
+// @Param is_ignored query bool false "Filter ignored or not ignored transactions"
+// @Param is_transfer query bool false "Filter transfers between the user accounts or other transactions"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/export [get]
+func (h *TransactionHandler) Export(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionOptions, err := prepareTransactionOptions(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transaction.ExportTransactionsUseCaseInput{
+		TransactionOptions: *transactionOptions,
+		UserID:             userID,
+		Format:             entity.ExportFormat(c.Query(QueryParamFormat)),
+	}
+
+	ctx := c.UserContext()
+	out, err := h.ex.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	c.Attachment(out.FileName)
+	c.Set(fiber.HeaderContentType, out.ContentType)
+
+	// The body is closed by fiber once it is sent, the size is unknown until
+	// then
+	return c.SendStream(out.Body)
+}
+
 // @Summary Update transaction
 // @Description Update transaction
 // @Tags Transaction
@@ -220,3 +301,245 @@ func (h TransactionHandler) Update(c *fiber.Ctx) error {
 
 	return c.SendStatus(http.StatusNoContent)
 }
+
+// @Summary Bulk update transactions
+// @Description Recategorize, set ignored, add or remove tags or delete many transactions at once. They are selected by the ids in the body or, without ids, by the same filters as the list. Deleting hides the synced transactions.
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkUpdateTransactionsRequest true "Request body"
+// @Param search query string false "Search"
+// @Param start_date query string false "Start date" format(date)
+// @Param end_date query string false "End date" format(date)
+// @Param institution_ids query []string false "Institution IDs"
+// @Param account_ids query []string false "Manual account IDs"
+// @Param category_ids query []string false "Category IDs"
+// @Param payment_method_ids query []string false "Payment method IDs"
+// @Param tag_ids query []string false "Tag IDs"
+// @Param merchant_ids query []string false "Merchant IDs"
+// @Param is_expense query bool false "Filter only expenses"
+// @Param is_income query bool false "Filter only incomes"
+// @Param is_ignored query bool false "Filter ignored or not ignored transactions"
+// @Param is_transfer query bool false "Filter transfers between the user accounts or other transactions"
+// @Success 200 {object} dto.BulkUpdateTransactionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions [patch]
+func (h *TransactionHandler) BulkUpdate(c *fiber.Ctx) error {
+	in := transaction.BulkUpdateTransactionsUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	if len(in.IDs) == 0 {
+		transactionOptions, err := prepareTransactionOptions(c)
+		if err != nil {
+			return errs.New(err)
+		}
+		in.Filter = *transactionOptions
+	}
+
+	ctx := c.UserContext()
+	out, err := h.bu.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.BulkUpdateTransactionsResponse{
+		BulkUpdateTransactionsUseCaseOutput: *out,
+	})
+}
+
+// @Summary Split transaction
+// @Description Split a transaction into parts with their own category, an empty list removes the splits
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.SplitTransactionRequest true "Request body"
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Success 200 {object} dto.SplitTransactionResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/splits [post]
+func (h TransactionHandler) Split(c *fiber.Ctx) error {
+	in := transaction.SplitTransactionUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = transactionID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	splits, err := h.st.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.SplitTransactionResponse{
+		Splits: splits,
+	})
+}
+
+// @Summary Delete transaction
+// @Description Move a manual transaction to the trash, synced transactions must be hidden instead
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id} [delete]
+func (h TransactionHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transaction.DeleteTransactionUseCaseInput{
+		ID:     transactionID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dt.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Hide transaction
+// @Description Move a synced transaction to the trash, so the next syncs do not insert it again
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/{transaction_id}/hidden [put]
+func (h TransactionHandler) Hide(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transaction.HideTransactionUseCaseInput{
+		ID:     transactionID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.ht.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary List deleted transactions
+// @Description List the transactions deleted or hidden in the last 30 days, which can still be restored
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListDeletedTransactionsResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/trash [get]
+func (h TransactionHandler) ListTrash(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transaction.ListDeletedTransactionsUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.ld.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListDeletedTransactionsResponse{
+		ListDeletedTransactionsUseCaseOutput: *out,
+	})
+}
+
+// @Summary Restore transaction
+// @Description Restore a transaction from the trash
+// @Tags Transaction
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/trash/{transaction_id}/restore [post]
+func (h TransactionHandler) Restore(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transactionID, err := parseUUIDPathParam(c, pathParamTransactionID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transaction.RestoreTransactionUseCaseInput{
+		ID:     transactionID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.rt.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
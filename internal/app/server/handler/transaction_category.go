@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
@@ -12,15 +13,30 @@ import (
 type TransactionCategoryHandler struct {
 	stc *transactioncategory.SyncTransactionCategoriesUseCase
 	ltc *transactioncategory.ListTransactionCategoriesUseCase
+	ctc *transactioncategory.CreateTransactionCategoryUseCase
+	utc *transactioncategory.UpdateTransactionCategoryUseCase
+	dtc *transactioncategory.DeleteTransactionCategoryUseCase
+	htc *transactioncategory.HideTransactionCategoryUseCase
+	mtc *transactioncategory.MergeTransactionCategoriesUseCase
 }
 
 func NewTransactionCategoryHandler(
 	stc *transactioncategory.SyncTransactionCategoriesUseCase,
 	ltc *transactioncategory.ListTransactionCategoriesUseCase,
+	ctc *transactioncategory.CreateTransactionCategoryUseCase,
+	utc *transactioncategory.UpdateTransactionCategoryUseCase,
+	dtc *transactioncategory.DeleteTransactionCategoryUseCase,
+	htc *transactioncategory.HideTransactionCategoryUseCase,
+	mtc *transactioncategory.MergeTransactionCategoriesUseCase,
 ) *TransactionCategoryHandler {
 	return &TransactionCategoryHandler{
 		stc: stc,
 		ltc: ltc,
+		ctc: ctc,
+		utc: utc,
+		dtc: dtc,
+		htc: htc,
+		mtc: mtc,
 	}
 }
 
@@ -48,6 +64,7 @@ func (h TransactionCategoryHandler) Sync(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param search query string false "Search"
+// @Param is_hidden query bool false "List only the hidden categories"
 // @Param page query int false "Page"
 // @Param page_size query int false "Page size"
 // @Success 200 {object} dto.ListTransactionCategoriesResponse
@@ -56,12 +73,20 @@ func (h TransactionCategoryHandler) Sync(c *fiber.Ctx) error {
 // @Router /v1/transactions/categories [get]
 func (h TransactionCategoryHandler) List(c *fiber.Ctx) error {
 	search := c.Query(QueryParamSearch)
+	isHidden := parseBoolQueryParam(c, QueryParamIsHidden)
 	paginationIn := parsePaginationParams(c)
 
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
 	in := transactioncategory.ListCategoriesInput{
 		PaginationInput: paginationIn,
 		TransactionCategoryOptions: repo.TransactionCategoryOptions{
-			Search: search,
+			Search:   search,
+			UserID:   userID,
+			IsHidden: &isHidden,
 		},
 	}
 
@@ -73,3 +98,199 @@ func (h TransactionCategoryHandler) List(c *fiber.Ctx) error {
 
 	return c.JSON(res)
 }
+
+// @Summary Create category
+// @Description Create a custom category, optionally nested under a provider category
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTransactionCategoryRequest true "Request body"
+// @Success 201 {object} dto.CreateTransactionCategoryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/categories [post]
+func (h TransactionCategoryHandler) Create(c *fiber.Ctx) error {
+	in := transactioncategory.CreateTransactionCategoryUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	out, err := h.ctc.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.Status(http.StatusCreated).
+		JSON(dto.CreateTransactionCategoryResponse{
+			TransactionCategory: *out,
+		})
+}
+
+// @Summary Update category
+// @Description Update a custom category
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID" format(uuid)
+// @Param request body dto.UpdateTransactionCategoryRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/categories/{category_id} [put]
+func (h TransactionCategoryHandler) Update(c *fiber.Ctx) error {
+	in := transactioncategory.UpdateTransactionCategoryUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	categoryID, err := parseUUIDPathParam(c, pathParamCategoryID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = categoryID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.utc.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Delete category
+// @Description Delete a custom category, moving its transactions to the parent or default category
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID" format(uuid)
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/categories/{category_id} [delete]
+func (h TransactionCategoryHandler) Delete(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	categoryID, err := parseUUIDPathParam(c, pathParamCategoryID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transactioncategory.DeleteTransactionCategoryUseCaseInput{
+		ID:     categoryID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.dtc.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Hide category
+// @Description Hide a category from the category list, or show it again
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID" format(uuid)
+// @Param request body dto.HideTransactionCategoryRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/categories/{category_id}/hidden [put]
+func (h TransactionCategoryHandler) Hide(c *fiber.Ctx) error {
+	in := transactioncategory.HideTransactionCategoryUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	categoryID, err := parseUUIDPathParam(c, pathParamCategoryID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = categoryID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.htc.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Merge categories
+// @Description Merge a category into another one, moving its transactions, budgets and rules
+// @Tags Category
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID" format(uuid)
+// @Param request body dto.MergeTransactionCategoriesRequest true "Request body"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transactions/categories/{category_id}/merge [post]
+func (h TransactionCategoryHandler) Merge(c *fiber.Ctx) error {
+	in := transactioncategory.MergeTransactionCategoriesUseCaseInput{}
+	if err := c.BodyParser(&in); err != nil {
+		return errs.New(err)
+	}
+
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	categoryID, err := parseUUIDPathParam(c, pathParamCategoryID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in.ID = categoryID
+	in.UserID = userID
+
+	ctx := c.UserContext()
+	if err := h.mtc.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TransferHandler struct {
+	lt *transfer.ListTransfersUseCase
+	mt *transfer.MatchTransfersUseCase
+	ct *transfer.ConfirmTransferUseCase
+	ut *transfer.UnlinkTransferUseCase
+}
+
+func NewTransferHandler(
+	lt *transfer.ListTransfersUseCase,
+	mt *transfer.MatchTransfersUseCase,
+	ct *transfer.ConfirmTransferUseCase,
+	ut *transfer.UnlinkTransferUseCase,
+) *TransferHandler {
+	return &TransferHandler{
+		lt: lt,
+		mt: mt,
+		ct: ct,
+		ut: ut,
+	}
+}
+
+// @Summary List transfers
+// @Description List the transactions linked as transfers between the user accounts, which are not counted as income or expense
+// @Tags Transfer
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListTransfersResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transfers [get]
+func (h *TransferHandler) List(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transfer.ListTransfersUseCaseInput{
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	out, err := h.lt.Execute(ctx, in)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListTransfersResponse{
+		ListTransfersUseCaseOutput: *out,
+	})
+}
+
+// @Summary Match transfers
+// @Description Pair opposite transactions of the same amount across the user accounts as transfers, it already runs after each transactions sync
+// @Tags Transfer
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.ListTransfersResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transfers/match [post]
+func (h *TransferHandler) Match(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	ctx := c.UserContext()
+	if err := h.mt.Execute(
+		ctx,
+		transfer.MatchTransfersUseCaseInput{UserID: userID},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	out, err := h.lt.Execute(
+		ctx,
+		transfer.ListTransfersUseCaseInput{UserID: userID},
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return c.JSON(dto.ListTransfersResponse{
+		ListTransfersUseCaseOutput: *out,
+	})
+}
+
+// @Summary Confirm transfer
+// @Description Confirm a matched transfer
+// @Tags Transfer
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transfer_id path string true "Transfer ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transfers/{transfer_id}/confirmed [put]
+func (h *TransferHandler) Confirm(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transferID, err := parseUUIDPathParam(c, pathParamTransferID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transfer.ConfirmTransferUseCaseInput{
+		ID:     transferID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.ct.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// @Summary Unlink transfer
+// @Description Unlink a transfer, so its transactions are counted as income and expense again and the pair is not matched again
+// @Tags Transfer
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param transfer_id path string true "Transfer ID" format(uuid)
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /v1/transfers/{transfer_id} [delete]
+func (h *TransferHandler) Unlink(c *fiber.Ctx) error {
+	userID, _, err := GetUser(c)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transferID, err := parseUUIDPathParam(c, pathParamTransferID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	in := transfer.UnlinkTransferUseCaseInput{
+		ID:     transferID,
+		UserID: userID,
+	}
+
+	ctx := c.UserContext()
+	if err := h.ut.Execute(ctx, in); err != nil {
+		return errs.New(err)
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
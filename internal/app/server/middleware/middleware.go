@@ -3,19 +3,23 @@ package middleware
 import (
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
 )
 
 type Middleware struct {
 	e *env.Env
 	j *jwtutil.JWT
+	c cache.Cache
 }
 
 func NewMiddleware(
 	e *env.Env,
 	j *jwtutil.JWT,
+	c cache.Cache,
 ) *Middleware {
 	return &Middleware{
 		e: e,
 		j: j,
+		c: c,
 	}
 }
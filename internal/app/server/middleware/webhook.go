@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+)
+
+// HeaderWebhookSecret is the custom header the open finance provider is
+// configured to send along with the webhooks, holding the webhook secret.
+const HeaderWebhookSecret = "X-Webhook-Secret"
+
+// webhookEventRetention is how long a processed event id is kept, covering
+// the retries of the provider.
+const webhookEventRetention = 7 * 24 * time.Hour
+
+type webhookEvent struct {
+	EventID string `json:"eventId"`
+}
+
+// Webhook verifies that the request was sent by the open finance provider.
+// The provider only sends static custom headers, so the secret header must
+// match the webhook secret. Events already processed are rejected by their
+// event id, so payloads without one are rejected too.
+func (m *Middleware) Webhook() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		secret := c.Get(HeaderWebhookSecret)
+		if subtle.ConstantTimeCompare(
+			[]byte(secret),
+			[]byte(m.e.OpenFinanceWebhookSecret),
+		) != 1 {
+			return errs.ErrInvalidWebhookSecret
+		}
+
+		event := webhookEvent{}
+		if err := json.Unmarshal(c.Body(), &event); err != nil {
+			return errs.ErrInvalidBody
+		}
+
+		if event.EventID == "" {
+			return errs.ErrInvalidBody
+		}
+
+		eventKey := fmt.Sprintf("%s:%s", cache.KeyWebhookEvent, event.EventID)
+		ok, err := m.c.SetNX(
+			c.UserContext(),
+			eventKey,
+			time.Now().Unix(),
+			webhookEventRetention,
+		)
+		if err != nil {
+			return errs.New(err)
+		}
+		if !ok {
+			return errs.ErrReplayedWebhook
+		}
+
+		// The provider retries failed deliveries with the same event id, so
+		// they must not be taken as replays.
+		if err := c.Next(); err != nil {
+			if err := m.c.Delete(context.Background(), eventKey); err != nil {
+				slog.Error(
+					"webhook: error releasing event id",
+					"event_id", event.EventID,
+					"err", err,
+				)
+			}
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache/memcache"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+func TestWebhook(t *testing.T) {
+	t.Parallel()
+
+	type delivery struct {
+		secret string
+		body   string
+		// fails makes the handler fail the delivery.
+		fails        bool
+		expectedCode int
+	}
+
+	tests := []struct {
+		description string
+		deliveries  []delivery
+	}{
+		{
+			description: "valid secret",
+			deliveries: []delivery{
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusNoContent,
+				},
+			},
+		},
+		{
+			description: "invalid secret",
+			deliveries: []delivery{
+				{
+					secret:       "wrong-secret",
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusUnauthorized,
+				},
+			},
+		},
+		{
+			description: "missing secret",
+			deliveries: []delivery{
+				{
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusUnauthorized,
+				},
+			},
+		},
+		{
+			description: "invalid body",
+			deliveries: []delivery{
+				{
+					secret:       testWebhookSecret,
+					body:         `not json`,
+					expectedCode: http.StatusBadRequest,
+				},
+			},
+		},
+		{
+			description: "replayed event",
+			deliveries: []delivery{
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusNoContent,
+				},
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusUnauthorized,
+				},
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"2","event":"item/updated"}`,
+					expectedCode: http.StatusNoContent,
+				},
+			},
+		},
+		{
+			description: "retry of a failed delivery",
+			deliveries: []delivery{
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					fails:        true,
+					expectedCode: http.StatusInternalServerError,
+				},
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"1","event":"item/updated"}`,
+					expectedCode: http.StatusNoContent,
+				},
+			},
+		},
+		{
+			description: "payload without event id",
+			deliveries: []delivery{
+				{
+					secret:       testWebhookSecret,
+					body:         `{"event":"item/updated"}`,
+					expectedCode: http.StatusBadRequest,
+				},
+				{
+					secret:       testWebhookSecret,
+					body:         `{"eventId":"","event":"item/updated"}`,
+					expectedCode: http.StatusBadRequest,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewMiddleware(
+				&env.Env{OpenFinanceWebhookSecret: testWebhookSecret},
+				nil,
+				memcache.NewMemCache(),
+			)
+
+			app := fiber.New(fiber.Config{ErrorHandler: m.ErrorHandler})
+			app.Post("/webhook", m.Webhook(), func(c *fiber.Ctx) error {
+				if c.Get("X-Fail") != "" {
+					return errs.New("handler failed")
+				}
+				return c.SendStatus(http.StatusNoContent)
+			})
+
+			for _, d := range test.deliveries {
+				req := httptest.NewRequest(
+					http.MethodPost,
+					"/webhook",
+					strings.NewReader(d.body),
+				)
+				req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+				if d.secret != "" {
+					req.Header.Set(HeaderWebhookSecret, d.secret)
+				}
+				if d.fails {
+					req.Header.Set("X-Fail", "true")
+				}
+
+				res, err := app.Test(req, -1)
+				assert.Nil(t, err)
+				assert.Equal(t, d.expectedCode, res.StatusCode, d.body)
+			}
+		})
+	}
+}
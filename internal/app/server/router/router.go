@@ -25,6 +25,15 @@ type Router struct {
 	fh  *handler.FeedbackHandler
 	pmh *handler.PaymentMethodHandler
 	aih *handler.AIChatHandler
+	inh *handler.InvestmentHandler
+	rh  *handler.RuleHandler
+	tgh *handler.TagHandler
+	ath *handler.AttachmentHandler
+	rch *handler.RecurringHandler
+	tfh *handler.TransferHandler
+	mch *handler.MerchantHandler
+	imh *handler.ImportHandler
+	gh  *handler.GoalHandler
 }
 
 func NewRouter(
@@ -43,6 +52,15 @@ func NewRouter(
 	fh *handler.FeedbackHandler,
 	pmh *handler.PaymentMethodHandler,
 	aih *handler.AIChatHandler,
+	inh *handler.InvestmentHandler,
+	rh *handler.RuleHandler,
+	tgh *handler.TagHandler,
+	ath *handler.AttachmentHandler,
+	rch *handler.RecurringHandler,
+	tfh *handler.TransferHandler,
+	mch *handler.MerchantHandler,
+	imh *handler.ImportHandler,
+	gh *handler.GoalHandler,
 ) *Router {
 	return &Router{
 		e:   e,
@@ -60,6 +78,15 @@ func NewRouter(
 		fh:  fh,
 		pmh: pmh,
 		aih: aih,
+		inh: inh,
+		rh:  rh,
+		tgh: tgh,
+		ath: ath,
+		rch: rch,
+		tfh: tfh,
+		mch: mch,
+		imh: imh,
+		gh:  gh,
 	}
 }
 
@@ -78,12 +105,20 @@ func (r *Router) Register(
 	apiV1.Post("/auth/sign-in", r.ah.SignIn)
 	apiV1.Post("/auth/refresh", r.m.BearerAuthRefreshToken(), r.ah.RefreshToken)
 
+	webhooksApiV1 := apiV1.Group("/webhooks", r.m.Webhook())
+	webhooksApiV1.Post("/open-finance", r.ih.Webhook)
+	webhooksApiV1.Post("/open-finance/accounts", r.ach.Create)
+
 	adminApiV1 := apiV1.Group("/admin", r.m.BasicAuth())
 	adminApiV1.Post("/institutions/sync", r.ih.Sync)
-	adminApiV1.Post("/accounts", r.ach.Create)
 	adminApiV1.Post("/accounts/balances/sync", r.ach.Sync)
 	adminApiV1.Post("/transactions/categories/sync", r.tch.Sync)
 	adminApiV1.Post("/transactions/sync", r.th.Sync)
+	adminApiV1.Post("/investments/sync", r.inh.Sync)
+	adminApiV1.Get(
+		"/users/:user_id/accounts/sync-status",
+		r.ach.GetUserSyncStatus,
+	)
 
 	usersApiV1 := apiV1.Group("", r.m.BearerAuthAccessToken())
 
@@ -98,9 +133,25 @@ func (r *Router) Register(
 	usersApiV1.Post("/calculator/cash-vs-installments", r.ch.CashVsInstallments)
 
 	usersApiV1.Get("/transactions/categories", r.tch.List)
+	usersApiV1.Post("/transactions/categories", r.tch.Create)
+	usersApiV1.Put("/transactions/categories/:category_id", r.tch.Update)
+	usersApiV1.Delete("/transactions/categories/:category_id", r.tch.Delete)
+	usersApiV1.Put(
+		"/transactions/categories/:category_id/hidden",
+		r.tch.Hide,
+	)
+	usersApiV1.Post(
+		"/transactions/categories/:category_id/merge",
+		r.tch.Merge,
+	)
 
 	usersApiV1.Get("/institutions", r.ih.List)
+	usersApiV1.Post("/institutions/connect-token", r.ih.CreateConnectToken)
 	usersApiV1.Get("/users/institutions", r.ih.ListUserInstitutions)
+	usersApiV1.Delete(
+		"/users/institutions/:user_institution_id",
+		r.ih.DeleteUserInstitution,
+	)
 
 	usersApiV1.Post("/budgets", r.bh.Upsert)
 	usersApiV1.Get("/budgets", r.bh.Get)
@@ -115,11 +166,82 @@ func (r *Router) Register(
 	usersApiV1.Delete("/budgets", r.bh.Delete)
 
 	usersApiV1.Get("/accounts/balances", r.ach.GetBalance)
+	usersApiV1.Get("/accounts/balances/history", r.ach.GetBalanceHistory)
+	usersApiV1.Get("/accounts/sync-status", r.ach.GetSyncStatus)
+	usersApiV1.Get("/accounts/installments", r.ach.GetInstallmentCommitments)
+	usersApiV1.Get("/accounts/manual", r.ach.ListManual)
+	usersApiV1.Post("/accounts/manual", r.ach.CreateManual)
+	usersApiV1.Put("/accounts/manual/:account_id", r.ach.UpdateManual)
+	usersApiV1.Delete("/accounts/manual/:account_id", r.ach.DeleteManual)
+	usersApiV1.Get("/accounts/:account_id/bills", r.ach.ListBills)
+
+	usersApiV1.Get("/investments", r.inh.Get)
 
 	usersApiV1.Post("/transactions", r.th.Create)
 	usersApiV1.Get("/transactions", r.th.List)
+	usersApiV1.Patch("/transactions", r.th.BulkUpdate)
+	usersApiV1.Get("/transactions/export", r.th.Export)
+	usersApiV1.Get("/transactions/trash", r.th.ListTrash)
+	usersApiV1.Post(
+		"/transactions/trash/:transaction_id/restore",
+		r.th.Restore,
+	)
 	usersApiV1.Get("/transactions/:transaction_id", r.th.Get)
 	usersApiV1.Put("/transactions/:transaction_id", r.th.Update)
+	usersApiV1.Delete("/transactions/:transaction_id", r.th.Delete)
+	usersApiV1.Put("/transactions/:transaction_id/hidden", r.th.Hide)
+	usersApiV1.Post("/transactions/:transaction_id/splits", r.th.Split)
+
+	usersApiV1.Post(
+		"/transactions/:transaction_id/attachments",
+		r.ath.Upload,
+	)
+	usersApiV1.Get("/transactions/:transaction_id/attachments", r.ath.List)
+	usersApiV1.Get(
+		"/transactions/:transaction_id/attachments/:attachment_id",
+		r.ath.Download,
+	)
+	usersApiV1.Delete(
+		"/transactions/:transaction_id/attachments/:attachment_id",
+		r.ath.Delete,
+	)
+
+	usersApiV1.Post("/rules", r.rh.Create)
+	usersApiV1.Get("/rules", r.rh.List)
+	usersApiV1.Post("/rules/apply", r.rh.Apply)
+	usersApiV1.Get("/rules/:rule_id", r.rh.Get)
+	usersApiV1.Put("/rules/:rule_id", r.rh.Update)
+	usersApiV1.Delete("/rules/:rule_id", r.rh.Delete)
+
+	usersApiV1.Get("/tags", r.tgh.List)
+	usersApiV1.Post("/tags", r.tgh.Create)
+	usersApiV1.Put("/tags/:tag_id", r.tgh.Update)
+	usersApiV1.Delete("/tags/:tag_id", r.tgh.Delete)
+
+	usersApiV1.Get("/recurring", r.rch.List)
+	usersApiV1.Post("/recurring/detect", r.rch.Detect)
+
+	usersApiV1.Get("/transfers", r.tfh.List)
+	usersApiV1.Post("/transfers/match", r.tfh.Match)
+	usersApiV1.Put("/transfers/:transfer_id/confirmed", r.tfh.Confirm)
+	usersApiV1.Delete("/transfers/:transfer_id", r.tfh.Unlink)
+
+	usersApiV1.Get("/merchants", r.mch.List)
+
+	usersApiV1.Post("/imports", r.imh.Create)
+	usersApiV1.Post("/imports/preview", r.imh.Preview)
+
+	usersApiV1.Get("/goals", r.gh.List)
+	usersApiV1.Post("/goals", r.gh.Create)
+	usersApiV1.Get("/goals/:goal_id", r.gh.Get)
+	usersApiV1.Put("/goals/:goal_id", r.gh.Update)
+	usersApiV1.Delete("/goals/:goal_id", r.gh.Delete)
+	usersApiV1.Get("/goals/:goal_id/contributions", r.gh.ListContributions)
+	usersApiV1.Post("/goals/:goal_id/contributions", r.gh.CreateContribution)
+	usersApiV1.Delete(
+		"/goals/:goal_id/contributions/:contribution_id",
+		r.gh.DeleteContribution,
+	)
 
 	usersApiV1.Post("/feedbacks", r.fh.Create)
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/middleware"
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/router"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/attachment"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache/fibercache"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
@@ -31,6 +32,8 @@ func Build(
 ) *App {
 	app := fiber.New(fiber.Config{
 		ErrorHandler: m.ErrorHandler,
+		// Leaves room for the multipart overhead of the largest attachment
+		BodyLimit: int(attachment.MaxAttachmentSize) + 1<<20,
 	})
 
 	app.Use(cors.New())
@@ -12,14 +12,23 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/aichat"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/attachment"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/auth"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/budget"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/feedback"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/imports"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/paymentmethod"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/user"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/hash"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/jwtutil"
@@ -38,6 +47,9 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo/pgrepo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/localstorage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/s3storage"
 	"github.com/google/wire"
 )
 
@@ -50,6 +62,8 @@ func NewTest(
 		mockoauth.NewMockOAuth,
 		wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 		mockpluggy.NewClient,
+		wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+		localstorage.NewLocalStorage,
 		jwtutil.NewJWT,
 		hash.NewHasher,
 		googleoauth.NewGoogleOAuth,
@@ -83,6 +97,10 @@ func NewTest(
 		pgrepo.NewPaymentMethodRepo,
 		wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
 		pgrepo.NewAccountBalanceRepo,
+		wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+		pgrepo.NewCreditCardBillRepo,
+		wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+		pgrepo.NewSyncRunRepo,
 		wire.Bind(new(repo.FeedbackRepo), new(*pgrepo.FeedbackRepo)),
 		pgrepo.NewFeedbackRepo,
 		wire.Bind(new(repo.UserInstitutionRepo), new(*pgrepo.UserInstitutionRepo)),
@@ -93,6 +111,35 @@ func NewTest(
 		pgrepo.NewAIChatMessageRepo,
 		wire.Bind(new(repo.AIChatAnswerRepo), new(*pgrepo.AIChatAnswerRepo)),
 		pgrepo.NewAIChatAnswerRepo,
+		wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+		pgrepo.NewInvestmentRepo,
+		wire.Bind(
+			new(repo.InvestmentPositionRepo),
+			new(*pgrepo.InvestmentPositionRepo),
+		),
+		pgrepo.NewInvestmentPositionRepo,
+		wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+		pgrepo.NewRuleRepo,
+		wire.Bind(
+			new(repo.RecurringSeriesRepo),
+			new(*pgrepo.RecurringSeriesRepo),
+		),
+		pgrepo.NewRecurringSeriesRepo,
+		wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+		pgrepo.NewTransferRepo,
+		wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+		pgrepo.NewMerchantRepo,
+		wire.Bind(new(repo.TagRepo), new(*pgrepo.TagRepo)),
+		pgrepo.NewTagRepo,
+		wire.Bind(new(repo.GoalRepo), new(*pgrepo.GoalRepo)),
+		pgrepo.NewGoalRepo,
+		wire.Bind(
+			new(repo.GoalContributionRepo),
+			new(*pgrepo.GoalContributionRepo),
+		),
+		pgrepo.NewGoalContributionRepo,
+		wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+		pgrepo.NewAttachmentRepo,
 		wire.Bind(
 			new(repo.UserAuthProviderRepo),
 			new(*pgrepo.UserAuthProviderRepo),
@@ -101,6 +148,14 @@ func NewTest(
 		account.NewCreateAccountsUseCase,
 		account.NewGetAccountsBalanceUseCase,
 		account.NewSyncAccountsBalancesUseCase,
+		account.NewGetAccountsSyncStatusUseCase,
+		account.NewListCreditCardBillsUseCase,
+		account.NewGetInstallmentCommitmentsUseCase,
+		account.NewListManualAccountsUseCase,
+		account.NewCreateManualAccountUseCase,
+		account.NewUpdateManualAccountUseCase,
+		account.NewDeleteManualAccountUseCase,
+		account.NewGetAccountsBalanceHistoryUseCase,
 		aichat.NewListAIChatsUseCase,
 		aichat.NewCreateAIChatUseCase,
 		aichat.NewDeleteAIChatUseCase,
@@ -122,14 +177,63 @@ func NewTest(
 		feedback.NewCreateFeedbackUseCase,
 		institution.NewSyncInstitutionsUseCase,
 		institution.NewListInstitutionsUseCase,
+		institution.NewListUserInstitutionsUseCase,
+		institution.NewHandleOpenFinanceWebhookUseCase,
+		institution.NewCreateConnectTokenUseCase,
+		institution.NewDeleteUserInstitutionUseCase,
+		investment.NewSyncInvestmentsUseCase,
+		investment.NewGetInvestmentsUseCase,
 		paymentmethod.NewListPaymentMethodsUseCase,
+		rule.NewCreateRuleUseCase,
+		rule.NewUpdateRuleUseCase,
+		rule.NewDeleteRuleUseCase,
+		rule.NewGetRuleUseCase,
+		rule.NewListRulesUseCase,
+		rule.NewApplyRulesUseCase,
+		tag.NewCreateTagUseCase,
+		tag.NewUpdateTagUseCase,
+		tag.NewDeleteTagUseCase,
+		tag.NewListTagsUseCase,
+		attachment.NewUploadAttachmentUseCase,
+		attachment.NewListAttachmentsUseCase,
+		attachment.NewDownloadAttachmentUseCase,
+		attachment.NewDeleteAttachmentUseCase,
+		recurring.NewDetectRecurringSeriesUseCase,
+		recurring.NewListRecurringSeriesUseCase,
+		transfer.NewMatchTransfersUseCase,
+		transfer.NewListTransfersUseCase,
+		transfer.NewConfirmTransferUseCase,
+		transfer.NewUnlinkTransferUseCase,
+		merchant.NewListMerchantsUseCase,
+
+		imports.NewImportTransactionsUseCase,
+		goal.NewCreateGoalUseCase,
+		goal.NewUpdateGoalUseCase,
+		goal.NewDeleteGoalUseCase,
+		goal.NewGetGoalUseCase,
+		goal.NewListGoalsUseCase,
+		goal.NewCreateGoalContributionUseCase,
+		goal.NewListGoalContributionsUseCase,
+		goal.NewDeleteGoalContributionUseCase,
 		transaction.NewSyncTransactionsUseCase,
 		transaction.NewListTransactionsUseCase,
 		transaction.NewGetTransactionUseCase,
 		transaction.NewUpdateTransactionUseCase,
+		transaction.NewSplitTransactionUseCase,
 		transaction.NewCreateTransactionUseCase,
+		transaction.NewDeleteTransactionUseCase,
+		transaction.NewHideTransactionUseCase,
+		transaction.NewListDeletedTransactionsUseCase,
+		transaction.NewRestoreTransactionUseCase,
+		transaction.NewBulkUpdateTransactionsUseCase,
+		transaction.NewExportTransactionsUseCase,
 		transactioncategory.NewSyncTransactionCategoriesUseCase,
 		transactioncategory.NewListTransactionCategoriesUseCase,
+		transactioncategory.NewCreateTransactionCategoryUseCase,
+		transactioncategory.NewUpdateTransactionCategoryUseCase,
+		transactioncategory.NewDeleteTransactionCategoryUseCase,
+		transactioncategory.NewHideTransactionCategoryUseCase,
+		transactioncategory.NewMergeTransactionCategoriesUseCase,
 		user.NewGetUserUseCase,
 		user.NewUpdateUserUseCase,
 		user.NewDeleteUserUseCase,
@@ -145,6 +249,15 @@ func NewTest(
 		handler.NewFeedbackHandler,
 		handler.NewPaymentMethodHandler,
 		handler.NewAIChatHandler,
+		handler.NewInvestmentHandler,
+		handler.NewRuleHandler,
+		handler.NewTagHandler,
+		handler.NewAttachmentHandler,
+		handler.NewRecurringHandler,
+		handler.NewTransferHandler,
+		handler.NewMerchantHandler,
+		handler.NewImportHandler,
+		handler.NewGoalHandler,
 		handler.NewHealthHandler,
 		middleware.NewMiddleware,
 		router.NewRouter,
@@ -161,6 +274,8 @@ func NewProd(
 	wire.Build(
 		wire.Value((*mockoauth.MockOAuth)(nil)),
 		wire.Bind(new(openfinance.Client), new(*pluggy.Client)),
+		wire.Bind(new(storage.Storage), new(*s3storage.S3Storage)),
+		s3storage.NewS3Storage,
 		jwtutil.NewJWT,
 		hash.NewHasher,
 		googleoauth.NewGoogleOAuth,
@@ -194,6 +309,10 @@ func NewProd(
 		pgrepo.NewPaymentMethodRepo,
 		wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
 		pgrepo.NewAccountBalanceRepo,
+		wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+		pgrepo.NewCreditCardBillRepo,
+		wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+		pgrepo.NewSyncRunRepo,
 		wire.Bind(new(repo.FeedbackRepo), new(*pgrepo.FeedbackRepo)),
 		pgrepo.NewFeedbackRepo,
 		wire.Bind(new(repo.UserInstitutionRepo), new(*pgrepo.UserInstitutionRepo)),
@@ -204,6 +323,35 @@ func NewProd(
 		pgrepo.NewAIChatMessageRepo,
 		wire.Bind(new(repo.AIChatAnswerRepo), new(*pgrepo.AIChatAnswerRepo)),
 		pgrepo.NewAIChatAnswerRepo,
+		wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+		pgrepo.NewInvestmentRepo,
+		wire.Bind(
+			new(repo.InvestmentPositionRepo),
+			new(*pgrepo.InvestmentPositionRepo),
+		),
+		pgrepo.NewInvestmentPositionRepo,
+		wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+		pgrepo.NewRuleRepo,
+		wire.Bind(
+			new(repo.RecurringSeriesRepo),
+			new(*pgrepo.RecurringSeriesRepo),
+		),
+		pgrepo.NewRecurringSeriesRepo,
+		wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+		pgrepo.NewTransferRepo,
+		wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+		pgrepo.NewMerchantRepo,
+		wire.Bind(new(repo.TagRepo), new(*pgrepo.TagRepo)),
+		pgrepo.NewTagRepo,
+		wire.Bind(new(repo.GoalRepo), new(*pgrepo.GoalRepo)),
+		pgrepo.NewGoalRepo,
+		wire.Bind(
+			new(repo.GoalContributionRepo),
+			new(*pgrepo.GoalContributionRepo),
+		),
+		pgrepo.NewGoalContributionRepo,
+		wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+		pgrepo.NewAttachmentRepo,
 		wire.Bind(
 			new(repo.UserAuthProviderRepo),
 			new(*pgrepo.UserAuthProviderRepo),
@@ -212,6 +360,14 @@ func NewProd(
 		account.NewCreateAccountsUseCase,
 		account.NewGetAccountsBalanceUseCase,
 		account.NewSyncAccountsBalancesUseCase,
+		account.NewGetAccountsSyncStatusUseCase,
+		account.NewListCreditCardBillsUseCase,
+		account.NewGetInstallmentCommitmentsUseCase,
+		account.NewListManualAccountsUseCase,
+		account.NewCreateManualAccountUseCase,
+		account.NewUpdateManualAccountUseCase,
+		account.NewDeleteManualAccountUseCase,
+		account.NewGetAccountsBalanceHistoryUseCase,
 		aichat.NewListAIChatsUseCase,
 		aichat.NewCreateAIChatUseCase,
 		aichat.NewDeleteAIChatUseCase,
@@ -233,14 +389,63 @@ func NewProd(
 		feedback.NewCreateFeedbackUseCase,
 		institution.NewSyncInstitutionsUseCase,
 		institution.NewListInstitutionsUseCase,
+		institution.NewListUserInstitutionsUseCase,
+		institution.NewHandleOpenFinanceWebhookUseCase,
+		institution.NewCreateConnectTokenUseCase,
+		institution.NewDeleteUserInstitutionUseCase,
+		investment.NewSyncInvestmentsUseCase,
+		investment.NewGetInvestmentsUseCase,
 		paymentmethod.NewListPaymentMethodsUseCase,
+		rule.NewCreateRuleUseCase,
+		rule.NewUpdateRuleUseCase,
+		rule.NewDeleteRuleUseCase,
+		rule.NewGetRuleUseCase,
+		rule.NewListRulesUseCase,
+		rule.NewApplyRulesUseCase,
+		tag.NewCreateTagUseCase,
+		tag.NewUpdateTagUseCase,
+		tag.NewDeleteTagUseCase,
+		tag.NewListTagsUseCase,
+		attachment.NewUploadAttachmentUseCase,
+		attachment.NewListAttachmentsUseCase,
+		attachment.NewDownloadAttachmentUseCase,
+		attachment.NewDeleteAttachmentUseCase,
+		recurring.NewDetectRecurringSeriesUseCase,
+		recurring.NewListRecurringSeriesUseCase,
+		transfer.NewMatchTransfersUseCase,
+		transfer.NewListTransfersUseCase,
+		transfer.NewConfirmTransferUseCase,
+		transfer.NewUnlinkTransferUseCase,
+		merchant.NewListMerchantsUseCase,
+
+		imports.NewImportTransactionsUseCase,
+		goal.NewCreateGoalUseCase,
+		goal.NewUpdateGoalUseCase,
+		goal.NewDeleteGoalUseCase,
+		goal.NewGetGoalUseCase,
+		goal.NewListGoalsUseCase,
+		goal.NewCreateGoalContributionUseCase,
+		goal.NewListGoalContributionsUseCase,
+		goal.NewDeleteGoalContributionUseCase,
 		transaction.NewSyncTransactionsUseCase,
 		transaction.NewListTransactionsUseCase,
 		transaction.NewGetTransactionUseCase,
 		transaction.NewUpdateTransactionUseCase,
+		transaction.NewSplitTransactionUseCase,
 		transaction.NewCreateTransactionUseCase,
+		transaction.NewDeleteTransactionUseCase,
+		transaction.NewHideTransactionUseCase,
+		transaction.NewListDeletedTransactionsUseCase,
+		transaction.NewRestoreTransactionUseCase,
+		transaction.NewBulkUpdateTransactionsUseCase,
+		transaction.NewExportTransactionsUseCase,
 		transactioncategory.NewSyncTransactionCategoriesUseCase,
 		transactioncategory.NewListTransactionCategoriesUseCase,
+		transactioncategory.NewCreateTransactionCategoryUseCase,
+		transactioncategory.NewUpdateTransactionCategoryUseCase,
+		transactioncategory.NewDeleteTransactionCategoryUseCase,
+		transactioncategory.NewHideTransactionCategoryUseCase,
+		transactioncategory.NewMergeTransactionCategoriesUseCase,
 		user.NewGetUserUseCase,
 		user.NewUpdateUserUseCase,
 		user.NewDeleteUserUseCase,
@@ -256,6 +461,15 @@ func NewProd(
 		handler.NewFeedbackHandler,
 		handler.NewPaymentMethodHandler,
 		handler.NewAIChatHandler,
+		handler.NewInvestmentHandler,
+		handler.NewRuleHandler,
+		handler.NewTagHandler,
+		handler.NewAttachmentHandler,
+		handler.NewRecurringHandler,
+		handler.NewTransferHandler,
+		handler.NewMerchantHandler,
+		handler.NewImportHandler,
+		handler.NewGoalHandler,
 		handler.NewHealthHandler,
 		middleware.NewMiddleware,
 		router.NewRouter,
@@ -273,6 +487,8 @@ func NewDev(
 		mockoauth.NewMockOAuth,
 		wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 		mockpluggy.NewClient,
+		wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+		localstorage.NewLocalStorage,
 		jwtutil.NewJWT,
 		hash.NewHasher,
 		googleoauth.NewGoogleOAuth,
@@ -306,6 +522,10 @@ func NewDev(
 		pgrepo.NewPaymentMethodRepo,
 		wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
 		pgrepo.NewAccountBalanceRepo,
+		wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+		pgrepo.NewCreditCardBillRepo,
+		wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+		pgrepo.NewSyncRunRepo,
 		wire.Bind(new(repo.FeedbackRepo), new(*pgrepo.FeedbackRepo)),
 		pgrepo.NewFeedbackRepo,
 		wire.Bind(new(repo.UserInstitutionRepo), new(*pgrepo.UserInstitutionRepo)),
@@ -316,6 +536,35 @@ func NewDev(
 		pgrepo.NewAIChatMessageRepo,
 		wire.Bind(new(repo.AIChatAnswerRepo), new(*pgrepo.AIChatAnswerRepo)),
 		pgrepo.NewAIChatAnswerRepo,
+		wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+		pgrepo.NewInvestmentRepo,
+		wire.Bind(
+			new(repo.InvestmentPositionRepo),
+			new(*pgrepo.InvestmentPositionRepo),
+		),
+		pgrepo.NewInvestmentPositionRepo,
+		wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+		pgrepo.NewRuleRepo,
+		wire.Bind(
+			new(repo.RecurringSeriesRepo),
+			new(*pgrepo.RecurringSeriesRepo),
+		),
+		pgrepo.NewRecurringSeriesRepo,
+		wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+		pgrepo.NewTransferRepo,
+		wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+		pgrepo.NewMerchantRepo,
+		wire.Bind(new(repo.TagRepo), new(*pgrepo.TagRepo)),
+		pgrepo.NewTagRepo,
+		wire.Bind(new(repo.GoalRepo), new(*pgrepo.GoalRepo)),
+		pgrepo.NewGoalRepo,
+		wire.Bind(
+			new(repo.GoalContributionRepo),
+			new(*pgrepo.GoalContributionRepo),
+		),
+		pgrepo.NewGoalContributionRepo,
+		wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+		pgrepo.NewAttachmentRepo,
 		wire.Bind(
 			new(repo.UserAuthProviderRepo),
 			new(*pgrepo.UserAuthProviderRepo),
@@ -324,6 +573,14 @@ func NewDev(
 		account.NewCreateAccountsUseCase,
 		account.NewGetAccountsBalanceUseCase,
 		account.NewSyncAccountsBalancesUseCase,
+		account.NewGetAccountsSyncStatusUseCase,
+		account.NewListCreditCardBillsUseCase,
+		account.NewGetInstallmentCommitmentsUseCase,
+		account.NewListManualAccountsUseCase,
+		account.NewCreateManualAccountUseCase,
+		account.NewUpdateManualAccountUseCase,
+		account.NewDeleteManualAccountUseCase,
+		account.NewGetAccountsBalanceHistoryUseCase,
 		aichat.NewListAIChatsUseCase,
 		aichat.NewCreateAIChatUseCase,
 		aichat.NewDeleteAIChatUseCase,
@@ -345,14 +602,63 @@ func NewDev(
 		feedback.NewCreateFeedbackUseCase,
 		institution.NewSyncInstitutionsUseCase,
 		institution.NewListInstitutionsUseCase,
+		institution.NewListUserInstitutionsUseCase,
+		institution.NewHandleOpenFinanceWebhookUseCase,
+		institution.NewCreateConnectTokenUseCase,
+		institution.NewDeleteUserInstitutionUseCase,
+		investment.NewSyncInvestmentsUseCase,
+		investment.NewGetInvestmentsUseCase,
 		paymentmethod.NewListPaymentMethodsUseCase,
+		rule.NewCreateRuleUseCase,
+		rule.NewUpdateRuleUseCase,
+		rule.NewDeleteRuleUseCase,
+		rule.NewGetRuleUseCase,
+		rule.NewListRulesUseCase,
+		rule.NewApplyRulesUseCase,
+		tag.NewCreateTagUseCase,
+		tag.NewUpdateTagUseCase,
+		tag.NewDeleteTagUseCase,
+		tag.NewListTagsUseCase,
+		attachment.NewUploadAttachmentUseCase,
+		attachment.NewListAttachmentsUseCase,
+		attachment.NewDownloadAttachmentUseCase,
+		attachment.NewDeleteAttachmentUseCase,
+		recurring.NewDetectRecurringSeriesUseCase,
+		recurring.NewListRecurringSeriesUseCase,
+		transfer.NewMatchTransfersUseCase,
+		transfer.NewListTransfersUseCase,
+		transfer.NewConfirmTransferUseCase,
+		transfer.NewUnlinkTransferUseCase,
+		merchant.NewListMerchantsUseCase,
+
+		imports.NewImportTransactionsUseCase,
+		goal.NewCreateGoalUseCase,
+		goal.NewUpdateGoalUseCase,
+		goal.NewDeleteGoalUseCase,
+		goal.NewGetGoalUseCase,
+		goal.NewListGoalsUseCase,
+		goal.NewCreateGoalContributionUseCase,
+		goal.NewListGoalContributionsUseCase,
+		goal.NewDeleteGoalContributionUseCase,
 		transaction.NewSyncTransactionsUseCase,
 		transaction.NewListTransactionsUseCase,
 		transaction.NewGetTransactionUseCase,
 		transaction.NewUpdateTransactionUseCase,
+		transaction.NewSplitTransactionUseCase,
 		transaction.NewCreateTransactionUseCase,
+		transaction.NewDeleteTransactionUseCase,
+		transaction.NewHideTransactionUseCase,
+		transaction.NewListDeletedTransactionsUseCase,
+		transaction.NewRestoreTransactionUseCase,
+		transaction.NewBulkUpdateTransactionsUseCase,
+		transaction.NewExportTransactionsUseCase,
 		transactioncategory.NewSyncTransactionCategoriesUseCase,
 		transactioncategory.NewListTransactionCategoriesUseCase,
+		transactioncategory.NewCreateTransactionCategoryUseCase,
+		transactioncategory.NewUpdateTransactionCategoryUseCase,
+		transactioncategory.NewDeleteTransactionCategoryUseCase,
+		transactioncategory.NewHideTransactionCategoryUseCase,
+		transactioncategory.NewMergeTransactionCategoriesUseCase,
 		user.NewGetUserUseCase,
 		user.NewUpdateUserUseCase,
 		user.NewDeleteUserUseCase,
@@ -368,6 +674,15 @@ func NewDev(
 		handler.NewFeedbackHandler,
 		handler.NewPaymentMethodHandler,
 		handler.NewAIChatHandler,
+		handler.NewInvestmentHandler,
+		handler.NewRuleHandler,
+		handler.NewTagHandler,
+		handler.NewAttachmentHandler,
+		handler.NewRecurringHandler,
+		handler.NewTransferHandler,
+		handler.NewMerchantHandler,
+		handler.NewImportHandler,
+		handler.NewGoalHandler,
 		handler.NewHealthHandler,
 		middleware.NewMiddleware,
 		router.NewRouter,
@@ -385,6 +700,8 @@ func NewStaging(
 		mockoauth.NewMockOAuth,
 		wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 		mockpluggy.NewClient,
+		wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+		localstorage.NewLocalStorage,
 		jwtutil.NewJWT,
 		hash.NewHasher,
 		googleoauth.NewGoogleOAuth,
@@ -418,6 +735,10 @@ func NewStaging(
 		pgrepo.NewPaymentMethodRepo,
 		wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
 		pgrepo.NewAccountBalanceRepo,
+		wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+		pgrepo.NewCreditCardBillRepo,
+		wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+		pgrepo.NewSyncRunRepo,
 		wire.Bind(new(repo.FeedbackRepo), new(*pgrepo.FeedbackRepo)),
 		pgrepo.NewFeedbackRepo,
 		wire.Bind(new(repo.UserInstitutionRepo), new(*pgrepo.UserInstitutionRepo)),
@@ -428,6 +749,35 @@ func NewStaging(
 		pgrepo.NewAIChatMessageRepo,
 		wire.Bind(new(repo.AIChatAnswerRepo), new(*pgrepo.AIChatAnswerRepo)),
 		pgrepo.NewAIChatAnswerRepo,
+		wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+		pgrepo.NewInvestmentRepo,
+		wire.Bind(
+			new(repo.InvestmentPositionRepo),
+			new(*pgrepo.InvestmentPositionRepo),
+		),
+		pgrepo.NewInvestmentPositionRepo,
+		wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+		pgrepo.NewRuleRepo,
+		wire.Bind(
+			new(repo.RecurringSeriesRepo),
+			new(*pgrepo.RecurringSeriesRepo),
+		),
+		pgrepo.NewRecurringSeriesRepo,
+		wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+		pgrepo.NewTransferRepo,
+		wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+		pgrepo.NewMerchantRepo,
+		wire.Bind(new(repo.TagRepo), new(*pgrepo.TagRepo)),
+		pgrepo.NewTagRepo,
+		wire.Bind(new(repo.GoalRepo), new(*pgrepo.GoalRepo)),
+		pgrepo.NewGoalRepo,
+		wire.Bind(
+			new(repo.GoalContributionRepo),
+			new(*pgrepo.GoalContributionRepo),
+		),
+		pgrepo.NewGoalContributionRepo,
+		wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+		pgrepo.NewAttachmentRepo,
 		wire.Bind(
 			new(repo.UserAuthProviderRepo),
 			new(*pgrepo.UserAuthProviderRepo),
@@ -436,6 +786,14 @@ func NewStaging(
 		account.NewCreateAccountsUseCase,
 		account.NewGetAccountsBalanceUseCase,
 		account.NewSyncAccountsBalancesUseCase,
+		account.NewGetAccountsSyncStatusUseCase,
+		account.NewListCreditCardBillsUseCase,
+		account.NewGetInstallmentCommitmentsUseCase,
+		account.NewListManualAccountsUseCase,
+		account.NewCreateManualAccountUseCase,
+		account.NewUpdateManualAccountUseCase,
+		account.NewDeleteManualAccountUseCase,
+		account.NewGetAccountsBalanceHistoryUseCase,
 		aichat.NewListAIChatsUseCase,
 		aichat.NewCreateAIChatUseCase,
 		aichat.NewDeleteAIChatUseCase,
@@ -457,14 +815,63 @@ func NewStaging(
 		feedback.NewCreateFeedbackUseCase,
 		institution.NewSyncInstitutionsUseCase,
 		institution.NewListInstitutionsUseCase,
+		institution.NewListUserInstitutionsUseCase,
+		institution.NewHandleOpenFinanceWebhookUseCase,
+		institution.NewCreateConnectTokenUseCase,
+		institution.NewDeleteUserInstitutionUseCase,
+		investment.NewSyncInvestmentsUseCase,
+		investment.NewGetInvestmentsUseCase,
 		paymentmethod.NewListPaymentMethodsUseCase,
+		rule.NewCreateRuleUseCase,
+		rule.NewUpdateRuleUseCase,
+		rule.NewDeleteRuleUseCase,
+		rule.NewGetRuleUseCase,
+		rule.NewListRulesUseCase,
+		rule.NewApplyRulesUseCase,
+		tag.NewCreateTagUseCase,
+		tag.NewUpdateTagUseCase,
+		tag.NewDeleteTagUseCase,
+		tag.NewListTagsUseCase,
+		attachment.NewUploadAttachmentUseCase,
+		attachment.NewListAttachmentsUseCase,
+		attachment.NewDownloadAttachmentUseCase,
+		attachment.NewDeleteAttachmentUseCase,
+		recurring.NewDetectRecurringSeriesUseCase,
+		recurring.NewListRecurringSeriesUseCase,
+		transfer.NewMatchTransfersUseCase,
+		transfer.NewListTransfersUseCase,
+		transfer.NewConfirmTransferUseCase,
+		transfer.NewUnlinkTransferUseCase,
+		merchant.NewListMerchantsUseCase,
+
+		imports.NewImportTransactionsUseCase,
+		goal.NewCreateGoalUseCase,
+		goal.NewUpdateGoalUseCase,
+		goal.NewDeleteGoalUseCase,
+		goal.NewGetGoalUseCase,
+		goal.NewListGoalsUseCase,
+		goal.NewCreateGoalContributionUseCase,
+		goal.NewListGoalContributionsUseCase,
+		goal.NewDeleteGoalContributionUseCase,
 		transaction.NewSyncTransactionsUseCase,
 		transaction.NewListTransactionsUseCase,
 		transaction.NewGetTransactionUseCase,
 		transaction.NewUpdateTransactionUseCase,
+		transaction.NewSplitTransactionUseCase,
 		transaction.NewCreateTransactionUseCase,
+		transaction.NewDeleteTransactionUseCase,
+		transaction.NewHideTransactionUseCase,
+		transaction.NewListDeletedTransactionsUseCase,
+		transaction.NewRestoreTransactionUseCase,
+		transaction.NewBulkUpdateTransactionsUseCase,
+		transaction.NewExportTransactionsUseCase,
 		transactioncategory.NewSyncTransactionCategoriesUseCase,
 		transactioncategory.NewListTransactionCategoriesUseCase,
+		transactioncategory.NewCreateTransactionCategoryUseCase,
+		transactioncategory.NewUpdateTransactionCategoryUseCase,
+		transactioncategory.NewDeleteTransactionCategoryUseCase,
+		transactioncategory.NewHideTransactionCategoryUseCase,
+		transactioncategory.NewMergeTransactionCategoriesUseCase,
 		user.NewGetUserUseCase,
 		user.NewUpdateUserUseCase,
 		user.NewDeleteUserUseCase,
@@ -480,6 +887,15 @@ func NewStaging(
 		handler.NewFeedbackHandler,
 		handler.NewPaymentMethodHandler,
 		handler.NewAIChatHandler,
+		handler.NewInvestmentHandler,
+		handler.NewRuleHandler,
+		handler.NewTagHandler,
+		handler.NewAttachmentHandler,
+		handler.NewRecurringHandler,
+		handler.NewTransferHandler,
+		handler.NewMerchantHandler,
+		handler.NewImportHandler,
+		handler.NewGoalHandler,
 		handler.NewHealthHandler,
 		middleware.NewMiddleware,
 		router.NewRouter,
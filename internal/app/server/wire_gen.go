@@ -13,14 +13,23 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/aichat"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/attachment"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/auth"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/budget"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/feedback"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/imports"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/paymentmethod"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/user"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/hash"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/jwtutil"
@@ -35,6 +44,8 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/mockpluggy"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo/pgrepo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/localstorage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/s3storage"
 )
 
 // Injectors from wire.go:
@@ -42,7 +53,8 @@ import (
 // NewTest wires up the application in test mode.
 func NewTest(v *validator.Validator, e *env.Env) *App {
 	jwt := jwtutil.NewJWT(e)
-	middlewareMiddleware := middleware.NewMiddleware(e, jwt)
+	redisCache := rediscache.NewRedisCache(e)
+	middlewareMiddleware := middleware.NewMiddleware(e, jwt, redisCache)
 	healthHandler := handler.NewHealthHandler()
 	docHandler := handler.NewDocHandler()
 	pool := db.NewPGXPool(e)
@@ -69,15 +81,38 @@ func NewTest(v *validator.Validator, e *env.Env) *App {
 	institutionRepo := pgrepo.NewInstitutionRepo(dbDB)
 	syncInstitutionsUseCase := institution.NewSyncInstitutionsUseCase(mockpluggyClient, institutionRepo)
 	listInstitutionsUseCase := institution.NewListInstitutionsUseCase(institutionRepo)
-	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase)
+	listUserInstitutionsUseCase := institution.NewListUserInstitutionsUseCase(institutionRepo)
+	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
+	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
+	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
 	transactionCategoryRepo := pgrepo.NewCategoryRepo(dbDB)
+	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
+	syncRunRepo := pgrepo.NewSyncRunRepo(dbDB, queryBuilder)
+	creditCardBillRepo := pgrepo.NewCreditCardBillRepo(dbDB)
+	ruleRepo := pgrepo.NewRuleRepo(dbDB)
+	recurringSeriesRepo := pgrepo.NewRecurringSeriesRepo(dbDB)
+	detectRecurringSeriesUseCase := recurring.NewDetectRecurringSeriesUseCase(pgxTX, transactionRepo, recurringSeriesRepo)
+	transferRepo := pgrepo.NewTransferRepo(dbDB)
+	matchTransfersUseCase := transfer.NewMatchTransfersUseCase(pgxTX, transactionRepo, transferRepo)
+	merchantRepo := pgrepo.NewMerchantRepo(dbDB)
+	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo, syncRunRepo, creditCardBillRepo, ruleRepo, merchantRepo, detectRecurringSeriesUseCase, matchTransfersUseCase)
+	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
+	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
+	handleOpenFinanceWebhookUseCase := institution.NewHandleOpenFinanceWebhookUseCase(v, pgxTX, redisCache, accountRepo, userInstitutionRepo, syncTransactionsUseCase, syncAccountsBalancesUseCase)
+	createConnectTokenUseCase := institution.NewCreateConnectTokenUseCase(v, mockpluggyClient, userInstitutionRepo)
+	deleteUserInstitutionUseCase := institution.NewDeleteUserInstitutionUseCase(v, mockpluggyClient, pgxTX, accountRepo, transactionRepo, userInstitutionRepo)
+	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase, listUserInstitutionsUseCase, handleOpenFinanceWebhookUseCase, createConnectTokenUseCase, deleteUserInstitutionUseCase)
 	syncTransactionCategoriesUseCase := transactioncategory.NewSyncTransactionCategoriesUseCase(mockpluggyClient, transactionCategoryRepo)
 	listTransactionCategoriesUseCase := transactioncategory.NewListTransactionCategoriesUseCase(transactionCategoryRepo)
-	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase)
+	createTransactionCategoryUseCase := transactioncategory.NewCreateTransactionCategoryUseCase(v, transactionCategoryRepo)
+	updateTransactionCategoryUseCase := transactioncategory.NewUpdateTransactionCategoryUseCase(v, transactionCategoryRepo)
 	budgetRepo := pgrepo.NewBudgetRepo(dbDB)
+	deleteTransactionCategoryUseCase := transactioncategory.NewDeleteTransactionCategoryUseCase(pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	hideTransactionCategoryUseCase := transactioncategory.NewHideTransactionCategoryUseCase(transactionCategoryRepo)
+	mergeTransactionCategoriesUseCase := transactioncategory.NewMergeTransactionCategoriesUseCase(v, pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase, createTransactionCategoryUseCase, updateTransactionCategoryUseCase, deleteTransactionCategoryUseCase, hideTransactionCategoryUseCase, mergeTransactionCategoriesUseCase)
 	upsertBudgetUseCase := budget.NewUpsertBudgetUseCase(v, pgxTX, budgetRepo, transactionCategoryRepo)
-	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
-	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo)
+	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo, recurringSeriesRepo)
 	getBudgetCategoryUseCase := budget.NewGetBudgetCategoryUseCase(v, budgetRepo, transactionRepo, transactionCategoryRepo)
 	deleteBudgetUseCase := budget.NewDeleteBudgetUseCase(pgxTX, budgetRepo)
 	listTransactionsUseCase := transaction.NewListTransactionsUseCase(v, transactionRepo)
@@ -85,22 +120,33 @@ func NewTest(v *validator.Validator, e *env.Env) *App {
 	budgetHandler := handler.NewBudgetHandler(upsertBudgetUseCase, getBudgetUseCase, getBudgetCategoryUseCase, deleteBudgetUseCase, listBudgetCategoryTransactionsUseCase)
 	getUserUseCase := user.NewGetUserUseCase(userRepo)
 	updateUserUseCase := user.NewUpdateUserUseCase(v, userRepo)
-	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, userRepo)
+	localStorage := localstorage.NewLocalStorage(e)
+	attachmentRepo := pgrepo.NewAttachmentRepo(dbDB)
+	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, localStorage, userRepo, attachmentRepo)
 	userHandler := handler.NewUserHandler(getUserUseCase, updateUserUseCase, deleteUserUseCase)
-	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
-	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
-	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
 	createAccountsUseCase := account.NewCreateAccountsUseCase(v, mockpluggyClient, pgxTX, accountRepo, accountBalanceRepo, institutionRepo, userInstitutionRepo)
-	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo)
-	redisCache := rediscache.NewRedisCache(e)
-	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
-	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase)
-	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
-	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo)
+	tagRepo := pgrepo.NewTagRepo(dbDB)
+	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo, tagRepo)
+	getAccountsSyncStatusUseCase := account.NewGetAccountsSyncStatusUseCase(v, syncRunRepo)
+	listCreditCardBillsUseCase := account.NewListCreditCardBillsUseCase(v, accountRepo, transactionRepo, creditCardBillRepo)
+	getInstallmentCommitmentsUseCase := account.NewGetInstallmentCommitmentsUseCase(v, transactionRepo)
+	listManualAccountsUseCase := account.NewListManualAccountsUseCase(accountRepo)
+	createManualAccountUseCase := account.NewCreateManualAccountUseCase(v, accountRepo)
+	updateManualAccountUseCase := account.NewUpdateManualAccountUseCase(v, accountRepo)
+	deleteManualAccountUseCase := account.NewDeleteManualAccountUseCase(pgxTX, accountRepo, transactionRepo)
+	getAccountsBalanceHistoryUseCase := account.NewGetAccountsBalanceHistoryUseCase(v, accountBalanceRepo)
+	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase, getAccountsSyncStatusUseCase, listCreditCardBillsUseCase, getInstallmentCommitmentsUseCase, listManualAccountsUseCase, createManualAccountUseCase, updateManualAccountUseCase, deleteManualAccountUseCase, getAccountsBalanceHistoryUseCase)
 	getTransactionUseCase := transaction.NewGetTransactionUseCase(transactionRepo)
-	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, transactionRepo)
-	createTransactionUseCase := transaction.NewCreateTransactionUseCase(v, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo)
-	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase)
+	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	createTransactionUseCase := transaction.NewCreateTransactionUseCase(e, v, pgxTX, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo, tagRepo, accountRepo)
+	splitTransactionUseCase := transaction.NewSplitTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo)
+	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(v, transactionRepo)
+	hideTransactionUseCase := transaction.NewHideTransactionUseCase(v, transactionRepo)
+	listDeletedTransactionsUseCase := transaction.NewListDeletedTransactionsUseCase(transactionRepo)
+	restoreTransactionUseCase := transaction.NewRestoreTransactionUseCase(v, transactionRepo)
+	bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(v, transactionRepo, userRepo)
+	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase, splitTransactionUseCase, deleteTransactionUseCase, hideTransactionUseCase, listDeletedTransactionsUseCase, restoreTransactionUseCase, bulkUpdateTransactionsUseCase, exportTransactionsUseCase)
 	feedbackRepo := pgrepo.NewFeedbackRepo(dbDB)
 	createFeedbackUseCase := feedback.NewCreateFeedbackUseCase(v, feedbackRepo)
 	feedbackHandler := handler.NewFeedbackHandler(createFeedbackUseCase)
@@ -115,9 +161,52 @@ func NewTest(v *validator.Validator, e *env.Env) *App {
 	openAI := openai.NewOpenAI(e)
 	aiChatMessageRepo := pgrepo.NewAIChatMessageRepo(dbDB)
 	aiChatAnswerRepo := pgrepo.NewAIChatAnswerRepo(dbDB)
-	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase)
+	listRecurringSeriesUseCase := recurring.NewListRecurringSeriesUseCase(recurringSeriesRepo)
+	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase, listRecurringSeriesUseCase)
 	aiChatHandler := handler.NewAIChatHandler(createAIChatUseCase, deleteAIChatUseCase, updateAIChatUseCase, listAIChatsUseCase, listAIChatMessagesAndAnswersUseCase, generateAIChatMessageUseCase)
-	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler)
+	investmentRepo := pgrepo.NewInvestmentRepo(dbDB)
+	getInvestmentsUseCase := investment.NewGetInvestmentsUseCase(v, investmentRepo)
+	investmentPositionRepo := pgrepo.NewInvestmentPositionRepo(dbDB)
+	syncInvestmentsUseCase := investment.NewSyncInvestmentsUseCase(e, pgxTX, mockpluggyClient, redisCache, userInstitutionRepo, investmentRepo, investmentPositionRepo)
+	investmentHandler := handler.NewInvestmentHandler(getInvestmentsUseCase, syncInvestmentsUseCase)
+	createRuleUseCase := rule.NewCreateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	updateRuleUseCase := rule.NewUpdateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	deleteRuleUseCase := rule.NewDeleteRuleUseCase(ruleRepo)
+	getRuleUseCase := rule.NewGetRuleUseCase(ruleRepo)
+	listRulesUseCase := rule.NewListRulesUseCase(ruleRepo)
+	applyRulesUseCase := rule.NewApplyRulesUseCase(e, pgxTX, ruleRepo, transactionRepo)
+	ruleHandler := handler.NewRuleHandler(createRuleUseCase, updateRuleUseCase, deleteRuleUseCase, getRuleUseCase, listRulesUseCase, applyRulesUseCase)
+	createTagUseCase := tag.NewCreateTagUseCase(v, tagRepo)
+	updateTagUseCase := tag.NewUpdateTagUseCase(v, tagRepo)
+	deleteTagUseCase := tag.NewDeleteTagUseCase(pgxTX, tagRepo)
+	listTagsUseCase := tag.NewListTagsUseCase(tagRepo)
+	tagHandler := handler.NewTagHandler(createTagUseCase, updateTagUseCase, deleteTagUseCase, listTagsUseCase)
+	uploadAttachmentUseCase := attachment.NewUploadAttachmentUseCase(v, localStorage, redisCache, attachmentRepo, transactionRepo)
+	listAttachmentsUseCase := attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo)
+	downloadAttachmentUseCase := attachment.NewDownloadAttachmentUseCase(localStorage, attachmentRepo)
+	deleteAttachmentUseCase := attachment.NewDeleteAttachmentUseCase(localStorage, attachmentRepo)
+	attachmentHandler := handler.NewAttachmentHandler(uploadAttachmentUseCase, listAttachmentsUseCase, downloadAttachmentUseCase, deleteAttachmentUseCase)
+	recurringHandler := handler.NewRecurringHandler(listRecurringSeriesUseCase, detectRecurringSeriesUseCase)
+	listTransfersUseCase := transfer.NewListTransfersUseCase(transactionRepo, transferRepo)
+	confirmTransferUseCase := transfer.NewConfirmTransferUseCase(transferRepo)
+	unlinkTransferUseCase := transfer.NewUnlinkTransferUseCase(transferRepo)
+	transferHandler := handler.NewTransferHandler(listTransfersUseCase, matchTransfersUseCase, confirmTransferUseCase, unlinkTransferUseCase)
+	listMerchantsUseCase := merchant.NewListMerchantsUseCase(v, transactionRepo, merchantRepo)
+	merchantHandler := handler.NewMerchantHandler(listMerchantsUseCase)
+	importTransactionsUseCase := imports.NewImportTransactionsUseCase(e, v, pgxTX, transactionRepo, accountRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo)
+	importHandler := handler.NewImportHandler(importTransactionsUseCase)
+	goalRepo := pgrepo.NewGoalRepo(dbDB)
+	createGoalUseCase := goal.NewCreateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	updateGoalUseCase := goal.NewUpdateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	deleteGoalUseCase := goal.NewDeleteGoalUseCase(pgxTX, goalRepo)
+	goalContributionRepo := pgrepo.NewGoalContributionRepo(dbDB)
+	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	createGoalContributionUseCase := goal.NewCreateGoalContributionUseCase(v, goalRepo, goalContributionRepo)
+	listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+	deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
+	goalHandler := handler.NewGoalHandler(createGoalUseCase, updateGoalUseCase, deleteGoalUseCase, getGoalUseCase, listGoalsUseCase, createGoalContributionUseCase, listGoalContributionsUseCase, deleteGoalContributionUseCase)
+	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler, investmentHandler, ruleHandler, tagHandler, attachmentHandler, recurringHandler, transferHandler, merchantHandler, importHandler, goalHandler)
 	app := Build(middlewareMiddleware, routerRouter, redisCache, dbDB)
 	return app
 }
@@ -125,7 +214,8 @@ func NewTest(v *validator.Validator, e *env.Env) *App {
 // NewProd wires up the application in prod mode.
 func NewProd(v *validator.Validator, e *env.Env) *App {
 	jwt := jwtutil.NewJWT(e)
-	middlewareMiddleware := middleware.NewMiddleware(e, jwt)
+	redisCache := rediscache.NewRedisCache(e)
+	middlewareMiddleware := middleware.NewMiddleware(e, jwt, redisCache)
 	healthHandler := handler.NewHealthHandler()
 	docHandler := handler.NewDocHandler()
 	pool := db.NewPGXPool(e)
@@ -151,15 +241,38 @@ func NewProd(v *validator.Validator, e *env.Env) *App {
 	institutionRepo := pgrepo.NewInstitutionRepo(dbDB)
 	syncInstitutionsUseCase := institution.NewSyncInstitutionsUseCase(client, institutionRepo)
 	listInstitutionsUseCase := institution.NewListInstitutionsUseCase(institutionRepo)
-	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase)
+	listUserInstitutionsUseCase := institution.NewListUserInstitutionsUseCase(institutionRepo)
+	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
+	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
+	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
 	transactionCategoryRepo := pgrepo.NewCategoryRepo(dbDB)
+	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
+	syncRunRepo := pgrepo.NewSyncRunRepo(dbDB, queryBuilder)
+	creditCardBillRepo := pgrepo.NewCreditCardBillRepo(dbDB)
+	ruleRepo := pgrepo.NewRuleRepo(dbDB)
+	recurringSeriesRepo := pgrepo.NewRecurringSeriesRepo(dbDB)
+	detectRecurringSeriesUseCase := recurring.NewDetectRecurringSeriesUseCase(pgxTX, transactionRepo, recurringSeriesRepo)
+	transferRepo := pgrepo.NewTransferRepo(dbDB)
+	matchTransfersUseCase := transfer.NewMatchTransfersUseCase(pgxTX, transactionRepo, transferRepo)
+	merchantRepo := pgrepo.NewMerchantRepo(dbDB)
+	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, client, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo, syncRunRepo, creditCardBillRepo, ruleRepo, merchantRepo, detectRecurringSeriesUseCase, matchTransfersUseCase)
+	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
+	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, client, redisCache, accountRepo, accountBalanceRepo)
+	handleOpenFinanceWebhookUseCase := institution.NewHandleOpenFinanceWebhookUseCase(v, pgxTX, redisCache, accountRepo, userInstitutionRepo, syncTransactionsUseCase, syncAccountsBalancesUseCase)
+	createConnectTokenUseCase := institution.NewCreateConnectTokenUseCase(v, client, userInstitutionRepo)
+	deleteUserInstitutionUseCase := institution.NewDeleteUserInstitutionUseCase(v, client, pgxTX, accountRepo, transactionRepo, userInstitutionRepo)
+	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase, listUserInstitutionsUseCase, handleOpenFinanceWebhookUseCase, createConnectTokenUseCase, deleteUserInstitutionUseCase)
 	syncTransactionCategoriesUseCase := transactioncategory.NewSyncTransactionCategoriesUseCase(client, transactionCategoryRepo)
 	listTransactionCategoriesUseCase := transactioncategory.NewListTransactionCategoriesUseCase(transactionCategoryRepo)
-	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase)
+	createTransactionCategoryUseCase := transactioncategory.NewCreateTransactionCategoryUseCase(v, transactionCategoryRepo)
+	updateTransactionCategoryUseCase := transactioncategory.NewUpdateTransactionCategoryUseCase(v, transactionCategoryRepo)
 	budgetRepo := pgrepo.NewBudgetRepo(dbDB)
+	deleteTransactionCategoryUseCase := transactioncategory.NewDeleteTransactionCategoryUseCase(pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	hideTransactionCategoryUseCase := transactioncategory.NewHideTransactionCategoryUseCase(transactionCategoryRepo)
+	mergeTransactionCategoriesUseCase := transactioncategory.NewMergeTransactionCategoriesUseCase(v, pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase, createTransactionCategoryUseCase, updateTransactionCategoryUseCase, deleteTransactionCategoryUseCase, hideTransactionCategoryUseCase, mergeTransactionCategoriesUseCase)
 	upsertBudgetUseCase := budget.NewUpsertBudgetUseCase(v, pgxTX, budgetRepo, transactionCategoryRepo)
-	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
-	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo)
+	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo, recurringSeriesRepo)
 	getBudgetCategoryUseCase := budget.NewGetBudgetCategoryUseCase(v, budgetRepo, transactionRepo, transactionCategoryRepo)
 	deleteBudgetUseCase := budget.NewDeleteBudgetUseCase(pgxTX, budgetRepo)
 	listTransactionsUseCase := transaction.NewListTransactionsUseCase(v, transactionRepo)
@@ -167,22 +280,33 @@ func NewProd(v *validator.Validator, e *env.Env) *App {
 	budgetHandler := handler.NewBudgetHandler(upsertBudgetUseCase, getBudgetUseCase, getBudgetCategoryUseCase, deleteBudgetUseCase, listBudgetCategoryTransactionsUseCase)
 	getUserUseCase := user.NewGetUserUseCase(userRepo)
 	updateUserUseCase := user.NewUpdateUserUseCase(v, userRepo)
-	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, userRepo)
+	s3Storage := s3storage.NewS3Storage(e)
+	attachmentRepo := pgrepo.NewAttachmentRepo(dbDB)
+	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, s3Storage, userRepo, attachmentRepo)
 	userHandler := handler.NewUserHandler(getUserUseCase, updateUserUseCase, deleteUserUseCase)
-	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
-	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
-	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
 	createAccountsUseCase := account.NewCreateAccountsUseCase(v, client, pgxTX, accountRepo, accountBalanceRepo, institutionRepo, userInstitutionRepo)
-	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo)
-	redisCache := rediscache.NewRedisCache(e)
-	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, client, redisCache, accountRepo, accountBalanceRepo)
-	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase)
-	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
-	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, client, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo)
+	tagRepo := pgrepo.NewTagRepo(dbDB)
+	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo, tagRepo)
+	getAccountsSyncStatusUseCase := account.NewGetAccountsSyncStatusUseCase(v, syncRunRepo)
+	listCreditCardBillsUseCase := account.NewListCreditCardBillsUseCase(v, accountRepo, transactionRepo, creditCardBillRepo)
+	getInstallmentCommitmentsUseCase := account.NewGetInstallmentCommitmentsUseCase(v, transactionRepo)
+	listManualAccountsUseCase := account.NewListManualAccountsUseCase(accountRepo)
+	createManualAccountUseCase := account.NewCreateManualAccountUseCase(v, accountRepo)
+	updateManualAccountUseCase := account.NewUpdateManualAccountUseCase(v, accountRepo)
+	deleteManualAccountUseCase := account.NewDeleteManualAccountUseCase(pgxTX, accountRepo, transactionRepo)
+	getAccountsBalanceHistoryUseCase := account.NewGetAccountsBalanceHistoryUseCase(v, accountBalanceRepo)
+	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase, getAccountsSyncStatusUseCase, listCreditCardBillsUseCase, getInstallmentCommitmentsUseCase, listManualAccountsUseCase, createManualAccountUseCase, updateManualAccountUseCase, deleteManualAccountUseCase, getAccountsBalanceHistoryUseCase)
 	getTransactionUseCase := transaction.NewGetTransactionUseCase(transactionRepo)
-	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, transactionRepo)
-	createTransactionUseCase := transaction.NewCreateTransactionUseCase(v, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo)
-	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase)
+	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	createTransactionUseCase := transaction.NewCreateTransactionUseCase(e, v, pgxTX, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo, tagRepo, accountRepo)
+	splitTransactionUseCase := transaction.NewSplitTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo)
+	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(v, transactionRepo)
+	hideTransactionUseCase := transaction.NewHideTransactionUseCase(v, transactionRepo)
+	listDeletedTransactionsUseCase := transaction.NewListDeletedTransactionsUseCase(transactionRepo)
+	restoreTransactionUseCase := transaction.NewRestoreTransactionUseCase(v, transactionRepo)
+	bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(v, transactionRepo, userRepo)
+	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase, splitTransactionUseCase, deleteTransactionUseCase, hideTransactionUseCase, listDeletedTransactionsUseCase, restoreTransactionUseCase, bulkUpdateTransactionsUseCase, exportTransactionsUseCase)
 	feedbackRepo := pgrepo.NewFeedbackRepo(dbDB)
 	createFeedbackUseCase := feedback.NewCreateFeedbackUseCase(v, feedbackRepo)
 	feedbackHandler := handler.NewFeedbackHandler(createFeedbackUseCase)
@@ -197,9 +321,52 @@ func NewProd(v *validator.Validator, e *env.Env) *App {
 	openAI := openai.NewOpenAI(e)
 	aiChatMessageRepo := pgrepo.NewAIChatMessageRepo(dbDB)
 	aiChatAnswerRepo := pgrepo.NewAIChatAnswerRepo(dbDB)
-	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase)
+	listRecurringSeriesUseCase := recurring.NewListRecurringSeriesUseCase(recurringSeriesRepo)
+	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase, listRecurringSeriesUseCase)
 	aiChatHandler := handler.NewAIChatHandler(createAIChatUseCase, deleteAIChatUseCase, updateAIChatUseCase, listAIChatsUseCase, listAIChatMessagesAndAnswersUseCase, generateAIChatMessageUseCase)
-	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler)
+	investmentRepo := pgrepo.NewInvestmentRepo(dbDB)
+	getInvestmentsUseCase := investment.NewGetInvestmentsUseCase(v, investmentRepo)
+	investmentPositionRepo := pgrepo.NewInvestmentPositionRepo(dbDB)
+	syncInvestmentsUseCase := investment.NewSyncInvestmentsUseCase(e, pgxTX, client, redisCache, userInstitutionRepo, investmentRepo, investmentPositionRepo)
+	investmentHandler := handler.NewInvestmentHandler(getInvestmentsUseCase, syncInvestmentsUseCase)
+	createRuleUseCase := rule.NewCreateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	updateRuleUseCase := rule.NewUpdateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	deleteRuleUseCase := rule.NewDeleteRuleUseCase(ruleRepo)
+	getRuleUseCase := rule.NewGetRuleUseCase(ruleRepo)
+	listRulesUseCase := rule.NewListRulesUseCase(ruleRepo)
+	applyRulesUseCase := rule.NewApplyRulesUseCase(e, pgxTX, ruleRepo, transactionRepo)
+	ruleHandler := handler.NewRuleHandler(createRuleUseCase, updateRuleUseCase, deleteRuleUseCase, getRuleUseCase, listRulesUseCase, applyRulesUseCase)
+	createTagUseCase := tag.NewCreateTagUseCase(v, tagRepo)
+	updateTagUseCase := tag.NewUpdateTagUseCase(v, tagRepo)
+	deleteTagUseCase := tag.NewDeleteTagUseCase(pgxTX, tagRepo)
+	listTagsUseCase := tag.NewListTagsUseCase(tagRepo)
+	tagHandler := handler.NewTagHandler(createTagUseCase, updateTagUseCase, deleteTagUseCase, listTagsUseCase)
+	uploadAttachmentUseCase := attachment.NewUploadAttachmentUseCase(v, s3Storage, redisCache, attachmentRepo, transactionRepo)
+	listAttachmentsUseCase := attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo)
+	downloadAttachmentUseCase := attachment.NewDownloadAttachmentUseCase(s3Storage, attachmentRepo)
+	deleteAttachmentUseCase := attachment.NewDeleteAttachmentUseCase(s3Storage, attachmentRepo)
+	attachmentHandler := handler.NewAttachmentHandler(uploadAttachmentUseCase, listAttachmentsUseCase, downloadAttachmentUseCase, deleteAttachmentUseCase)
+	recurringHandler := handler.NewRecurringHandler(listRecurringSeriesUseCase, detectRecurringSeriesUseCase)
+	listTransfersUseCase := transfer.NewListTransfersUseCase(transactionRepo, transferRepo)
+	confirmTransferUseCase := transfer.NewConfirmTransferUseCase(transferRepo)
+	unlinkTransferUseCase := transfer.NewUnlinkTransferUseCase(transferRepo)
+	transferHandler := handler.NewTransferHandler(listTransfersUseCase, matchTransfersUseCase, confirmTransferUseCase, unlinkTransferUseCase)
+	listMerchantsUseCase := merchant.NewListMerchantsUseCase(v, transactionRepo, merchantRepo)
+	merchantHandler := handler.NewMerchantHandler(listMerchantsUseCase)
+	importTransactionsUseCase := imports.NewImportTransactionsUseCase(e, v, pgxTX, transactionRepo, accountRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo)
+	importHandler := handler.NewImportHandler(importTransactionsUseCase)
+	goalRepo := pgrepo.NewGoalRepo(dbDB)
+	createGoalUseCase := goal.NewCreateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	updateGoalUseCase := goal.NewUpdateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	deleteGoalUseCase := goal.NewDeleteGoalUseCase(pgxTX, goalRepo)
+	goalContributionRepo := pgrepo.NewGoalContributionRepo(dbDB)
+	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	createGoalContributionUseCase := goal.NewCreateGoalContributionUseCase(v, goalRepo, goalContributionRepo)
+	listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+	deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
+	goalHandler := handler.NewGoalHandler(createGoalUseCase, updateGoalUseCase, deleteGoalUseCase, getGoalUseCase, listGoalsUseCase, createGoalContributionUseCase, listGoalContributionsUseCase, deleteGoalContributionUseCase)
+	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler, investmentHandler, ruleHandler, tagHandler, attachmentHandler, recurringHandler, transferHandler, merchantHandler, importHandler, goalHandler)
 	app := Build(middlewareMiddleware, routerRouter, redisCache, dbDB)
 	return app
 }
@@ -211,7 +378,8 @@ var (
 // NewDev wires up the application in dev mode.
 func NewDev(v *validator.Validator, e *env.Env) *App {
 	jwt := jwtutil.NewJWT(e)
-	middlewareMiddleware := middleware.NewMiddleware(e, jwt)
+	redisCache := rediscache.NewRedisCache(e)
+	middlewareMiddleware := middleware.NewMiddleware(e, jwt, redisCache)
 	healthHandler := handler.NewHealthHandler()
 	docHandler := handler.NewDocHandler()
 	pool := db.NewPGXPool(e)
@@ -238,15 +406,38 @@ func NewDev(v *validator.Validator, e *env.Env) *App {
 	institutionRepo := pgrepo.NewInstitutionRepo(dbDB)
 	syncInstitutionsUseCase := institution.NewSyncInstitutionsUseCase(mockpluggyClient, institutionRepo)
 	listInstitutionsUseCase := institution.NewListInstitutionsUseCase(institutionRepo)
-	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase)
+	listUserInstitutionsUseCase := institution.NewListUserInstitutionsUseCase(institutionRepo)
+	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
+	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
+	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
 	transactionCategoryRepo := pgrepo.NewCategoryRepo(dbDB)
+	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
+	syncRunRepo := pgrepo.NewSyncRunRepo(dbDB, queryBuilder)
+	creditCardBillRepo := pgrepo.NewCreditCardBillRepo(dbDB)
+	ruleRepo := pgrepo.NewRuleRepo(dbDB)
+	recurringSeriesRepo := pgrepo.NewRecurringSeriesRepo(dbDB)
+	detectRecurringSeriesUseCase := recurring.NewDetectRecurringSeriesUseCase(pgxTX, transactionRepo, recurringSeriesRepo)
+	transferRepo := pgrepo.NewTransferRepo(dbDB)
+	matchTransfersUseCase := transfer.NewMatchTransfersUseCase(pgxTX, transactionRepo, transferRepo)
+	merchantRepo := pgrepo.NewMerchantRepo(dbDB)
+	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo, syncRunRepo, creditCardBillRepo, ruleRepo, merchantRepo, detectRecurringSeriesUseCase, matchTransfersUseCase)
+	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
+	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
+	handleOpenFinanceWebhookUseCase := institution.NewHandleOpenFinanceWebhookUseCase(v, pgxTX, redisCache, accountRepo, userInstitutionRepo, syncTransactionsUseCase, syncAccountsBalancesUseCase)
+	createConnectTokenUseCase := institution.NewCreateConnectTokenUseCase(v, mockpluggyClient, userInstitutionRepo)
+	deleteUserInstitutionUseCase := institution.NewDeleteUserInstitutionUseCase(v, mockpluggyClient, pgxTX, accountRepo, transactionRepo, userInstitutionRepo)
+	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase, listUserInstitutionsUseCase, handleOpenFinanceWebhookUseCase, createConnectTokenUseCase, deleteUserInstitutionUseCase)
 	syncTransactionCategoriesUseCase := transactioncategory.NewSyncTransactionCategoriesUseCase(mockpluggyClient, transactionCategoryRepo)
 	listTransactionCategoriesUseCase := transactioncategory.NewListTransactionCategoriesUseCase(transactionCategoryRepo)
-	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase)
+	createTransactionCategoryUseCase := transactioncategory.NewCreateTransactionCategoryUseCase(v, transactionCategoryRepo)
+	updateTransactionCategoryUseCase := transactioncategory.NewUpdateTransactionCategoryUseCase(v, transactionCategoryRepo)
 	budgetRepo := pgrepo.NewBudgetRepo(dbDB)
+	deleteTransactionCategoryUseCase := transactioncategory.NewDeleteTransactionCategoryUseCase(pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	hideTransactionCategoryUseCase := transactioncategory.NewHideTransactionCategoryUseCase(transactionCategoryRepo)
+	mergeTransactionCategoriesUseCase := transactioncategory.NewMergeTransactionCategoriesUseCase(v, pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase, createTransactionCategoryUseCase, updateTransactionCategoryUseCase, deleteTransactionCategoryUseCase, hideTransactionCategoryUseCase, mergeTransactionCategoriesUseCase)
 	upsertBudgetUseCase := budget.NewUpsertBudgetUseCase(v, pgxTX, budgetRepo, transactionCategoryRepo)
-	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
-	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo)
+	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo, recurringSeriesRepo)
 	getBudgetCategoryUseCase := budget.NewGetBudgetCategoryUseCase(v, budgetRepo, transactionRepo, transactionCategoryRepo)
 	deleteBudgetUseCase := budget.NewDeleteBudgetUseCase(pgxTX, budgetRepo)
 	listTransactionsUseCase := transaction.NewListTransactionsUseCase(v, transactionRepo)
@@ -254,22 +445,33 @@ func NewDev(v *validator.Validator, e *env.Env) *App {
 	budgetHandler := handler.NewBudgetHandler(upsertBudgetUseCase, getBudgetUseCase, getBudgetCategoryUseCase, deleteBudgetUseCase, listBudgetCategoryTransactionsUseCase)
 	getUserUseCase := user.NewGetUserUseCase(userRepo)
 	updateUserUseCase := user.NewUpdateUserUseCase(v, userRepo)
-	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, userRepo)
+	localStorage := localstorage.NewLocalStorage(e)
+	attachmentRepo := pgrepo.NewAttachmentRepo(dbDB)
+	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, localStorage, userRepo, attachmentRepo)
 	userHandler := handler.NewUserHandler(getUserUseCase, updateUserUseCase, deleteUserUseCase)
-	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
-	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
-	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
 	createAccountsUseCase := account.NewCreateAccountsUseCase(v, mockpluggyClient, pgxTX, accountRepo, accountBalanceRepo, institutionRepo, userInstitutionRepo)
-	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo)
-	redisCache := rediscache.NewRedisCache(e)
-	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
-	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase)
-	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
-	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo)
+	tagRepo := pgrepo.NewTagRepo(dbDB)
+	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo, tagRepo)
+	getAccountsSyncStatusUseCase := account.NewGetAccountsSyncStatusUseCase(v, syncRunRepo)
+	listCreditCardBillsUseCase := account.NewListCreditCardBillsUseCase(v, accountRepo, transactionRepo, creditCardBillRepo)
+	getInstallmentCommitmentsUseCase := account.NewGetInstallmentCommitmentsUseCase(v, transactionRepo)
+	listManualAccountsUseCase := account.NewListManualAccountsUseCase(accountRepo)
+	createManualAccountUseCase := account.NewCreateManualAccountUseCase(v, accountRepo)
+	updateManualAccountUseCase := account.NewUpdateManualAccountUseCase(v, accountRepo)
+	deleteManualAccountUseCase := account.NewDeleteManualAccountUseCase(pgxTX, accountRepo, transactionRepo)
+	getAccountsBalanceHistoryUseCase := account.NewGetAccountsBalanceHistoryUseCase(v, accountBalanceRepo)
+	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase, getAccountsSyncStatusUseCase, listCreditCardBillsUseCase, getInstallmentCommitmentsUseCase, listManualAccountsUseCase, createManualAccountUseCase, updateManualAccountUseCase, deleteManualAccountUseCase, getAccountsBalanceHistoryUseCase)
 	getTransactionUseCase := transaction.NewGetTransactionUseCase(transactionRepo)
-	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, transactionRepo)
-	createTransactionUseCase := transaction.NewCreateTransactionUseCase(v, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo)
-	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase)
+	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	createTransactionUseCase := transaction.NewCreateTransactionUseCase(e, v, pgxTX, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo, tagRepo, accountRepo)
+	splitTransactionUseCase := transaction.NewSplitTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo)
+	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(v, transactionRepo)
+	hideTransactionUseCase := transaction.NewHideTransactionUseCase(v, transactionRepo)
+	listDeletedTransactionsUseCase := transaction.NewListDeletedTransactionsUseCase(transactionRepo)
+	restoreTransactionUseCase := transaction.NewRestoreTransactionUseCase(v, transactionRepo)
+	bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(v, transactionRepo, userRepo)
+	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase, splitTransactionUseCase, deleteTransactionUseCase, hideTransactionUseCase, listDeletedTransactionsUseCase, restoreTransactionUseCase, bulkUpdateTransactionsUseCase, exportTransactionsUseCase)
 	feedbackRepo := pgrepo.NewFeedbackRepo(dbDB)
 	createFeedbackUseCase := feedback.NewCreateFeedbackUseCase(v, feedbackRepo)
 	feedbackHandler := handler.NewFeedbackHandler(createFeedbackUseCase)
@@ -284,9 +486,52 @@ func NewDev(v *validator.Validator, e *env.Env) *App {
 	openAI := openai.NewOpenAI(e)
 	aiChatMessageRepo := pgrepo.NewAIChatMessageRepo(dbDB)
 	aiChatAnswerRepo := pgrepo.NewAIChatAnswerRepo(dbDB)
-	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase)
+	listRecurringSeriesUseCase := recurring.NewListRecurringSeriesUseCase(recurringSeriesRepo)
+	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase, listRecurringSeriesUseCase)
 	aiChatHandler := handler.NewAIChatHandler(createAIChatUseCase, deleteAIChatUseCase, updateAIChatUseCase, listAIChatsUseCase, listAIChatMessagesAndAnswersUseCase, generateAIChatMessageUseCase)
-	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler)
+	investmentRepo := pgrepo.NewInvestmentRepo(dbDB)
+	getInvestmentsUseCase := investment.NewGetInvestmentsUseCase(v, investmentRepo)
+	investmentPositionRepo := pgrepo.NewInvestmentPositionRepo(dbDB)
+	syncInvestmentsUseCase := investment.NewSyncInvestmentsUseCase(e, pgxTX, mockpluggyClient, redisCache, userInstitutionRepo, investmentRepo, investmentPositionRepo)
+	investmentHandler := handler.NewInvestmentHandler(getInvestmentsUseCase, syncInvestmentsUseCase)
+	createRuleUseCase := rule.NewCreateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	updateRuleUseCase := rule.NewUpdateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	deleteRuleUseCase := rule.NewDeleteRuleUseCase(ruleRepo)
+	getRuleUseCase := rule.NewGetRuleUseCase(ruleRepo)
+	listRulesUseCase := rule.NewListRulesUseCase(ruleRepo)
+	applyRulesUseCase := rule.NewApplyRulesUseCase(e, pgxTX, ruleRepo, transactionRepo)
+	ruleHandler := handler.NewRuleHandler(createRuleUseCase, updateRuleUseCase, deleteRuleUseCase, getRuleUseCase, listRulesUseCase, applyRulesUseCase)
+	createTagUseCase := tag.NewCreateTagUseCase(v, tagRepo)
+	updateTagUseCase := tag.NewUpdateTagUseCase(v, tagRepo)
+	deleteTagUseCase := tag.NewDeleteTagUseCase(pgxTX, tagRepo)
+	listTagsUseCase := tag.NewListTagsUseCase(tagRepo)
+	tagHandler := handler.NewTagHandler(createTagUseCase, updateTagUseCase, deleteTagUseCase, listTagsUseCase)
+	uploadAttachmentUseCase := attachment.NewUploadAttachmentUseCase(v, localStorage, redisCache, attachmentRepo, transactionRepo)
+	listAttachmentsUseCase := attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo)
+	downloadAttachmentUseCase := attachment.NewDownloadAttachmentUseCase(localStorage, attachmentRepo)
+	deleteAttachmentUseCase := attachment.NewDeleteAttachmentUseCase(localStorage, attachmentRepo)
+	attachmentHandler := handler.NewAttachmentHandler(uploadAttachmentUseCase, listAttachmentsUseCase, downloadAttachmentUseCase, deleteAttachmentUseCase)
+	recurringHandler := handler.NewRecurringHandler(listRecurringSeriesUseCase, detectRecurringSeriesUseCase)
+	listTransfersUseCase := transfer.NewListTransfersUseCase(transactionRepo, transferRepo)
+	confirmTransferUseCase := transfer.NewConfirmTransferUseCase(transferRepo)
+	unlinkTransferUseCase := transfer.NewUnlinkTransferUseCase(transferRepo)
+	transferHandler := handler.NewTransferHandler(listTransfersUseCase, matchTransfersUseCase, confirmTransferUseCase, unlinkTransferUseCase)
+	listMerchantsUseCase := merchant.NewListMerchantsUseCase(v, transactionRepo, merchantRepo)
+	merchantHandler := handler.NewMerchantHandler(listMerchantsUseCase)
+	importTransactionsUseCase := imports.NewImportTransactionsUseCase(e, v, pgxTX, transactionRepo, accountRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo)
+	importHandler := handler.NewImportHandler(importTransactionsUseCase)
+	goalRepo := pgrepo.NewGoalRepo(dbDB)
+	createGoalUseCase := goal.NewCreateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	updateGoalUseCase := goal.NewUpdateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	deleteGoalUseCase := goal.NewDeleteGoalUseCase(pgxTX, goalRepo)
+	goalContributionRepo := pgrepo.NewGoalContributionRepo(dbDB)
+	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	createGoalContributionUseCase := goal.NewCreateGoalContributionUseCase(v, goalRepo, goalContributionRepo)
+	listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+	deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
+	goalHandler := handler.NewGoalHandler(createGoalUseCase, updateGoalUseCase, deleteGoalUseCase, getGoalUseCase, listGoalsUseCase, createGoalContributionUseCase, listGoalContributionsUseCase, deleteGoalContributionUseCase)
+	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler, investmentHandler, ruleHandler, tagHandler, attachmentHandler, recurringHandler, transferHandler, merchantHandler, importHandler, goalHandler)
 	app := Build(middlewareMiddleware, routerRouter, redisCache, dbDB)
 	return app
 }
@@ -294,7 +539,8 @@ func NewDev(v *validator.Validator, e *env.Env) *App {
 // NewStaging wires up the application in staging mode.
 func NewStaging(v *validator.Validator, e *env.Env) *App {
 	jwt := jwtutil.NewJWT(e)
-	middlewareMiddleware := middleware.NewMiddleware(e, jwt)
+	redisCache := rediscache.NewRedisCache(e)
+	middlewareMiddleware := middleware.NewMiddleware(e, jwt, redisCache)
 	healthHandler := handler.NewHealthHandler()
 	docHandler := handler.NewDocHandler()
 	pool := db.NewPGXPool(e)
@@ -321,15 +567,38 @@ func NewStaging(v *validator.Validator, e *env.Env) *App {
 	institutionRepo := pgrepo.NewInstitutionRepo(dbDB)
 	syncInstitutionsUseCase := institution.NewSyncInstitutionsUseCase(mockpluggyClient, institutionRepo)
 	listInstitutionsUseCase := institution.NewListInstitutionsUseCase(institutionRepo)
-	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase)
+	listUserInstitutionsUseCase := institution.NewListUserInstitutionsUseCase(institutionRepo)
+	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
+	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
+	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
 	transactionCategoryRepo := pgrepo.NewCategoryRepo(dbDB)
+	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
+	syncRunRepo := pgrepo.NewSyncRunRepo(dbDB, queryBuilder)
+	creditCardBillRepo := pgrepo.NewCreditCardBillRepo(dbDB)
+	ruleRepo := pgrepo.NewRuleRepo(dbDB)
+	recurringSeriesRepo := pgrepo.NewRecurringSeriesRepo(dbDB)
+	detectRecurringSeriesUseCase := recurring.NewDetectRecurringSeriesUseCase(pgxTX, transactionRepo, recurringSeriesRepo)
+	transferRepo := pgrepo.NewTransferRepo(dbDB)
+	matchTransfersUseCase := transfer.NewMatchTransfersUseCase(pgxTX, transactionRepo, transferRepo)
+	merchantRepo := pgrepo.NewMerchantRepo(dbDB)
+	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo, syncRunRepo, creditCardBillRepo, ruleRepo, merchantRepo, detectRecurringSeriesUseCase, matchTransfersUseCase)
+	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
+	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
+	handleOpenFinanceWebhookUseCase := institution.NewHandleOpenFinanceWebhookUseCase(v, pgxTX, redisCache, accountRepo, userInstitutionRepo, syncTransactionsUseCase, syncAccountsBalancesUseCase)
+	createConnectTokenUseCase := institution.NewCreateConnectTokenUseCase(v, mockpluggyClient, userInstitutionRepo)
+	deleteUserInstitutionUseCase := institution.NewDeleteUserInstitutionUseCase(v, mockpluggyClient, pgxTX, accountRepo, transactionRepo, userInstitutionRepo)
+	institutionHandler := handler.NewInstitutionHandler(syncInstitutionsUseCase, listInstitutionsUseCase, listUserInstitutionsUseCase, handleOpenFinanceWebhookUseCase, createConnectTokenUseCase, deleteUserInstitutionUseCase)
 	syncTransactionCategoriesUseCase := transactioncategory.NewSyncTransactionCategoriesUseCase(mockpluggyClient, transactionCategoryRepo)
 	listTransactionCategoriesUseCase := transactioncategory.NewListTransactionCategoriesUseCase(transactionCategoryRepo)
-	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase)
+	createTransactionCategoryUseCase := transactioncategory.NewCreateTransactionCategoryUseCase(v, transactionCategoryRepo)
+	updateTransactionCategoryUseCase := transactioncategory.NewUpdateTransactionCategoryUseCase(v, transactionCategoryRepo)
 	budgetRepo := pgrepo.NewBudgetRepo(dbDB)
+	deleteTransactionCategoryUseCase := transactioncategory.NewDeleteTransactionCategoryUseCase(pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	hideTransactionCategoryUseCase := transactioncategory.NewHideTransactionCategoryUseCase(transactionCategoryRepo)
+	mergeTransactionCategoriesUseCase := transactioncategory.NewMergeTransactionCategoriesUseCase(v, pgxTX, transactionCategoryRepo, transactionRepo, budgetRepo, ruleRepo)
+	transactionCategoryHandler := handler.NewTransactionCategoryHandler(syncTransactionCategoriesUseCase, listTransactionCategoriesUseCase, createTransactionCategoryUseCase, updateTransactionCategoryUseCase, deleteTransactionCategoryUseCase, hideTransactionCategoryUseCase, mergeTransactionCategoriesUseCase)
 	upsertBudgetUseCase := budget.NewUpsertBudgetUseCase(v, pgxTX, budgetRepo, transactionCategoryRepo)
-	transactionRepo := pgrepo.NewTransactionRepo(dbDB)
-	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo)
+	getBudgetUseCase := budget.NewGetBudgetUseCase(v, budgetRepo, transactionRepo, recurringSeriesRepo)
 	getBudgetCategoryUseCase := budget.NewGetBudgetCategoryUseCase(v, budgetRepo, transactionRepo, transactionCategoryRepo)
 	deleteBudgetUseCase := budget.NewDeleteBudgetUseCase(pgxTX, budgetRepo)
 	listTransactionsUseCase := transaction.NewListTransactionsUseCase(v, transactionRepo)
@@ -337,22 +606,33 @@ func NewStaging(v *validator.Validator, e *env.Env) *App {
 	budgetHandler := handler.NewBudgetHandler(upsertBudgetUseCase, getBudgetUseCase, getBudgetCategoryUseCase, deleteBudgetUseCase, listBudgetCategoryTransactionsUseCase)
 	getUserUseCase := user.NewGetUserUseCase(userRepo)
 	updateUserUseCase := user.NewUpdateUserUseCase(v, userRepo)
-	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, userRepo)
+	localStorage := localstorage.NewLocalStorage(e)
+	attachmentRepo := pgrepo.NewAttachmentRepo(dbDB)
+	deleteUserUseCase := user.NewDeleteUserUseCase(hasher, localStorage, userRepo, attachmentRepo)
 	userHandler := handler.NewUserHandler(getUserUseCase, updateUserUseCase, deleteUserUseCase)
-	accountRepo := pgrepo.NewAccountRepo(dbDB, queryBuilder)
-	accountBalanceRepo := pgrepo.NewAccountBalanceRepo(dbDB)
-	userInstitutionRepo := pgrepo.NewUserInstitutionRepo(dbDB)
 	createAccountsUseCase := account.NewCreateAccountsUseCase(v, mockpluggyClient, pgxTX, accountRepo, accountBalanceRepo, institutionRepo, userInstitutionRepo)
-	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo)
-	redisCache := rediscache.NewRedisCache(e)
-	syncAccountsBalancesUseCase := account.NewSyncAccountsBalancesUseCase(e, pgxTX, mockpluggyClient, redisCache, accountRepo, accountBalanceRepo)
-	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase)
-	paymentMethodRepo := pgrepo.NewPaymentMethodRepo(dbDB)
-	syncTransactionsUseCase := transaction.NewSyncTransactionsUseCase(e, mockpluggyClient, redisCache, pgxTX, accountRepo, userRepo, transactionRepo, transactionCategoryRepo, paymentMethodRepo)
+	tagRepo := pgrepo.NewTagRepo(dbDB)
+	getAccountsBalanceUseCase := account.NewGetAccountsBalanceUseCase(v, transactionRepo, accountBalanceRepo, tagRepo)
+	getAccountsSyncStatusUseCase := account.NewGetAccountsSyncStatusUseCase(v, syncRunRepo)
+	listCreditCardBillsUseCase := account.NewListCreditCardBillsUseCase(v, accountRepo, transactionRepo, creditCardBillRepo)
+	getInstallmentCommitmentsUseCase := account.NewGetInstallmentCommitmentsUseCase(v, transactionRepo)
+	listManualAccountsUseCase := account.NewListManualAccountsUseCase(accountRepo)
+	createManualAccountUseCase := account.NewCreateManualAccountUseCase(v, accountRepo)
+	updateManualAccountUseCase := account.NewUpdateManualAccountUseCase(v, accountRepo)
+	deleteManualAccountUseCase := account.NewDeleteManualAccountUseCase(pgxTX, accountRepo, transactionRepo)
+	getAccountsBalanceHistoryUseCase := account.NewGetAccountsBalanceHistoryUseCase(v, accountBalanceRepo)
+	accountHandler := handler.NewAccountHandler(createAccountsUseCase, getAccountsBalanceUseCase, syncAccountsBalancesUseCase, getAccountsSyncStatusUseCase, listCreditCardBillsUseCase, getInstallmentCommitmentsUseCase, listManualAccountsUseCase, createManualAccountUseCase, updateManualAccountUseCase, deleteManualAccountUseCase, getAccountsBalanceHistoryUseCase)
 	getTransactionUseCase := transaction.NewGetTransactionUseCase(transactionRepo)
-	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, transactionRepo)
-	createTransactionUseCase := transaction.NewCreateTransactionUseCase(v, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo)
-	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase)
+	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	createTransactionUseCase := transaction.NewCreateTransactionUseCase(e, v, pgxTX, transactionRepo, userRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo, tagRepo, accountRepo)
+	splitTransactionUseCase := transaction.NewSplitTransactionUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo)
+	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(v, transactionRepo)
+	hideTransactionUseCase := transaction.NewHideTransactionUseCase(v, transactionRepo)
+	listDeletedTransactionsUseCase := transaction.NewListDeletedTransactionsUseCase(transactionRepo)
+	restoreTransactionUseCase := transaction.NewRestoreTransactionUseCase(v, transactionRepo)
+	bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(v, pgxTX, transactionRepo, transactionCategoryRepo, tagRepo)
+	exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(v, transactionRepo, userRepo)
+	transactionHandler := handler.NewTransactionHandler(syncTransactionsUseCase, listTransactionsUseCase, getTransactionUseCase, updateTransactionUseCase, createTransactionUseCase, splitTransactionUseCase, deleteTransactionUseCase, hideTransactionUseCase, listDeletedTransactionsUseCase, restoreTransactionUseCase, bulkUpdateTransactionsUseCase, exportTransactionsUseCase)
 	feedbackRepo := pgrepo.NewFeedbackRepo(dbDB)
 	createFeedbackUseCase := feedback.NewCreateFeedbackUseCase(v, feedbackRepo)
 	feedbackHandler := handler.NewFeedbackHandler(createFeedbackUseCase)
@@ -367,9 +647,52 @@ func NewStaging(v *validator.Validator, e *env.Env) *App {
 	openAI := openai.NewOpenAI(e)
 	aiChatMessageRepo := pgrepo.NewAIChatMessageRepo(dbDB)
 	aiChatAnswerRepo := pgrepo.NewAIChatAnswerRepo(dbDB)
-	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase)
+	listRecurringSeriesUseCase := recurring.NewListRecurringSeriesUseCase(recurringSeriesRepo)
+	generateAIChatMessageUseCase := aichat.NewGenerateAIChatMessageUseCase(v, pgxTX, openAI, aiChatRepo, aiChatMessageRepo, aiChatAnswerRepo, paymentMethodRepo, transactionCategoryRepo, institutionRepo, transactionRepo, getBudgetUseCase, getAccountsBalanceUseCase, listRecurringSeriesUseCase)
 	aiChatHandler := handler.NewAIChatHandler(createAIChatUseCase, deleteAIChatUseCase, updateAIChatUseCase, listAIChatsUseCase, listAIChatMessagesAndAnswersUseCase, generateAIChatMessageUseCase)
-	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler)
+	investmentRepo := pgrepo.NewInvestmentRepo(dbDB)
+	getInvestmentsUseCase := investment.NewGetInvestmentsUseCase(v, investmentRepo)
+	investmentPositionRepo := pgrepo.NewInvestmentPositionRepo(dbDB)
+	syncInvestmentsUseCase := investment.NewSyncInvestmentsUseCase(e, pgxTX, mockpluggyClient, redisCache, userInstitutionRepo, investmentRepo, investmentPositionRepo)
+	investmentHandler := handler.NewInvestmentHandler(getInvestmentsUseCase, syncInvestmentsUseCase)
+	createRuleUseCase := rule.NewCreateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	updateRuleUseCase := rule.NewUpdateRuleUseCase(v, ruleRepo, transactionCategoryRepo, paymentMethodRepo, institutionRepo, tagRepo)
+	deleteRuleUseCase := rule.NewDeleteRuleUseCase(ruleRepo)
+	getRuleUseCase := rule.NewGetRuleUseCase(ruleRepo)
+	listRulesUseCase := rule.NewListRulesUseCase(ruleRepo)
+	applyRulesUseCase := rule.NewApplyRulesUseCase(e, pgxTX, ruleRepo, transactionRepo)
+	ruleHandler := handler.NewRuleHandler(createRuleUseCase, updateRuleUseCase, deleteRuleUseCase, getRuleUseCase, listRulesUseCase, applyRulesUseCase)
+	createTagUseCase := tag.NewCreateTagUseCase(v, tagRepo)
+	updateTagUseCase := tag.NewUpdateTagUseCase(v, tagRepo)
+	deleteTagUseCase := tag.NewDeleteTagUseCase(pgxTX, tagRepo)
+	listTagsUseCase := tag.NewListTagsUseCase(tagRepo)
+	tagHandler := handler.NewTagHandler(createTagUseCase, updateTagUseCase, deleteTagUseCase, listTagsUseCase)
+	uploadAttachmentUseCase := attachment.NewUploadAttachmentUseCase(v, localStorage, redisCache, attachmentRepo, transactionRepo)
+	listAttachmentsUseCase := attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo)
+	downloadAttachmentUseCase := attachment.NewDownloadAttachmentUseCase(localStorage, attachmentRepo)
+	deleteAttachmentUseCase := attachment.NewDeleteAttachmentUseCase(localStorage, attachmentRepo)
+	attachmentHandler := handler.NewAttachmentHandler(uploadAttachmentUseCase, listAttachmentsUseCase, downloadAttachmentUseCase, deleteAttachmentUseCase)
+	recurringHandler := handler.NewRecurringHandler(listRecurringSeriesUseCase, detectRecurringSeriesUseCase)
+	listTransfersUseCase := transfer.NewListTransfersUseCase(transactionRepo, transferRepo)
+	confirmTransferUseCase := transfer.NewConfirmTransferUseCase(transferRepo)
+	unlinkTransferUseCase := transfer.NewUnlinkTransferUseCase(transferRepo)
+	transferHandler := handler.NewTransferHandler(listTransfersUseCase, matchTransfersUseCase, confirmTransferUseCase, unlinkTransferUseCase)
+	listMerchantsUseCase := merchant.NewListMerchantsUseCase(v, transactionRepo, merchantRepo)
+	merchantHandler := handler.NewMerchantHandler(listMerchantsUseCase)
+	importTransactionsUseCase := imports.NewImportTransactionsUseCase(e, v, pgxTX, transactionRepo, accountRepo, transactionCategoryRepo, paymentMethodRepo, ruleRepo)
+	importHandler := handler.NewImportHandler(importTransactionsUseCase)
+	goalRepo := pgrepo.NewGoalRepo(dbDB)
+	createGoalUseCase := goal.NewCreateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	updateGoalUseCase := goal.NewUpdateGoalUseCase(v, goalRepo, accountRepo, calculateEmergencyReserveUseCase)
+	deleteGoalUseCase := goal.NewDeleteGoalUseCase(pgxTX, goalRepo)
+	goalContributionRepo := pgrepo.NewGoalContributionRepo(dbDB)
+	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, accountBalanceRepo, goalContributionRepo, calculateCompoundInterestUseCase)
+	createGoalContributionUseCase := goal.NewCreateGoalContributionUseCase(v, goalRepo, goalContributionRepo)
+	listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+	deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
+	goalHandler := handler.NewGoalHandler(createGoalUseCase, updateGoalUseCase, deleteGoalUseCase, getGoalUseCase, listGoalsUseCase, createGoalContributionUseCase, listGoalContributionsUseCase, deleteGoalContributionUseCase)
+	routerRouter := router.NewRouter(e, middlewareMiddleware, healthHandler, docHandler, authHandler, calculatorHandler, institutionHandler, transactionCategoryHandler, budgetHandler, userHandler, accountHandler, transactionHandler, feedbackHandler, paymentMethodHandler, aiChatHandler, investmentHandler, ruleHandler, tagHandler, attachmentHandler, recurringHandler, transferHandler, merchantHandler, importHandler, goalHandler)
 	app := Build(middlewareMiddleware, routerRouter, redisCache, dbDB)
 	return app
 }
@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// JobFunc runs a job and reports how many accounts it processed.
+type JobFunc func(ctx context.Context) (accountsProcessed int, err error)
+
+type Job struct {
+	Name    string
+	Spec    string
+	Timeout time.Duration
+	Run     JobFunc
+}
+
+type Scheduler struct {
+	cron *cron.Cron
+	c    cache.Cache
+	jrr  repo.JobRunRepo
+}
+
+func NewScheduler(
+	c cache.Cache,
+	jrr repo.JobRunRepo,
+) *Scheduler {
+	return &Scheduler{
+		cron: cron.New(),
+		c:    c,
+		jrr:  jrr,
+	}
+}
+
+func (s *Scheduler) Register(job Job) error {
+	if _, err := s.cron.AddFunc(job.Spec, func() { s.run(job) }); err != nil {
+		return errs.New(fmt.Errorf("invalid spec for job %s: %w", job.Name, err))
+	}
+	return nil
+}
+
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop stops the scheduler and returns a context that is done once all
+// running jobs have finished.
+func (s *Scheduler) Stop() context.Context {
+	return s.cron.Stop()
+}
+
+func (s *Scheduler) run(job Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+	defer cancel()
+
+	// The lock holds a token of this run, so a run that outlives the lock
+	// does not release the one of another replica.
+	lockKey := fmt.Sprintf("%s:%s", cache.KeyJobLock, job.Name)
+	lockToken := uuid.NewString()
+	ok, err := s.c.SetNX(ctx, lockKey, lockToken, job.Timeout)
+	if err != nil {
+		slog.Error(
+			"worker: error acquiring job lock",
+			"job", job.Name,
+			"err", err,
+		)
+		return
+	}
+	if !ok {
+		slog.Info("worker: job is locked by another replica", "job", job.Name)
+		return
+	}
+	defer func() {
+		released, err := s.c.DeleteIfEqual(
+			context.Background(),
+			lockKey,
+			lockToken,
+		)
+		if err != nil {
+			slog.Error(
+				"worker: error releasing job lock",
+				"job", job.Name,
+				"err", err,
+			)
+			return
+		}
+		if !released {
+			slog.Warn(
+				"worker: job lock expired before the job finished",
+				"job", job.Name,
+			)
+		}
+	}()
+
+	jobRun, err := s.jrr.CreateJobRun(ctx, job.Name)
+	if err != nil {
+		slog.Error(
+			"worker: error creating job run",
+			"job", job.Name,
+			"err", err,
+		)
+		return
+	}
+
+	accountsProcessed, err := s.execute(ctx, job)
+
+	params := repo.FinishJobRunParams{
+		ID:                jobRun.ID,
+		AccountsProcessed: int64(accountsProcessed),
+	}
+	if err != nil {
+		slog.Error("worker: job failed", "job", job.Name, "err", err)
+		params.ErrorMessage = ptr.New(err.Error())
+	}
+
+	// The job context may already be expired, so the run is finished with a
+	// fresh one to make sure the failure is recorded.
+	finishCtx, finishCancel := context.WithTimeout(
+		context.Background(),
+		5*time.Second,
+	)
+	defer finishCancel()
+
+	if err := s.jrr.FinishJobRun(finishCtx, params); err != nil {
+		slog.Error(
+			"worker: error finishing job run",
+			"job", job.Name,
+			"err", err,
+		)
+	}
+}
+
+func (s *Scheduler) execute(
+	ctx context.Context,
+	job Job,
+) (accountsProcessed int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.New(fmt.Sprintf("panic: %v", r))
+		}
+	}()
+
+	return job.Run(ctx)
+}
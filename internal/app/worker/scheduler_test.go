@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache/memcache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type memoryJobRunRepo struct {
+	created  []string
+	finished []repo.FinishJobRunParams
+}
+
+func (r *memoryJobRunRepo) CreateJobRun(
+	_ context.Context,
+	job string,
+) (*entity.JobRun, error) {
+	r.created = append(r.created, job)
+	return &entity.JobRun{ID: uuid.New(), Job: job}, nil
+}
+
+func (r *memoryJobRunRepo) FinishJobRun(
+	_ context.Context,
+	params repo.FinishJobRunParams,
+) error {
+	r.finished = append(r.finished, params)
+	return nil
+}
+
+func TestSchedulerRun(t *testing.T) {
+	t.Parallel()
+
+	const jobName = "test_job"
+	lockKey := cache.KeyJobLock + ":" + jobName
+
+	tests := []struct {
+		description string
+		// lockedBy is the token of a lock held by another replica before
+		// the job runs.
+		lockedBy string
+		run      func(c *memcache.MemCache) JobFunc
+		// expectedRun reports whether the job runs and is recorded.
+		expectedRun    bool
+		expectedFailed bool
+		// expectedLock is the token left in the lock after the run, empty
+		// if it is released.
+		expectedLock string
+	}{
+		{
+			description: "runs and releases the lock",
+			run: func(_ *memcache.MemCache) JobFunc {
+				return func(context.Context) (int, error) { return 3, nil }
+			},
+			expectedRun: true,
+		},
+		{
+			description: "skips when another replica holds the lock",
+			lockedBy:    "other-replica",
+			run: func(_ *memcache.MemCache) JobFunc {
+				return func(context.Context) (int, error) { return 3, nil }
+			},
+			expectedLock: "other-replica",
+		},
+		{
+			description: "keeps the lock acquired by another replica after expiring",
+			run: func(c *memcache.MemCache) JobFunc {
+				return func(ctx context.Context) (int, error) {
+					// The lock of this run expired and another replica
+					// acquired it
+					_ = c.Set(ctx, lockKey, "other-replica", 0)
+					return 3, nil
+				}
+			},
+			expectedRun:  true,
+			expectedLock: "other-replica",
+		},
+		{
+			description: "records the error",
+			run: func(_ *memcache.MemCache) JobFunc {
+				return func(context.Context) (int, error) {
+					return 1, errors.New("sync failed")
+				}
+			},
+			expectedRun:    true,
+			expectedFailed: true,
+		},
+		{
+			description: "recovers from a panic",
+			run: func(_ *memcache.MemCache) JobFunc {
+				return func(context.Context) (int, error) { panic("boom") }
+			},
+			expectedRun:    true,
+			expectedFailed: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			c := memcache.NewMemCache()
+			if test.lockedBy != "" {
+				_ = c.Set(ctx, lockKey, test.lockedBy, 0)
+			}
+			jrr := &memoryJobRunRepo{}
+
+			s := NewScheduler(c, jrr)
+			s.run(Job{
+				Name:    jobName,
+				Spec:    "@every 1m",
+				Timeout: time.Minute,
+				Run:     test.run(c),
+			})
+
+			if !test.expectedRun {
+				assert.Empty(t, jrr.created)
+				assert.Empty(t, jrr.finished)
+			} else if assert.Len(t, jrr.finished, 1) {
+				assert.Equal(t, []string{jobName}, jrr.created)
+				if test.expectedFailed {
+					assert.NotNil(t, jrr.finished[0].ErrorMessage)
+				} else {
+					assert.Nil(t, jrr.finished[0].ErrorMessage)
+					assert.Equal(t, int64(3), jrr.finished[0].AccountsProcessed)
+				}
+			}
+
+			var lock string
+			ok, err := c.Scan(ctx, lockKey, &lock)
+			assert.Nil(t, err)
+			if test.expectedLock == "" {
+				assert.False(t, ok)
+			} else {
+				assert.Equal(t, test.expectedLock, lock)
+			}
+		})
+	}
+}
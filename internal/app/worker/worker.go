@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+)
+
+const (
+	JobSyncTransactions          = "sync_transactions"
+	JobSyncBalances              = "sync_balances"
+	JobSyncInstitutions          = "sync_institutions"
+	JobSyncTransactionCategories = "sync_transaction_categories"
+	JobSyncInvestments           = "sync_investments"
+	JobPurgeDeletedTransactions  = "purge_deleted_transactions"
+)
+
+type App struct {
+	*Scheduler
+	DB *db.DB
+}
+
+func Build(
+	e *env.Env,
+	s *Scheduler,
+	DB *db.DB,
+	st *transaction.SyncTransactionsUseCase,
+	sab *account.SyncAccountsBalancesUseCase,
+	si *institution.SyncInstitutionsUseCase,
+	stc *transactioncategory.SyncTransactionCategoriesUseCase,
+	sin *investment.SyncInvestmentsUseCase,
+	pdt *transaction.PurgeDeletedTransactionsUseCase,
+) *App {
+	jobs := []Job{
+		{
+			Name:    JobSyncTransactions,
+			Spec:    e.SyncTransactionsCron,
+			Timeout: 4 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				out, err := st.Execute(
+					ctx,
+					transaction.SyncTransactionsUseCaseInput{},
+				)
+				if err != nil {
+					return 0, err
+				}
+				return out.AccountsProcessed, nil
+			},
+		},
+		{
+			Name:    JobSyncBalances,
+			Spec:    e.SyncBalancesCron,
+			Timeout: 4 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				out, err := sab.Execute(
+					ctx,
+					account.SyncAccountsBalancesUseCaseInput{},
+				)
+				if err != nil {
+					return 0, err
+				}
+				return out.AccountsProcessed, nil
+			},
+		},
+		{
+			Name:    JobSyncInstitutions,
+			Spec:    e.SyncInstitutionsCron,
+			Timeout: 10 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				return 0, si.Execute(ctx)
+			},
+		},
+		{
+			Name:    JobSyncTransactionCategories,
+			Spec:    e.SyncTransactionCategoriesCron,
+			Timeout: 10 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				return 0, stc.Execute(ctx)
+			},
+		},
+		{
+			Name:    JobSyncInvestments,
+			Spec:    e.SyncInvestmentsCron,
+			Timeout: 4 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				out, err := sin.Execute(
+					ctx,
+					investment.SyncInvestmentsUseCaseInput{},
+				)
+				if err != nil {
+					return 0, err
+				}
+				return out.ConnectionsProcessed, nil
+			},
+		},
+		{
+			Name:    JobPurgeDeletedTransactions,
+			Spec:    e.PurgeDeletedTransactionsCron,
+			Timeout: 10 * time.Minute,
+			Run: func(ctx context.Context) (int, error) {
+				return 0, pdt.Execute(ctx)
+			},
+		},
+	}
+
+	for _, job := range jobs {
+		if err := s.Register(job); err != nil {
+			log.Fatalf("failed to register job: %v", err)
+		}
+	}
+
+	return &App{
+		Scheduler: s,
+		DB:        DB,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	root "github.com/danielmesquitta/api-finance-manager"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
@@ -44,6 +45,20 @@ type Env struct {
 	SyncBalancesMaxAccounts          int         `mapstructure:"SYNC_BALANCES_MAX_ACCOUNTS"          validate:"required,min=1"`
 	SyncTransactionsMaxAccounts      int         `mapstructure:"SYNC_TRANSACTIONS_MAX_ACCOUNTS"      validate:"required,min=1"`
 	OpenAIAPIKey                     string      `mapstructure:"OPEN_AI_API_KEY"                     validate:"required"`
+	OpenFinanceWebhookSecret         string      `mapstructure:"OPEN_FINANCE_WEBHOOK_SECRET"         validate:"required"`
+	SyncTransactionsCron             string      `mapstructure:"SYNC_TRANSACTIONS_CRON"`
+	SyncBalancesCron                 string      `mapstructure:"SYNC_BALANCES_CRON"`
+	SyncInstitutionsCron             string      `mapstructure:"SYNC_INSTITUTIONS_CRON"`
+	SyncTransactionCategoriesCron    string      `mapstructure:"SYNC_TRANSACTION_CATEGORIES_CRON"`
+	SyncInvestmentsCron              string      `mapstructure:"SYNC_INVESTMENTS_CRON"`
+	SyncInvestmentsMaxConnections    int         `mapstructure:"SYNC_INVESTMENTS_MAX_CONNECTIONS"`
+	PurgeDeletedTransactionsCron     string      `mapstructure:"PURGE_DELETED_TRANSACTIONS_CRON"`
+	StorageLocalPath                 string      `mapstructure:"STORAGE_LOCAL_PATH"`
+	S3Endpoint                       string      `mapstructure:"S3_ENDPOINT"`
+	S3Region                         string      `mapstructure:"S3_REGION"                           validate:"required_if=Environment production"`
+	S3Bucket                         string      `mapstructure:"S3_BUCKET"                           validate:"required_if=Environment production"`
+	S3AccessKeyID                    string      `mapstructure:"S3_ACCESS_KEY_ID"                    validate:"required_if=Environment production"`
+	S3SecretAccessKey                string      `mapstructure:"S3_SECRET_ACCESS_KEY"                validate:"required_if=Environment production"`
 }
 
 func NewEnv(v *validator.Validator) *Env {
@@ -115,5 +130,29 @@ func (e *Env) validate() error {
 	if e.Host == "" {
 		e.Host = "http://localhost"
 	}
+	if e.SyncTransactionsCron == "" {
+		e.SyncTransactionsCron = "*/5 * * * *"
+	}
+	if e.SyncBalancesCron == "" {
+		e.SyncBalancesCron = "*/5 * * * *"
+	}
+	if e.SyncInstitutionsCron == "" {
+		e.SyncInstitutionsCron = "0 3 * * *"
+	}
+	if e.SyncTransactionCategoriesCron == "" {
+		e.SyncTransactionCategoriesCron = "0 4 * * *"
+	}
+	if e.SyncInvestmentsCron == "" {
+		e.SyncInvestmentsCron = "*/10 * * * *"
+	}
+	if e.SyncInvestmentsMaxConnections == 0 {
+		e.SyncInvestmentsMaxConnections = 100
+	}
+	if e.PurgeDeletedTransactionsCron == "" {
+		e.PurgeDeletedTransactionsCron = "0 5 * * *"
+	}
+	if e.StorageLocalPath == "" {
+		e.StorageLocalPath = filepath.Join(os.TempDir(), "api-finance-manager")
+	}
 	return nil
 }
@@ -10,14 +10,23 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/aichat"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/attachment"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/auth"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/budget"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/feedback"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/imports"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/paymentmethod"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/user"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/hash"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/jwtutil"
@@ -36,6 +45,9 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo/pgrepo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/localstorage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/s3storage"
 )
 
 func init() {
@@ -102,6 +114,12 @@ var providers = []any{
 	wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
 	pgrepo.NewAccountBalanceRepo,
 
+	wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+	pgrepo.NewCreditCardBillRepo,
+
+	wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+	pgrepo.NewSyncRunRepo,
+
 	wire.Bind(new(repo.FeedbackRepo), new(*pgrepo.FeedbackRepo)),
 	pgrepo.NewFeedbackRepo,
 
@@ -117,6 +135,45 @@ var providers = []any{
 	wire.Bind(new(repo.AIChatAnswerRepo), new(*pgrepo.AIChatAnswerRepo)),
 	pgrepo.NewAIChatAnswerRepo,
 
+	wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+	pgrepo.NewInvestmentRepo,
+
+	wire.Bind(
+		new(repo.InvestmentPositionRepo),
+		new(*pgrepo.InvestmentPositionRepo),
+	),
+	pgrepo.NewInvestmentPositionRepo,
+
+	wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+	pgrepo.NewRuleRepo,
+
+	wire.Bind(
+		new(repo.RecurringSeriesRepo),
+		new(*pgrepo.RecurringSeriesRepo),
+	),
+	pgrepo.NewRecurringSeriesRepo,
+
+	wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+	pgrepo.NewTransferRepo,
+
+	wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+	pgrepo.NewMerchantRepo,
+
+	wire.Bind(new(repo.TagRepo), new(*pgrepo.TagRepo)),
+	pgrepo.NewTagRepo,
+
+	wire.Bind(new(repo.GoalRepo), new(*pgrepo.GoalRepo)),
+	pgrepo.NewGoalRepo,
+
+	wire.Bind(
+		new(repo.GoalContributionRepo),
+		new(*pgrepo.GoalContributionRepo),
+	),
+	pgrepo.NewGoalContributionRepo,
+
+	wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+	pgrepo.NewAttachmentRepo,
+
 	wire.Bind(
 		new(repo.UserAuthProviderRepo),
 		new(*pgrepo.UserAuthProviderRepo),
@@ -126,6 +183,14 @@ var providers = []any{
 	account.NewCreateAccountsUseCase,
 	account.NewGetAccountsBalanceUseCase,
 	account.NewSyncAccountsBalancesUseCase,
+	account.NewGetAccountsSyncStatusUseCase,
+	account.NewListCreditCardBillsUseCase,
+	account.NewGetInstallmentCommitmentsUseCase,
+	account.NewListManualAccountsUseCase,
+	account.NewCreateManualAccountUseCase,
+	account.NewUpdateManualAccountUseCase,
+	account.NewDeleteManualAccountUseCase,
+	account.NewGetAccountsBalanceHistoryUseCase,
 
 	aichat.NewListAIChatsUseCase,
 	aichat.NewCreateAIChatUseCase,
@@ -153,17 +218,74 @@ var providers = []any{
 
 	institution.NewSyncInstitutionsUseCase,
 	institution.NewListInstitutionsUseCase,
+	institution.NewListUserInstitutionsUseCase,
+	institution.NewHandleOpenFinanceWebhookUseCase,
+	institution.NewCreateConnectTokenUseCase,
+	institution.NewDeleteUserInstitutionUseCase,
+
+	investment.NewSyncInvestmentsUseCase,
+	investment.NewGetInvestmentsUseCase,
 
 	paymentmethod.NewListPaymentMethodsUseCase,
 
+	rule.NewCreateRuleUseCase,
+	rule.NewUpdateRuleUseCase,
+	rule.NewDeleteRuleUseCase,
+	rule.NewGetRuleUseCase,
+	rule.NewListRulesUseCase,
+	rule.NewApplyRulesUseCase,
+
+	tag.NewCreateTagUseCase,
+	tag.NewUpdateTagUseCase,
+	tag.NewDeleteTagUseCase,
+	tag.NewListTagsUseCase,
+
+	attachment.NewUploadAttachmentUseCase,
+	attachment.NewListAttachmentsUseCase,
+	attachment.NewDownloadAttachmentUseCase,
+	attachment.NewDeleteAttachmentUseCase,
+
+	recurring.NewDetectRecurringSeriesUseCase,
+	recurring.NewListRecurringSeriesUseCase,
+
+	transfer.NewMatchTransfersUseCase,
+	transfer.NewListTransfersUseCase,
+	transfer.NewConfirmTransferUseCase,
+	transfer.NewUnlinkTransferUseCase,
+
+	merchant.NewListMerchantsUseCase,
+
+	imports.NewImportTransactionsUseCase,
+
+	goal.NewCreateGoalUseCase,
+	goal.NewUpdateGoalUseCase,
+	goal.NewDeleteGoalUseCase,
+	goal.NewGetGoalUseCase,
+	goal.NewListGoalsUseCase,
+	goal.NewCreateGoalContributionUseCase,
+	goal.NewListGoalContributionsUseCase,
+	goal.NewDeleteGoalContributionUseCase,
+
 	transaction.NewSyncTransactionsUseCase,
 	transaction.NewListTransactionsUseCase,
 	transaction.NewGetTransactionUseCase,
 	transaction.NewUpdateTransactionUseCase,
+	transaction.NewSplitTransactionUseCase,
 	transaction.NewCreateTransactionUseCase,
+	transaction.NewDeleteTransactionUseCase,
+	transaction.NewHideTransactionUseCase,
+	transaction.NewListDeletedTransactionsUseCase,
+	transaction.NewRestoreTransactionUseCase,
+	transaction.NewBulkUpdateTransactionsUseCase,
+	transaction.NewExportTransactionsUseCase,
 
 	transactioncategory.NewSyncTransactionCategoriesUseCase,
 	transactioncategory.NewListTransactionCategoriesUseCase,
+	transactioncategory.NewCreateTransactionCategoryUseCase,
+	transactioncategory.NewUpdateTransactionCategoryUseCase,
+	transactioncategory.NewDeleteTransactionCategoryUseCase,
+	transactioncategory.NewHideTransactionCategoryUseCase,
+	transactioncategory.NewMergeTransactionCategoriesUseCase,
 
 	user.NewGetUserUseCase,
 	user.NewUpdateUserUseCase,
@@ -181,6 +303,15 @@ var providers = []any{
 	handler.NewFeedbackHandler,
 	handler.NewPaymentMethodHandler,
 	handler.NewAIChatHandler,
+	handler.NewInvestmentHandler,
+	handler.NewRuleHandler,
+	handler.NewTagHandler,
+	handler.NewAttachmentHandler,
+	handler.NewRecurringHandler,
+	handler.NewTransferHandler,
+	handler.NewMerchantHandler,
+	handler.NewImportHandler,
+	handler.NewGoalHandler,
 	handler.NewHealthHandler,
 
 	middleware.NewMiddleware,
@@ -194,21 +325,29 @@ var devProviders = []any{
 	mockoauth.NewMockOAuth,
 	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
 }
 
 var testProviders = []any{
 	mockoauth.NewMockOAuth,
 	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
 }
 
 var stagingProviders = []any{
 	mockoauth.NewMockOAuth,
 	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
 	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
 }
 
 var prodProviders = []any{
 	wire.Value((*mockoauth.MockOAuth)(nil)),
 	wire.Bind(new(openfinance.Client), new(*pluggy.Client)),
+	wire.Bind(new(storage.Storage), new(*s3storage.S3Storage)),
+	s3storage.NewS3Storage,
 }
@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"github.com/google/wire"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/worker"
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/investment"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/jwtutil"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache/rediscache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/query"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/mockpluggy"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo/pgrepo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/localstorage"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage/s3storage"
+)
+
+func init() {
+	_ = providers
+	_ = devProviders
+	_ = testProviders
+	_ = stagingProviders
+	_ = prodProviders
+	_ = params
+}
+
+func params(
+	v *validator.Validator,
+	e *env.Env,
+) {
+}
+
+var providers = []any{
+	jwtutil.NewJWT,
+
+	pluggy.NewClient,
+
+	db.NewPGXPool,
+	db.NewSQLX,
+	query.NewQueryBuilder,
+	db.NewDB,
+
+	wire.Bind(new(tx.TX), new(*tx.PgxTX)),
+	tx.NewPgxTX,
+
+	wire.Bind(new(cache.Cache), new(*rediscache.RedisCache)),
+	rediscache.NewRedisCache,
+
+	wire.Bind(new(repo.UserRepo), new(*pgrepo.UserRepo)),
+	pgrepo.NewUserRepo,
+
+	wire.Bind(new(repo.InstitutionRepo), new(*pgrepo.InstitutionRepo)),
+	pgrepo.NewInstitutionRepo,
+
+	wire.Bind(
+		new(repo.TransactionCategoryRepo),
+		new(*pgrepo.TransactionCategoryRepo),
+	),
+	pgrepo.NewCategoryRepo,
+
+	wire.Bind(new(repo.AccountRepo), new(*pgrepo.AccountRepo)),
+	pgrepo.NewAccountRepo,
+
+	wire.Bind(new(repo.TransactionRepo), new(*pgrepo.TransactionRepo)),
+	pgrepo.NewTransactionRepo,
+
+	wire.Bind(new(repo.PaymentMethodRepo), new(*pgrepo.PaymentMethodRepo)),
+	pgrepo.NewPaymentMethodRepo,
+
+	wire.Bind(new(repo.AccountBalanceRepo), new(*pgrepo.AccountBalanceRepo)),
+	pgrepo.NewAccountBalanceRepo,
+
+	wire.Bind(new(repo.CreditCardBillRepo), new(*pgrepo.CreditCardBillRepo)),
+	pgrepo.NewCreditCardBillRepo,
+
+	wire.Bind(new(repo.SyncRunRepo), new(*pgrepo.SyncRunRepo)),
+	pgrepo.NewSyncRunRepo,
+
+	wire.Bind(new(repo.JobRunRepo), new(*pgrepo.JobRunRepo)),
+	pgrepo.NewJobRunRepo,
+
+	wire.Bind(new(repo.UserInstitutionRepo), new(*pgrepo.UserInstitutionRepo)),
+	pgrepo.NewUserInstitutionRepo,
+
+	wire.Bind(new(repo.InvestmentRepo), new(*pgrepo.InvestmentRepo)),
+	pgrepo.NewInvestmentRepo,
+
+	wire.Bind(
+		new(repo.InvestmentPositionRepo),
+		new(*pgrepo.InvestmentPositionRepo),
+	),
+	pgrepo.NewInvestmentPositionRepo,
+
+	wire.Bind(new(repo.RuleRepo), new(*pgrepo.RuleRepo)),
+	pgrepo.NewRuleRepo,
+
+	wire.Bind(
+		new(repo.RecurringSeriesRepo),
+		new(*pgrepo.RecurringSeriesRepo),
+	),
+	pgrepo.NewRecurringSeriesRepo,
+
+	wire.Bind(new(repo.TransferRepo), new(*pgrepo.TransferRepo)),
+	pgrepo.NewTransferRepo,
+
+	wire.Bind(new(repo.MerchantRepo), new(*pgrepo.MerchantRepo)),
+	pgrepo.NewMerchantRepo,
+
+	wire.Bind(new(repo.AttachmentRepo), new(*pgrepo.AttachmentRepo)),
+	pgrepo.NewAttachmentRepo,
+
+	account.NewSyncAccountsBalancesUseCase,
+
+	institution.NewSyncInstitutionsUseCase,
+
+	investment.NewSyncInvestmentsUseCase,
+
+	recurring.NewDetectRecurringSeriesUseCase,
+
+	transfer.NewMatchTransfersUseCase,
+
+	transaction.NewSyncTransactionsUseCase,
+	transaction.NewPurgeDeletedTransactionsUseCase,
+
+	transactioncategory.NewSyncTransactionCategoriesUseCase,
+
+	worker.NewScheduler,
+
+	worker.Build,
+}
+
+var devProviders = []any{
+	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
+	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
+}
+
+var testProviders = []any{
+	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
+	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
+}
+
+var stagingProviders = []any{
+	wire.Bind(new(openfinance.Client), new(*mockpluggy.Client)),
+	mockpluggy.NewClient,
+	wire.Bind(new(storage.Storage), new(*localstorage.LocalStorage)),
+	localstorage.NewLocalStorage,
+}
+
+var prodProviders = []any{
+	wire.Bind(new(openfinance.Client), new(*pluggy.Client)),
+	wire.Bind(new(storage.Storage), new(*s3storage.S3Storage)),
+	s3storage.NewS3Storage,
+}
@@ -11,12 +11,21 @@ type AccountType = string
 const (
 	AccountTypeBank   AccountType = "BANK"
 	AccountTypeCredit AccountType = "CREDIT"
+	// AccountTypeWallet is the physical wallet of the user, a manual account
+	// each user has at most one of.
+	AccountTypeWallet AccountType = "WALLET"
 )
 
 type FullAccount struct {
 	Account
-	UserID                    *uuid.UUID `db:"user_id"                      json:"user_id,omitzero"`
 	InstitutionID             *uuid.UUID `db:"institution_id"               json:"institution_id,omitzero"`
 	UserInstitutionExternalID *string    `db:"user_institution_external_id" json:"user_institution_external_id,omitzero"`
 	SynchronizedAt            *time.Time `db:"synchronized_at"              json:"synchronized_at,omitzero"`
 }
+
+// ManualAccount is an account created by the user, whose balance is the
+// opening balance plus its transactions.
+type ManualAccount struct {
+	Account
+	Balance int64 `db:"balance" json:"balance"`
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BalanceInterval is the length of each point of a balance history.
+type BalanceInterval string
+
+const (
+	BalanceIntervalDay   BalanceInterval = "day"
+	BalanceIntervalWeek  BalanceInterval = "week"
+	BalanceIntervalMonth BalanceInterval = "month"
+)
+
+// AccountBalancePoint is the balance of an account at the end of a point of
+// a balance history. Credit card balances are negative, as they are debt.
+type AccountBalancePoint struct {
+	Date            time.Time   `db:"date"             json:"date"`
+	AccountID       uuid.UUID   `db:"account_id"       json:"account_id"`
+	AccountName     string      `db:"account_name"     json:"account_name"`
+	AccountType     AccountType `db:"account_type"     json:"account_type"`
+	InstitutionID   *uuid.UUID  `db:"institution_id"   json:"institution_id,omitzero"`
+	InstitutionName *string     `db:"institution_name" json:"institution_name,omitzero"`
+	Balance         int64       `db:"balance"          json:"balance"`
+}
+
+// BalanceHistoryGroupBy is how the accounts of a balance history are
+// grouped into series.
+type BalanceHistoryGroupBy string
+
+const (
+	BalanceHistoryGroupByAccount     BalanceHistoryGroupBy = "account"
+	BalanceHistoryGroupByInstitution BalanceHistoryGroupBy = "institution"
+)
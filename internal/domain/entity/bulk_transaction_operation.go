@@ -0,0 +1,11 @@
+package entity
+
+type BulkTransactionOperation string
+
+const (
+	BulkTransactionOperationRecategorize BulkTransactionOperation = "RECATEGORIZE"
+	BulkTransactionOperationSetIgnored   BulkTransactionOperation = "SET_IGNORED"
+	BulkTransactionOperationAddTags      BulkTransactionOperation = "ADD_TAGS"
+	BulkTransactionOperationRemoveTags   BulkTransactionOperation = "REMOVE_TAGS"
+	BulkTransactionOperationDelete       BulkTransactionOperation = "DELETE"
+)
@@ -22,7 +22,9 @@ type Account struct {
 	Type              string     `db:"type" json:"type,omitempty"`
 	CreatedAt         time.Time  `db:"created_at" json:"created_at,omitempty"`
 	DeletedAt         *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
-	UserInstitutionID uuid.UUID  `db:"user_institution_id" json:"user_institution_id,omitempty"`
+	UserInstitutionID *uuid.UUID `db:"user_institution_id" json:"user_institution_id,omitempty"`
+	UserID            uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
+	OpeningBalance    int64      `db:"opening_balance" json:"opening_balance,omitempty"`
 }
 
 type AIChatMessage struct {
@@ -52,6 +54,17 @@ type AIChat struct {
 	UserID    uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
 }
 
+type Attachment struct {
+	ID            uuid.UUID `db:"id" json:"id,omitempty"`
+	Name          string    `db:"name" json:"name,omitempty"`
+	ContentType   string    `db:"content_type" json:"content_type,omitempty"`
+	Size          int64     `db:"size" json:"size,omitempty"`
+	StorageKey    string    `db:"storage_key" json:"storage_key,omitempty"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at,omitempty"`
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id,omitempty"`
+	UserID        uuid.UUID `db:"user_id" json:"user_id,omitempty"`
+}
+
 type BudgetCategory struct {
 	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
 	Amount     int64      `db:"amount" json:"amount,omitempty"`
@@ -72,6 +85,18 @@ type Budget struct {
 	UserID    uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
 }
 
+type CreditCardBill struct {
+	ID          uuid.UUID  `db:"id" json:"id,omitempty"`
+	ExternalID  string     `db:"external_id" json:"external_id,omitempty"`
+	ClosingDate *time.Time `db:"closing_date" json:"closing_date,omitempty"`
+	DueDate     time.Time  `db:"due_date" json:"due_date,omitempty"`
+	TotalAmount int64      `db:"total_amount" json:"total_amount,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt   time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt   *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	AccountID   uuid.UUID  `db:"account_id" json:"account_id,omitempty"`
+}
+
 type Feedback struct {
 	ID        uuid.UUID  `db:"id" json:"id,omitempty"`
 	Message   string     `db:"message" json:"message,omitempty"`
@@ -80,6 +105,37 @@ type Feedback struct {
 	UserID    *uuid.UUID `db:"user_id" json:"user_id,omitempty"`
 }
 
+type GoalContribution struct {
+	ID        uuid.UUID  `db:"id" json:"id,omitempty"`
+	Amount    int64      `db:"amount" json:"amount,omitempty"`
+	Date      time.Time  `db:"date" json:"date,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at,omitempty"`
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	GoalID    uuid.UUID  `db:"goal_id" json:"goal_id,omitempty"`
+}
+
+type Goal struct {
+	ID                  uuid.UUID  `db:"id" json:"id,omitempty"`
+	Name                string     `db:"name" json:"name,omitempty"`
+	TargetAmount        int64      `db:"target_amount" json:"target_amount,omitempty"`
+	Deadline            time.Time  `db:"deadline" json:"deadline,omitempty"`
+	Interest            int64      `db:"interest" json:"interest,omitempty"`
+	MonthlyContribution int64      `db:"monthly_contribution" json:"monthly_contribution,omitempty"`
+	CreatedAt           time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt           time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt           *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	AccountID           *uuid.UUID `db:"account_id" json:"account_id,omitempty"`
+	UserID              uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
+}
+
+type HiddenCategory struct {
+	ID           uuid.UUID  `db:"id" json:"id,omitempty"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UserID       uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
+	CategoryID   uuid.UUID  `db:"category_id" json:"category_id,omitempty"`
+	MergedIntoID *uuid.UUID `db:"merged_into_id" json:"merged_into_id,omitempty"`
+}
+
 type Institution struct {
 	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
 	ExternalID string     `db:"external_id" json:"external_id,omitempty"`
@@ -89,6 +145,46 @@ type Institution struct {
 	DeletedAt  *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
 }
 
+type InvestmentPosition struct {
+	ID           uuid.UUID  `db:"id" json:"id,omitempty"`
+	Amount       int64      `db:"amount" json:"amount,omitempty"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at,omitempty"`
+	DeletedAt    *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	InvestmentID uuid.UUID  `db:"investment_id" json:"investment_id,omitempty"`
+}
+
+type Investment struct {
+	ID                uuid.UUID  `db:"id" json:"id,omitempty"`
+	ExternalID        string     `db:"external_id" json:"external_id,omitempty"`
+	Name              string     `db:"name" json:"name,omitempty"`
+	Type              string     `db:"type" json:"type,omitempty"`
+	Subtype           *string    `db:"subtype" json:"subtype,omitempty"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at,omitempty"`
+	DeletedAt         *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	UserInstitutionID uuid.UUID  `db:"user_institution_id" json:"user_institution_id,omitempty"`
+}
+
+type JobRun struct {
+	ID                uuid.UUID  `db:"id" json:"id,omitempty"`
+	Job               string     `db:"job" json:"job,omitempty"`
+	StartedAt         time.Time  `db:"started_at" json:"started_at,omitempty"`
+	FinishedAt        *time.Time `db:"finished_at" json:"finished_at,omitempty"`
+	AccountsProcessed int64      `db:"accounts_processed" json:"accounts_processed,omitempty"`
+	ErrorMessage      *string    `db:"error_message" json:"error_message,omitempty"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at,omitempty"`
+}
+
+type Merchant struct {
+	ID        uuid.UUID `db:"id" json:"id,omitempty"`
+	Key       string    `db:"key" json:"key,omitempty"`
+	Name      string    `db:"name" json:"name,omitempty"`
+	Document  *string   `db:"document" json:"document,omitempty"`
+	Mcc       *int32    `db:"mcc" json:"mcc,omitempty"`
+	Logo      *string   `db:"logo" json:"logo,omitempty"`
+	CreatedAt time.Time `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at,omitempty"`
+}
+
 type PaymentMethod struct {
 	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
 	ExternalID string     `db:"external_id" json:"external_id,omitempty"`
@@ -97,30 +193,144 @@ type PaymentMethod struct {
 	DeletedAt  *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
 }
 
-type TransactionCategory struct {
-	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
-	ExternalID string     `db:"external_id" json:"external_id,omitempty"`
-	Name       string     `db:"name" json:"name,omitempty"`
-	CreatedAt  time.Time  `db:"created_at" json:"created_at,omitempty"`
-	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at,omitempty"`
-	DeletedAt  *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+type RecurringSeries struct {
+	ID              uuid.UUID `db:"id" json:"id,omitempty"`
+	Name            string    `db:"name" json:"name,omitempty"`
+	NormalizedName  string    `db:"normalized_name" json:"normalized_name,omitempty"`
+	Frequency       string    `db:"frequency" json:"frequency,omitempty"`
+	Amount          int64     `db:"amount" json:"amount,omitempty"`
+	PreviousAmount  *int64    `db:"previous_amount" json:"previous_amount,omitempty"`
+	AverageAmount   int64     `db:"average_amount" json:"average_amount,omitempty"`
+	Occurrences     int32     `db:"occurrences" json:"occurrences,omitempty"`
+	FirstDate       time.Time `db:"first_date" json:"first_date,omitempty"`
+	LastDate        time.Time `db:"last_date" json:"last_date,omitempty"`
+	NextDate        time.Time `db:"next_date" json:"next_date,omitempty"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt       time.Time `db:"updated_at" json:"updated_at,omitempty"`
+	UserID          uuid.UUID `db:"user_id" json:"user_id,omitempty"`
+	PaymentMethodID uuid.UUID `db:"payment_method_id" json:"payment_method_id,omitempty"`
+	CategoryID      uuid.UUID `db:"category_id" json:"category_id,omitempty"`
 }
 
-type Transaction struct {
+type Rule struct {
 	ID              uuid.UUID  `db:"id" json:"id,omitempty"`
-	ExternalID      *string    `db:"external_id" json:"external_id,omitempty"`
 	Name            string     `db:"name" json:"name,omitempty"`
-	Amount          int64      `db:"amount" json:"amount,omitempty"`
-	IsIgnored       bool       `db:"is_ignored" json:"is_ignored,omitempty"`
-	Date            time.Time  `db:"date" json:"date,omitempty"`
+	Priority        int32      `db:"priority" json:"priority,omitempty"`
+	NameMatchType   *string    `db:"name_match_type" json:"name_match_type,omitempty"`
+	NamePattern     *string    `db:"name_pattern" json:"name_pattern,omitempty"`
+	MinAmount       *int64     `db:"min_amount" json:"min_amount,omitempty"`
+	MaxAmount       *int64     `db:"max_amount" json:"max_amount,omitempty"`
+	Direction       *string    `db:"direction" json:"direction,omitempty"`
+	RenameTo        *string    `db:"rename_to" json:"rename_to,omitempty"`
+	IsIgnored       *bool      `db:"is_ignored" json:"is_ignored,omitempty"`
 	CreatedAt       time.Time  `db:"created_at" json:"created_at,omitempty"`
 	UpdatedAt       time.Time  `db:"updated_at" json:"updated_at,omitempty"`
 	DeletedAt       *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
-	PaymentMethodID uuid.UUID  `db:"payment_method_id" json:"payment_method_id,omitempty"`
 	UserID          uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
-	CategoryID      uuid.UUID  `db:"category_id" json:"category_id,omitempty"`
-	AccountID       *uuid.UUID `db:"account_id" json:"account_id,omitempty"`
+	PaymentMethodID *uuid.UUID `db:"payment_method_id" json:"payment_method_id,omitempty"`
 	InstitutionID   *uuid.UUID `db:"institution_id" json:"institution_id,omitempty"`
+	CategoryID      *uuid.UUID `db:"category_id" json:"category_id,omitempty"`
+	TagID           *uuid.UUID `db:"tag_id" json:"tag_id,omitempty"`
+}
+
+type SyncRunItem struct {
+	ID            uuid.UUID `db:"id" json:"id,omitempty"`
+	Status        string    `db:"status" json:"status,omitempty"`
+	Fetched       int64     `db:"fetched" json:"fetched,omitempty"`
+	Inserted      int64     `db:"inserted" json:"inserted,omitempty"`
+	Updated       int64     `db:"updated" json:"updated,omitempty"`
+	Skipped       int64     `db:"skipped" json:"skipped,omitempty"`
+	Deleted       int64     `db:"deleted" json:"deleted,omitempty"`
+	FailureReason *string   `db:"failure_reason" json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at,omitempty"`
+	SyncRunID     uuid.UUID `db:"sync_run_id" json:"sync_run_id,omitempty"`
+	AccountID     uuid.UUID `db:"account_id" json:"account_id,omitempty"`
+}
+
+type SyncRun struct {
+	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
+	StartedAt  time.Time  `db:"started_at" json:"started_at,omitempty"`
+	FinishedAt *time.Time `db:"finished_at" json:"finished_at,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at,omitempty"`
+}
+
+type Tag struct {
+	ID        uuid.UUID  `db:"id" json:"id,omitempty"`
+	Name      string     `db:"name" json:"name,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	UserID    uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
+}
+
+type TransactionCategory struct {
+	ID         uuid.UUID  `db:"id" json:"id,omitempty"`
+	ExternalID *string    `db:"external_id" json:"external_id,omitempty"`
+	Name       string     `db:"name" json:"name,omitempty"`
+	Icon       *string    `db:"icon" json:"icon,omitempty"`
+	Color      *string    `db:"color" json:"color,omitempty"`
+	CreatedAt  time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt  time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt  *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	UserID     *uuid.UUID `db:"user_id" json:"user_id,omitempty"`
+	ParentID   *uuid.UUID `db:"parent_id" json:"parent_id,omitempty"`
+}
+
+type TransactionSplit struct {
+	ID            uuid.UUID  `db:"id" json:"id,omitempty"`
+	Amount        int64      `db:"amount" json:"amount,omitempty"`
+	Note          *string    `db:"note" json:"note,omitempty"`
+	CreatedAt     time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt     time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt     *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	TransactionID uuid.UUID  `db:"transaction_id" json:"transaction_id,omitempty"`
+	CategoryID    uuid.UUID  `db:"category_id" json:"category_id,omitempty"`
+}
+
+type TransactionTag struct {
+	CreatedAt     time.Time `db:"created_at" json:"created_at,omitempty"`
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id,omitempty"`
+	TagID         uuid.UUID `db:"tag_id" json:"tag_id,omitempty"`
+}
+
+type Transaction struct {
+	ID                        uuid.UUID  `db:"id" json:"id,omitempty"`
+	ExternalID                *string    `db:"external_id" json:"external_id,omitempty"`
+	Name                      string     `db:"name" json:"name,omitempty"`
+	Notes                     *string    `db:"notes" json:"notes,omitempty"`
+	Amount                    int64      `db:"amount" json:"amount,omitempty"`
+	IsIgnored                 bool       `db:"is_ignored" json:"is_ignored,omitempty"`
+	Date                      time.Time  `db:"date" json:"date,omitempty"`
+	PurchaseDate              *time.Time `db:"purchase_date" json:"purchase_date,omitempty"`
+	InstallmentNumber         *int32     `db:"installment_number" json:"installment_number,omitempty"`
+	TotalInstallments         *int32     `db:"total_installments" json:"total_installments,omitempty"`
+	Status                    string     `db:"status" json:"status,omitempty"`
+	IsNameOverridden          bool       `db:"is_name_overridden" json:"is_name_overridden,omitempty"`
+	IsAmountOverridden        bool       `db:"is_amount_overridden" json:"is_amount_overridden,omitempty"`
+	IsDateOverridden          bool       `db:"is_date_overridden" json:"is_date_overridden,omitempty"`
+	IsCategoryOverridden      bool       `db:"is_category_overridden" json:"is_category_overridden,omitempty"`
+	IsPaymentMethodOverridden bool       `db:"is_payment_method_overridden" json:"is_payment_method_overridden,omitempty"`
+	CreatedAt                 time.Time  `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt                 time.Time  `db:"updated_at" json:"updated_at,omitempty"`
+	DeletedAt                 *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+	IsHidden                  bool       `db:"is_hidden" json:"is_hidden,omitempty"`
+	PaymentMethodID           uuid.UUID  `db:"payment_method_id" json:"payment_method_id,omitempty"`
+	UserID                    uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
+	CategoryID                uuid.UUID  `db:"category_id" json:"category_id,omitempty"`
+	AccountID                 *uuid.UUID `db:"account_id" json:"account_id,omitempty"`
+	InstitutionID             *uuid.UUID `db:"institution_id" json:"institution_id,omitempty"`
+	BillID                    *uuid.UUID `db:"bill_id" json:"bill_id,omitempty"`
+	MerchantID                *uuid.UUID `db:"merchant_id" json:"merchant_id,omitempty"`
+}
+
+type Transfer struct {
+	ID                    uuid.UUID `db:"id" json:"id,omitempty"`
+	Status                string    `db:"status" json:"status,omitempty"`
+	CreatedAt             time.Time `db:"created_at" json:"created_at,omitempty"`
+	UpdatedAt             time.Time `db:"updated_at" json:"updated_at,omitempty"`
+	UserID                uuid.UUID `db:"user_id" json:"user_id,omitempty"`
+	OutgoingTransactionID uuid.UUID `db:"outgoing_transaction_id" json:"outgoing_transaction_id,omitempty"`
+	IncomingTransactionID uuid.UUID `db:"incoming_transaction_id" json:"incoming_transaction_id,omitempty"`
 }
 
 type UserAuthProvider struct {
@@ -137,6 +347,7 @@ type UserAuthProvider struct {
 type UserInstitution struct {
 	ID            uuid.UUID  `db:"id" json:"id,omitempty"`
 	ExternalID    string     `db:"external_id" json:"external_id,omitempty"`
+	Status        string     `db:"status" json:"status,omitempty"`
 	CreatedAt     time.Time  `db:"created_at" json:"created_at,omitempty"`
 	DeletedAt     *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
 	UserID        uuid.UUID  `db:"user_id" json:"user_id,omitempty"`
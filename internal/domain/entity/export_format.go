@@ -0,0 +1,11 @@
+package entity
+
+// ExportFormat is the file format of exported transactions, named after the
+// file extension.
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatOFX  ExportFormat = "ofx"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
@@ -0,0 +1,23 @@
+package entity
+
+// ImportExternalIDPrefix marks the transactions created by imports, whose
+// external id is a hash of their content instead of an open finance id.
+const ImportExternalIDPrefix = "import:"
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV ImportFormat = "CSV"
+	ImportFormatOFX ImportFormat = "OFX"
+	ImportFormatQIF ImportFormat = "QIF"
+)
+
+// ImportCSVPreset maps the columns of the CSV statements of a bank.
+type ImportCSVPreset string
+
+const (
+	ImportCSVPresetGeneric       ImportCSVPreset = "GENERIC"
+	ImportCSVPresetNubankAccount ImportCSVPreset = "NUBANK_ACCOUNT"
+	ImportCSVPresetNubankCard    ImportCSVPreset = "NUBANK_CARD"
+	ImportCSVPresetInter         ImportCSVPreset = "INTER"
+)
@@ -0,0 +1,12 @@
+package entity
+
+import "github.com/google/uuid"
+
+// FullInstitution is an institution along with the state of the user
+// connection to it. Connection fields are nil when the institution is not
+// listed for a user.
+type FullInstitution struct {
+	Institution
+	UserInstitutionID *uuid.UUID             `db:"user_institution_id" json:"user_institution_id,omitzero"`
+	ConnectionStatus  *UserInstitutionStatus `db:"connection_status"   json:"connection_status,omitzero"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "github.com/google/uuid"
+
+type InvestmentType = string
+
+const (
+	InvestmentTypeCOE         InvestmentType = "COE"
+	InvestmentTypeEquity      InvestmentType = "EQUITY"
+	InvestmentTypeETF         InvestmentType = "ETF"
+	InvestmentTypeFixedIncome InvestmentType = "FIXED_INCOME"
+	InvestmentTypeMutualFund  InvestmentType = "MUTUAL_FUND"
+	InvestmentTypeSecurity    InvestmentType = "SECURITY"
+	InvestmentTypeOther       InvestmentType = "OTHER"
+)
+
+// FullInvestment is an investment along with its latest position and the
+// institution it is held at.
+type FullInvestment struct {
+	Investment
+	Balance         int64      `db:"balance"          json:"balance"`
+	UserID          *uuid.UUID `db:"user_id"          json:"user_id,omitzero"`
+	InstitutionID   *uuid.UUID `db:"institution_id"   json:"institution_id,omitzero"`
+	InstitutionName *string    `db:"institution_name" json:"institution_name,omitzero"`
+	InstitutionLogo *string    `db:"institution_logo" json:"institution_logo,omitzero"`
+}
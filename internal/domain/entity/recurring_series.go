@@ -0,0 +1,9 @@
+package entity
+
+type RecurringFrequency = string
+
+const (
+	RecurringFrequencyWeekly  RecurringFrequency = "WEEKLY"
+	RecurringFrequencyMonthly RecurringFrequency = "MONTHLY"
+	RecurringFrequencyYearly  RecurringFrequency = "YEARLY"
+)
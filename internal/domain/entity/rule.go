@@ -0,0 +1,16 @@
+package entity
+
+type RuleNameMatchType = string
+
+const (
+	RuleNameMatchTypeContains RuleNameMatchType = "CONTAINS"
+	RuleNameMatchTypeRegex    RuleNameMatchType = "REGEX"
+	RuleNameMatchTypeFuzzy    RuleNameMatchType = "FUZZY"
+)
+
+type RuleDirection = string
+
+const (
+	RuleDirectionIncome  RuleDirection = "INCOME"
+	RuleDirectionExpense RuleDirection = "EXPENSE"
+)
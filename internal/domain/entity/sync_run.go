@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SyncRunItemStatus = string
+
+const (
+	SyncRunItemStatusSuccess SyncRunItemStatus = "SUCCESS"
+	SyncRunItemStatusFailed  SyncRunItemStatus = "FAILED"
+)
+
+// AccountSyncStatus is the outcome of the latest sync run of an account.
+// Sync fields are nil when the account was never synchronized.
+type AccountSyncStatus struct {
+	AccountID       uuid.UUID  `db:"account_id"       json:"account_id"`
+	AccountName     string     `db:"account_name"     json:"account_name"`
+	AccountType     string     `db:"account_type"     json:"account_type"`
+	InstitutionName string     `db:"institution_name" json:"institution_name"`
+	InstitutionLogo *string    `db:"institution_logo" json:"institution_logo,omitzero"`
+	Status          *string    `db:"status"           json:"status,omitzero"`
+	Fetched         *int64     `db:"fetched"          json:"fetched,omitzero"`
+	Inserted        *int64     `db:"inserted"         json:"inserted,omitzero"`
+	Updated         *int64     `db:"updated"          json:"updated,omitzero"`
+	Skipped         *int64     `db:"skipped"          json:"skipped,omitzero"`
+	Deleted         *int64     `db:"deleted"          json:"deleted,omitzero"`
+	FailureReason   *string    `db:"failure_reason"   json:"failure_reason,omitzero"`
+	LastSyncAt      *time.Time `db:"last_sync_at"     json:"last_sync_at,omitzero"`
+	LastSuccessAt   *time.Time `db:"last_success_at"  json:"last_success_at,omitzero"`
+}
@@ -6,4 +6,16 @@ type FullTransaction struct {
 	PaymentMethodName string  `db:"payment_method_name" json:"payment_method_name,omitzero"`
 	InstitutionName   *string `db:"institution_name"    json:"institution_name,omitzero"`
 	InstitutionLogo   *string `db:"institution_logo"    json:"institution_logo,omitzero"`
+	MerchantName      *string `db:"merchant_name"       json:"merchant_name,omitzero"`
+	MerchantLogo      *string `db:"merchant_logo"       json:"merchant_logo,omitzero"`
+
+	Splits []TransactionSplit `db:"-" json:"splits,omitzero"`
+	Tags   []Tag              `db:"-" json:"tags,omitzero"`
 }
+
+type TransactionStatus = string
+
+const (
+	TransactionStatusPending TransactionStatus = "PENDING"
+	TransactionStatusPosted  TransactionStatus = "POSTED"
+)
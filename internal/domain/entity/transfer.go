@@ -0,0 +1,9 @@
+package entity
+
+type TransferStatus = string
+
+const (
+	TransferStatusMatched   TransferStatus = "MATCHED"
+	TransferStatusConfirmed TransferStatus = "CONFIRMED"
+	TransferStatusUnlinked  TransferStatus = "UNLINKED"
+)
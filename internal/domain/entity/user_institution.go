@@ -0,0 +1,8 @@
+package entity
+
+type UserInstitutionStatus = string
+
+const (
+	UserInstitutionStatusConnected         UserInstitutionStatus = "CONNECTED"
+	UserInstitutionStatusNeedsReconnection UserInstitutionStatus = "NEEDS_RECONNECTION"
+)
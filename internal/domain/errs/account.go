@@ -13,4 +13,28 @@ var (
 		"Não foi possível encontrar contas de usuários com tier premium ou trial",
 		ErrCodeNotFound,
 	)
+	ErrAccountNotFound = New(
+		"Conta não encontrada",
+		ErrCodeNotFound,
+	)
+	ErrAccountNotCreditCard = New(
+		"Essa conta não é um cartão de crédito",
+		ErrCodeValidation,
+	)
+	ErrManualAccountRequired = New(
+		"Transações só podem ser vinculadas a contas manuais",
+		ErrCodeValidation,
+	)
+	ErrWalletAlreadyExists = New(
+		"Você já possui uma carteira",
+		ErrCodeValidation,
+	)
+	ErrInvalidBalanceHistoryPeriod = New(
+		"A data inicial deve ser anterior à data final",
+		ErrCodeValidation,
+	)
+	ErrBalanceHistoryTooLong = New(
+		"Período longo demais para o intervalo escolhido",
+		ErrCodeValidation,
+	)
 )
@@ -0,0 +1,28 @@
+package errs
+
+var (
+	ErrAttachmentNotFound = New(
+		"Anexo não encontrado",
+		ErrCodeNotFound,
+	)
+	ErrAttachmentTooLarge = New(
+		"O anexo excede o tamanho máximo permitido",
+		ErrCodeValidation,
+	)
+	ErrAttachmentEmpty = New(
+		"O anexo está vazio",
+		ErrCodeValidation,
+	)
+	ErrInvalidAttachmentType = New(
+		"Tipo de anexo não suportado, envie uma imagem (JPEG, PNG ou WebP) ou um PDF",
+		ErrCodeValidation,
+	)
+	ErrAttachmentQuotaExceeded = New(
+		"Limite de armazenamento de anexos atingido para o seu plano",
+		ErrCodeForbidden,
+	)
+	ErrAttachmentUploadInProgress = New(
+		"Outro anexo está sendo enviado, tente novamente em instantes",
+		ErrCodeValidation,
+	)
+)
@@ -13,4 +13,8 @@ var (
 		"Você não possui um orçamento cadastrado para essa categoria",
 		ErrCodeNotFound,
 	)
+	ErrBudgetSubCategory = New(
+		"Subcategorias não podem ser usadas no orçamento, use a categoria pai",
+		ErrCodeValidation,
+	)
 )
@@ -9,4 +9,16 @@ var (
 		"Uma ou mais categorias não foram encontradas",
 		ErrCodeNotFound,
 	)
+	ErrCategoryNotEditable = New(
+		"Somente categorias personalizadas podem ser alteradas",
+		ErrCodeValidation,
+	)
+	ErrInvalidCategoryParent = New(
+		"A categoria pai deve ser uma categoria principal do provedor",
+		ErrCodeValidation,
+	)
+	ErrInvalidCategoryMerge = New(
+		"Não é possível mesclar uma categoria com ela mesma",
+		ErrCodeValidation,
+	)
 )
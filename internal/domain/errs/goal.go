@@ -0,0 +1,24 @@
+package errs
+
+var (
+	ErrGoalNotFound = New(
+		"Objetivo não encontrado",
+		ErrCodeNotFound,
+	)
+	ErrGoalContributionNotFound = New(
+		"Contribuição não encontrada",
+		ErrCodeNotFound,
+	)
+	ErrGoalTargetRequired = New(
+		"Informe o valor do objetivo ou os dados da reserva de emergência",
+		ErrCodeValidation,
+	)
+	ErrGoalAccountNotSupported = New(
+		"Objetivos só podem ser vinculados a contas correntes ou à carteira",
+		ErrCodeValidation,
+	)
+	ErrGoalHasLinkedAccount = New(
+		"Objetivos vinculados a uma conta acompanham o saldo dela e não recebem contribuições",
+		ErrCodeValidation,
+	)
+)
@@ -0,0 +1,20 @@
+package errs
+
+var (
+	ErrImportFileEmpty = New(
+		"O arquivo não possui transações",
+		ErrCodeValidation,
+	)
+	ErrImportFileTooLarge = New(
+		"O arquivo excede o tamanho máximo permitido",
+		ErrCodeValidation,
+	)
+	ErrInvalidImportFile = New(
+		"Arquivo inválido, envie um extrato em CSV, OFX ou QIF",
+		ErrCodeValidation,
+	)
+	ErrImportCSVColumnsNotFound = New(
+		"Não foi possível encontrar as colunas de data, descrição e valor do CSV, verifique o modelo escolhido",
+		ErrCodeValidation,
+	)
+)
@@ -5,4 +5,8 @@ var (
 		"Instituição não encontrada",
 		ErrCodeNotFound,
 	)
+	ErrUserInstitutionNotFound = New(
+		"Conexão com a instituição não encontrada",
+		ErrCodeNotFound,
+	)
 )
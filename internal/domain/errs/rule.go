@@ -0,0 +1,24 @@
+package errs
+
+var (
+	ErrRuleNotFound = New(
+		"Regra não encontrada",
+		ErrCodeNotFound,
+	)
+	ErrRuleWithoutCondition = New(
+		"A regra deve possuir ao menos uma condição",
+		ErrCodeValidation,
+	)
+	ErrRuleWithoutAction = New(
+		"A regra deve possuir ao menos uma ação",
+		ErrCodeValidation,
+	)
+	ErrInvalidRuleNamePattern = New(
+		"O padrão de nome da regra não é uma expressão regular válida",
+		ErrCodeValidation,
+	)
+	ErrInvalidRuleAmountRange = New(
+		"O valor mínimo da regra deve ser menor ou igual ao valor máximo",
+		ErrCodeValidation,
+	)
+)
@@ -0,0 +1,16 @@
+package errs
+
+var (
+	ErrTagNotFound = New(
+		"Tag não encontrada",
+		ErrCodeNotFound,
+	)
+	ErrTagsNotFound = New(
+		"Uma ou mais tags não foram encontradas",
+		ErrCodeNotFound,
+	)
+	ErrTagAlreadyExists = New(
+		"Já existe uma tag com esse nome",
+		ErrCodeValidation,
+	)
+)
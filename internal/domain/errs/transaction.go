@@ -5,4 +5,28 @@ var (
 		"Transação não encontrada",
 		ErrCodeNotFound,
 	)
+	ErrInvalidTransactionSplitAmount = New(
+		"A soma das divisões deve ser igual ao valor da transação",
+		ErrCodeValidation,
+	)
+	ErrInvalidTransactionSplitSign = New(
+		"As divisões devem ter o mesmo sinal do valor da transação",
+		ErrCodeValidation,
+	)
+	ErrSplitTransactionAmount = New(
+		"Remova as divisões da transação antes de alterar o seu valor",
+		ErrCodeValidation,
+	)
+	ErrSyncedTransactionDelete = New(
+		"Transações sincronizadas não podem ser excluídas, oculte-as",
+		ErrCodeValidation,
+	)
+	ErrManualTransactionHide = New(
+		"Somente transações sincronizadas podem ser ocultadas",
+		ErrCodeValidation,
+	)
+	ErrBulkTransactionsSelector = New(
+		"Selecione as transações pelos ids ou por filtros",
+		ErrCodeValidation,
+	)
 )
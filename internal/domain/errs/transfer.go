@@ -0,0 +1,8 @@
+package errs
+
+var (
+	ErrTransferNotFound = New(
+		"Transferência não encontrada",
+		ErrCodeNotFound,
+	)
+)
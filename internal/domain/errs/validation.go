@@ -13,4 +13,8 @@ var (
 		`Utilize um valor verdadeiro ou falso (1, t, T, TRUE, true, True, 0, f, F, FALSE, false, False)`,
 		ErrCodeValidation,
 	)
+	ErrInvalidBody = New(
+		"Corpo da requisição inválido",
+		ErrCodeValidation,
+	)
 )
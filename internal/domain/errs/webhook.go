@@ -0,0 +1,12 @@
+package errs
+
+var (
+	ErrInvalidWebhookSecret = New(
+		"Segredo do webhook inválido",
+		ErrCodeUnauthorized,
+	)
+	ErrReplayedWebhook = New(
+		"Webhook já processado",
+		ErrCodeUnauthorized,
+	)
+)
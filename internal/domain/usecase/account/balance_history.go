@@ -0,0 +1,176 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+// maxBalanceHistoryPoints limits the points of a balance history, about a
+// year of days.
+const maxBalanceHistoryPoints = 366
+
+// defaultBalanceHistoryPoints is how many points are listed up to the end
+// date when no start date is given.
+var defaultBalanceHistoryPoints = map[entity.BalanceInterval]int{
+	entity.BalanceIntervalDay:   30,
+	entity.BalanceIntervalWeek:  12,
+	entity.BalanceIntervalMonth: 12,
+}
+
+type BalancePoint struct {
+	Date    time.Time `json:"date"`
+	Balance int64     `json:"balance"`
+}
+
+// BalanceSeries is the balance history of an account, or of the accounts of
+// an institution. Manual accounts have no institution, so they are always a
+// series of their own.
+type BalanceSeries struct {
+	AccountID     *uuid.UUID     `json:"account_id,omitzero"`
+	AccountType   string         `json:"account_type,omitzero"`
+	InstitutionID *uuid.UUID     `json:"institution_id,omitzero"`
+	Name          string         `json:"name"`
+	Points        []BalancePoint `json:"points"`
+}
+
+// toIntervalStart truncates the date to the start of its interval, weeks
+// start on monday.
+func toIntervalStart(
+	date time.Time,
+	interval entity.BalanceInterval,
+) time.Time {
+	switch interval {
+	case entity.BalanceIntervalWeek:
+		daysSinceMonday := (int(date.Weekday()) + 6) % 7
+		return time.Date(
+			date.Year(),
+			date.Month(),
+			date.Day()-daysSinceMonday,
+			0,
+			0,
+			0,
+			0,
+			date.Location(),
+		)
+	case entity.BalanceIntervalMonth:
+		return time.Date(
+			date.Year(),
+			date.Month(),
+			1,
+			0,
+			0,
+			0,
+			0,
+			date.Location(),
+		)
+	default:
+		return time.Date(
+			date.Year(),
+			date.Month(),
+			date.Day(),
+			0,
+			0,
+			0,
+			0,
+			date.Location(),
+		)
+	}
+}
+
+func addIntervals(
+	date time.Time,
+	interval entity.BalanceInterval,
+	n int,
+) time.Time {
+	switch interval {
+	case entity.BalanceIntervalWeek:
+		return date.AddDate(0, 0, 7*n)
+	case entity.BalanceIntervalMonth:
+		return date.AddDate(0, n, 0)
+	default:
+		return date.AddDate(0, 0, n)
+	}
+}
+
+// countIntervals counts the interval starts from start up to end, stopping
+// once limit is exceeded.
+func countIntervals(
+	start, end time.Time,
+	interval entity.BalanceInterval,
+	limit int,
+) int {
+	for count := 0; ; count++ {
+		if count > limit || addIntervals(start, interval, count).After(end) {
+			return count
+		}
+	}
+}
+
+// buildBalanceHistory sums the account points, ordered by date, into the
+// net worth and the series of each group.
+func buildBalanceHistory(
+	points []entity.AccountBalancePoint,
+	groupBy entity.BalanceHistoryGroupBy,
+) ([]BalancePoint, []BalanceSeries) {
+	netWorth := []BalancePoint{}
+	series := []BalanceSeries{}
+	seriesIndexByKey := map[uuid.UUID]int{}
+
+	for _, point := range points {
+		if len(netWorth) == 0 ||
+			!netWorth[len(netWorth)-1].Date.Equal(point.Date) {
+			netWorth = append(netWorth, BalancePoint{Date: point.Date})
+		}
+		netWorth[len(netWorth)-1].Balance += point.Balance
+
+		key := point.AccountID
+		if groupBy == entity.BalanceHistoryGroupByInstitution &&
+			point.InstitutionID != nil {
+			key = *point.InstitutionID
+		}
+
+		i, ok := seriesIndexByKey[key]
+		if !ok {
+			i = len(series)
+			seriesIndexByKey[key] = i
+			series = append(series, newBalanceSeries(point, groupBy))
+		}
+
+		seriesPoints := series[i].Points
+		if len(seriesPoints) == 0 ||
+			!seriesPoints[len(seriesPoints)-1].Date.Equal(point.Date) {
+			series[i].Points = append(
+				seriesPoints,
+				BalancePoint{Date: point.Date},
+			)
+		}
+		series[i].Points[len(series[i].Points)-1].Balance += point.Balance
+	}
+
+	return netWorth, series
+}
+
+func newBalanceSeries(
+	point entity.AccountBalancePoint,
+	groupBy entity.BalanceHistoryGroupBy,
+) BalanceSeries {
+	if groupBy == entity.BalanceHistoryGroupByInstitution &&
+		point.InstitutionID != nil {
+		return BalanceSeries{
+			InstitutionID: point.InstitutionID,
+			Name:          ptr.Deref(point.InstitutionName),
+		}
+	}
+
+	accountID := point.AccountID
+	return BalanceSeries{
+		AccountID:     &accountID,
+		AccountType:   point.AccountType,
+		InstitutionID: point.InstitutionID,
+		Name:          point.AccountName,
+	}
+}
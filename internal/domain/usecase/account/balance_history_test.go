@@ -0,0 +1,267 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestToIntervalStart(t *testing.T) {
+	t.Parallel()
+
+	// 2025-05-14 is a wednesday
+	date := time.Date(2025, 5, 14, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		date        time.Time
+		interval    entity.BalanceInterval
+		expected    time.Time
+	}{
+		{
+			description: "day",
+			date:        date,
+			interval:    entity.BalanceIntervalDay,
+			expected:    time.Date(2025, 5, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "week starts on monday",
+			date:        date,
+			interval:    entity.BalanceIntervalWeek,
+			expected:    time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "sunday belongs to the previous week",
+			date:        time.Date(2025, 5, 18, 10, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalWeek,
+			expected:    time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "week across months",
+			date:        time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalWeek,
+			expected:    time.Date(2025, 5, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "month",
+			date:        date,
+			interval:    entity.BalanceIntervalMonth,
+			expected:    time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(
+				t,
+				test.expected,
+				toIntervalStart(test.date, test.interval),
+			)
+		})
+	}
+}
+
+func TestCountIntervals(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		end         time.Time
+		interval    entity.BalanceInterval
+		limit       int
+		expected    int
+	}{
+		{
+			description: "same day",
+			end:         start,
+			interval:    entity.BalanceIntervalDay,
+			limit:       10,
+			expected:    1,
+		},
+		{
+			description: "days",
+			end:         time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalDay,
+			limit:       100,
+			expected:    31,
+		},
+		{
+			description: "weeks",
+			end:         time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalWeek,
+			limit:       100,
+			expected:    5,
+		},
+		{
+			description: "months",
+			end:         time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalMonth,
+			limit:       100,
+			expected:    12,
+		},
+		{
+			description: "stops past the limit",
+			end:         time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			interval:    entity.BalanceIntervalDay,
+			limit:       10,
+			expected:    11,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(
+				t,
+				test.expected,
+				countIntervals(start, test.end, test.interval, test.limit),
+			)
+		})
+	}
+}
+
+func TestBuildBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	institutionID := uuid.New()
+	checkingID, creditCardID, walletID := uuid.New(), uuid.New(), uuid.New()
+
+	point := func(
+		date time.Time,
+		accountID uuid.UUID,
+		balance int64,
+	) entity.AccountBalancePoint {
+		p := entity.AccountBalancePoint{
+			Date:      date,
+			AccountID: accountID,
+			Balance:   balance,
+		}
+		switch accountID {
+		case checkingID:
+			p.AccountName = "Conta corrente"
+			p.AccountType = entity.AccountTypeBank
+		case creditCardID:
+			p.AccountName = "Cartão"
+			p.AccountType = entity.AccountTypeCredit
+		case walletID:
+			p.AccountName = "Carteira"
+			p.AccountType = entity.AccountTypeWallet
+			return p
+		}
+		p.InstitutionID = &institutionID
+		p.InstitutionName = ptr.New("Banco")
+		return p
+	}
+
+	points := []entity.AccountBalancePoint{
+		point(jan, creditCardID, -300_00),
+		point(jan, checkingID, 1_000_00),
+		point(jan, walletID, 50_00),
+		point(feb, creditCardID, -500_00),
+		point(feb, checkingID, 1_200_00),
+		point(feb, walletID, 20_00),
+	}
+
+	expectedNetWorth := []BalancePoint{
+		{Date: jan, Balance: 750_00},
+		{Date: feb, Balance: 720_00},
+	}
+
+	tests := []struct {
+		description string
+		points      []entity.AccountBalancePoint
+		groupBy     entity.BalanceHistoryGroupBy
+		netWorth    []BalancePoint
+		series      []BalanceSeries
+	}{
+		{
+			description: "no accounts",
+			groupBy:     entity.BalanceHistoryGroupByInstitution,
+			netWorth:    []BalancePoint{},
+			series:      []BalanceSeries{},
+		},
+		{
+			description: "by account",
+			points:      points,
+			groupBy:     entity.BalanceHistoryGroupByAccount,
+			netWorth:    expectedNetWorth,
+			series: []BalanceSeries{
+				{
+					AccountID:     &creditCardID,
+					AccountType:   entity.AccountTypeCredit,
+					InstitutionID: &institutionID,
+					Name:          "Cartão",
+					Points: []BalancePoint{
+						{Date: jan, Balance: -300_00},
+						{Date: feb, Balance: -500_00},
+					},
+				},
+				{
+					AccountID:     &checkingID,
+					AccountType:   entity.AccountTypeBank,
+					InstitutionID: &institutionID,
+					Name:          "Conta corrente",
+					Points: []BalancePoint{
+						{Date: jan, Balance: 1_000_00},
+						{Date: feb, Balance: 1_200_00},
+					},
+				},
+				{
+					AccountID:   &walletID,
+					AccountType: entity.AccountTypeWallet,
+					Name:        "Carteira",
+					Points: []BalancePoint{
+						{Date: jan, Balance: 50_00},
+						{Date: feb, Balance: 20_00},
+					},
+				},
+			},
+		},
+		{
+			description: "by institution keeps manual accounts apart",
+			points:      points,
+			groupBy:     entity.BalanceHistoryGroupByInstitution,
+			netWorth:    expectedNetWorth,
+			series: []BalanceSeries{
+				{
+					InstitutionID: &institutionID,
+					Name:          "Banco",
+					Points: []BalancePoint{
+						{Date: jan, Balance: 700_00},
+						{Date: feb, Balance: 700_00},
+					},
+				},
+				{
+					AccountID:   &walletID,
+					AccountType: entity.AccountTypeWallet,
+					Name:        "Carteira",
+					Points: []BalancePoint{
+						{Date: jan, Balance: 50_00},
+						{Date: feb, Balance: 20_00},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			netWorth, series := buildBalanceHistory(test.points, test.groupBy)
+			assert.Equal(t, test.netWorth, netWorth)
+			assert.Equal(t, test.series, series)
+		})
+	}
+}
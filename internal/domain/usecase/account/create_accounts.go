@@ -74,7 +74,10 @@ func (uc *CreateAccountsUseCase) Execute(
 		return nil
 	}
 
-	userID := uuid.MustParse(in.ClientUserID)
+	userID, err := uuid.Parse(in.ClientUserID)
+	if err != nil {
+		return errs.ErrInvalidUUID
+	}
 
 	var (
 		institution         *entity.Institution
@@ -151,7 +154,7 @@ func (uc *CreateAccountsUseCase) Execute(
 		}
 	}
 
-	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
 		if userInstitution == nil {
 			userInstitutionParams := repo.CreateUserInstitutionParams{
 				UserID:        userID,
@@ -176,7 +179,8 @@ func (uc *CreateAccountsUseCase) Execute(
 				return errs.New(err)
 			}
 			params.ID = uuid.New()
-			params.UserInstitutionID = userInstitution.ID
+			params.UserInstitutionID = &userInstitution.ID
+			params.UserID = userID
 			createAccountsParams = append(
 				createAccountsParams,
 				params,
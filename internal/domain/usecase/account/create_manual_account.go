@@ -0,0 +1,77 @@
+package account
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type CreateManualAccountUseCase struct {
+	v  *validator.Validator
+	ar repo.AccountRepo
+}
+
+func NewCreateManualAccountUseCase(
+	v *validator.Validator,
+	ar repo.AccountRepo,
+) *CreateManualAccountUseCase {
+	return &CreateManualAccountUseCase{
+		v:  v,
+		ar: ar,
+	}
+}
+
+type CreateManualAccountUseCaseInput struct {
+	UserID uuid.UUID          `json:"-"    validate:"required"`
+	Type   entity.AccountType `json:"type" validate:"required,oneof=BANK WALLET"`
+	// Name defaults to "Carteira" for the wallet.
+	Name           string `json:"name"            validate:"required_unless=Type WALLET"`
+	OpeningBalance int64  `json:"opening_balance"`
+}
+
+// Execute creates an account the user keeps the balance of, like cash or a
+// foreign bank account. Its balance is the opening balance plus the
+// transactions linked to it.
+func (uc *CreateManualAccountUseCase) Execute(
+	ctx context.Context,
+	in CreateManualAccountUseCaseInput,
+) (*entity.ManualAccount, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	name := strings.TrimSpace(in.Name)
+
+	if in.Type == entity.AccountTypeWallet {
+		if err := validateWallet(ctx, uc.ar, in.UserID); err != nil {
+			return nil, errs.New(err)
+		}
+		if name == "" {
+			name = defaultWalletName
+		}
+	}
+
+	id := uuid.New()
+	account, err := uc.ar.CreateAccount(ctx, repo.CreateAccountParams{
+		ID:             id,
+		ExternalID:     id.String(),
+		Name:           name,
+		Type:           in.Type,
+		UserID:         in.UserID,
+		OpeningBalance: in.OpeningBalance,
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &entity.ManualAccount{
+		Account: *account,
+		Balance: account.OpeningBalance,
+	}, nil
+}
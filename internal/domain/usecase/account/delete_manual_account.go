@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type DeleteManualAccountUseCase struct {
+	tx tx.TX
+	ar repo.AccountRepo
+	tr repo.TransactionRepo
+}
+
+func NewDeleteManualAccountUseCase(
+	tx tx.TX,
+	ar repo.AccountRepo,
+	tr repo.TransactionRepo,
+) *DeleteManualAccountUseCase {
+	return &DeleteManualAccountUseCase{
+		tx: tx,
+		ar: ar,
+		tr: tr,
+	}
+}
+
+type DeleteManualAccountUseCaseInput struct {
+	ID     uuid.UUID `json:"account_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute deletes a manual account along with its transactions, which are
+// moved to the trash.
+func (uc *DeleteManualAccountUseCase) Execute(
+	ctx context.Context,
+	in DeleteManualAccountUseCaseInput,
+) error {
+	if _, err := getOwnManualAccount(ctx, uc.ar, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.tr.DeleteTransactionsByAccountID(ctx, in.ID); err != nil {
+			return errs.New(err)
+		}
+
+		return uc.ar.DeleteAccount(ctx, in.ID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
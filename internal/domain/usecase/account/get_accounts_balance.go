@@ -3,9 +3,11 @@ package account
 import (
 	"context"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/money"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/google/uuid"
@@ -17,17 +19,20 @@ type GetAccountsBalanceUseCase struct {
 	v   *validator.Validator
 	tr  repo.TransactionRepo
 	abr repo.AccountBalanceRepo
+	tgr repo.TagRepo
 }
 
 func NewGetAccountsBalanceUseCase(
 	v *validator.Validator,
 	tr repo.TransactionRepo,
 	abr repo.AccountBalanceRepo,
+	tgr repo.TagRepo,
 ) *GetAccountsBalanceUseCase {
 	return &GetAccountsBalanceUseCase{
 		v:   v,
 		tr:  tr,
 		abr: abr,
+		tgr: tgr,
 	}
 }
 
@@ -47,6 +52,16 @@ type GetAccountsBalanceUseCaseOutput struct {
 	CurrentExpense             int64                    `json:"current_expense"`
 	PreviousExpense            int64                    `json:"previous_expense"`
 	ExpensePercentageVariation int64                    `json:"expense_percentage_variation"`
+
+	// Tags sums the current income and expense of each tag with
+	// transactions in the period.
+	Tags []GetAccountsBalanceUseCaseTagOutput `json:"tags"`
+}
+
+type GetAccountsBalanceUseCaseTagOutput struct {
+	entity.Tag
+	Income  int64 `json:"income"`
+	Expense int64 `json:"expense"`
 }
 
 func (uc *GetAccountsBalanceUseCase) Execute(
@@ -62,6 +77,9 @@ func (uc *GetAccountsBalanceUseCase) Execute(
 		return nil, errs.New(err)
 	}
 
+	// Transfers between the user accounts are neither income nor expense
+	in.IsTransfer = ptr.New(false)
+
 	cmpDates := dateutil.CalculateComparisonDates(in.StartDate, in.EndDate)
 
 	g, gCtx := errgroup.WithContext(ctx)
@@ -144,10 +162,61 @@ func (uc *GetAccountsBalanceUseCase) Execute(
 		return err
 	})
 
+	var (
+		tags                            []entity.Tag
+		incomesByTagID, expensesByTagID map[uuid.UUID]int64
+	)
+
+	g.Go(func() (err error) {
+		tags, err = uc.tgr.ListTags(gCtx, repo.TagOptions{UserID: in.UserID})
+		return err
+	})
+
+	g.Go(func() (err error) {
+		opts := in.TransactionOptions
+		opts.StartDate = cmpDates.StartDate
+		opts.EndDate = cmpDates.EndDate
+		opts.IsIncome = true
+
+		incomesByTagID, err = uc.tr.SumTransactionsByTag(
+			gCtx,
+			in.UserID,
+			opts,
+		)
+		return err
+	})
+
+	g.Go(func() (err error) {
+		opts := in.TransactionOptions
+		opts.StartDate = cmpDates.StartDate
+		opts.EndDate = cmpDates.EndDate
+		opts.IsExpense = true
+
+		expensesByTagID, err = uc.tr.SumTransactionsByTag(
+			gCtx,
+			in.UserID,
+			opts,
+		)
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return nil, errs.New(err)
 	}
 
+	tagsOut := []GetAccountsBalanceUseCaseTagOutput{}
+	for _, tag := range tags {
+		income, expense := incomesByTagID[tag.ID], expensesByTagID[tag.ID]
+		if income == 0 && expense == 0 {
+			continue
+		}
+		tagsOut = append(tagsOut, GetAccountsBalanceUseCaseTagOutput{
+			Tag:     tag,
+			Income:  income,
+			Expense: expense,
+		})
+	}
+
 	balancePercentageVariation := money.CalculatePercentageVariation(
 		currentBalance,
 		previousBalance,
@@ -174,6 +243,7 @@ func (uc *GetAccountsBalanceUseCase) Execute(
 		CurrentExpense:             currentExpense,
 		PreviousExpense:            previousExpense,
 		ExpensePercentageVariation: expensePercentageVariation,
+		Tags:                       tagsOut,
 	}
 
 	return out, nil
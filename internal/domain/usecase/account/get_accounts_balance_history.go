@@ -0,0 +1,116 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type GetAccountsBalanceHistoryUseCase struct {
+	v   *validator.Validator
+	abr repo.AccountBalanceRepo
+}
+
+func NewGetAccountsBalanceHistoryUseCase(
+	v *validator.Validator,
+	abr repo.AccountBalanceRepo,
+) *GetAccountsBalanceHistoryUseCase {
+	return &GetAccountsBalanceHistoryUseCase{
+		v:   v,
+		abr: abr,
+	}
+}
+
+type GetAccountsBalanceHistoryUseCaseInput struct {
+	repo.AccountBalanceOptions
+	UserID    uuid.UUID                    `json:"user_id"    validate:"required"`
+	Interval  entity.BalanceInterval       `json:"interval"   validate:"omitempty,oneof=day week month"`
+	GroupBy   entity.BalanceHistoryGroupBy `json:"group_by"   validate:"omitempty,oneof=account institution"`
+	StartDate time.Time                    `json:"start_date"`
+	EndDate   time.Time                    `json:"end_date"`
+}
+
+type GetAccountsBalanceHistoryUseCaseOutput struct {
+	Interval  entity.BalanceInterval       `json:"interval"`
+	GroupBy   entity.BalanceHistoryGroupBy `json:"group_by"`
+	StartDate time.Time                    `json:"start_date"`
+	EndDate   time.Time                    `json:"end_date"`
+	// NetWorth is the sum of the balances of all accounts, with credit card
+	// debt subtracted.
+	NetWorth []BalancePoint  `json:"net_worth"`
+	Series   []BalanceSeries `json:"series"`
+}
+
+func (uc *GetAccountsBalanceHistoryUseCase) Execute(
+	ctx context.Context,
+	in GetAccountsBalanceHistoryUseCaseInput,
+) (*GetAccountsBalanceHistoryUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if in.Interval == "" {
+		in.Interval = entity.BalanceIntervalMonth
+	}
+
+	if in.GroupBy == "" {
+		in.GroupBy = entity.BalanceHistoryGroupByInstitution
+	}
+
+	now := time.Now()
+	if in.EndDate.IsZero() || in.EndDate.After(now) {
+		in.EndDate = now
+	}
+
+	if in.StartDate.IsZero() {
+		in.StartDate = addIntervals(
+			in.EndDate,
+			in.Interval,
+			1-defaultBalanceHistoryPoints[in.Interval],
+		)
+	}
+	in.StartDate = toIntervalStart(in.StartDate, in.Interval)
+
+	if in.StartDate.After(in.EndDate) {
+		return nil, errs.ErrInvalidBalanceHistoryPeriod
+	}
+
+	pointsCount := countIntervals(
+		in.StartDate,
+		in.EndDate,
+		in.Interval,
+		maxBalanceHistoryPoints,
+	)
+	if pointsCount > maxBalanceHistoryPoints {
+		return nil, errs.ErrBalanceHistoryTooLong
+	}
+
+	points, err := uc.abr.ListUserBalanceHistory(
+		ctx,
+		in.UserID,
+		in.StartDate,
+		in.EndDate,
+		in.Interval,
+		in.AccountBalanceOptions,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	netWorth, series := buildBalanceHistory(points, in.GroupBy)
+
+	return &GetAccountsBalanceHistoryUseCaseOutput{
+		Interval:  in.Interval,
+		GroupBy:   in.GroupBy,
+		StartDate: in.StartDate,
+		EndDate:   in.EndDate,
+		NetWorth:  netWorth,
+		Series:    series,
+	}, nil
+}
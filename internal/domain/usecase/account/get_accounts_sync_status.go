@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type GetAccountsSyncStatusUseCase struct {
+	v   *validator.Validator
+	srr repo.SyncRunRepo
+}
+
+func NewGetAccountsSyncStatusUseCase(
+	v *validator.Validator,
+	srr repo.SyncRunRepo,
+) *GetAccountsSyncStatusUseCase {
+	return &GetAccountsSyncStatusUseCase{
+		v:   v,
+		srr: srr,
+	}
+}
+
+type GetAccountsSyncStatusUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type GetAccountsSyncStatusUseCaseOutput struct {
+	Accounts []entity.AccountSyncStatus `json:"accounts"`
+}
+
+func (uc *GetAccountsSyncStatusUseCase) Execute(
+	ctx context.Context,
+	in GetAccountsSyncStatusUseCaseInput,
+) (*GetAccountsSyncStatusUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	statuses, err := uc.srr.ListAccountSyncStatuses(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &GetAccountsSyncStatusUseCaseOutput{
+		Accounts: statuses,
+	}, nil
+}
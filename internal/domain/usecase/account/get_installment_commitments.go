@@ -0,0 +1,143 @@
+package account
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type GetInstallmentCommitmentsUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+}
+
+func NewGetInstallmentCommitmentsUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+) *GetInstallmentCommitmentsUseCase {
+	return &GetInstallmentCommitmentsUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type GetInstallmentCommitmentsUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type InstallmentCommitment struct {
+	Month        time.Time `json:"month"`
+	Amount       int64     `json:"amount"`
+	Installments int       `json:"installments"`
+}
+
+type GetInstallmentCommitmentsUseCaseOutput struct {
+	Commitments []InstallmentCommitment `json:"commitments"`
+}
+
+func (uc *GetInstallmentCommitmentsUseCase) Execute(
+	ctx context.Context,
+	in GetInstallmentCommitmentsUseCaseInput,
+) (*GetInstallmentCommitmentsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	transactions, err := uc.tr.ListInstallmentTransactions(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &GetInstallmentCommitmentsUseCaseOutput{
+		Commitments: buildInstallmentCommitments(transactions, time.Now()),
+	}, nil
+}
+
+// installmentCounterRegex matches the installment counter institutions append
+// to the name of each charge, such as "Store 5/10".
+var installmentCounterRegex = regexp.MustCompile(`\s*\d+/\d+\s*$`)
+
+type installmentPurchaseKey struct {
+	accountID         uuid.UUID
+	purchaseDate      time.Time
+	name              string
+	amount            int64
+	totalInstallments int32
+}
+
+// buildInstallmentCommitments groups the installments still to be charged by
+// month, starting at the month of now. Each installment is a transaction of
+// its own, so only the latest installment of each purchase is used to project
+// the remaining ones. Two purchases on the same day are told apart by their
+// name, without the installment counter, and amount.
+func buildInstallmentCommitments(
+	transactions []entity.Transaction,
+	now time.Time,
+) []InstallmentCommitment {
+	latestByPurchase := map[installmentPurchaseKey]entity.Transaction{}
+	for _, t := range transactions {
+		if t.InstallmentNumber == nil || t.TotalInstallments == nil {
+			continue
+		}
+
+		key := installmentPurchaseKey{
+			name:              installmentCounterRegex.ReplaceAllString(t.Name, ""),
+			amount:            t.Amount,
+			totalInstallments: *t.TotalInstallments,
+		}
+		if t.AccountID != nil {
+			key.accountID = *t.AccountID
+		}
+		if t.PurchaseDate != nil {
+			key.purchaseDate = *t.PurchaseDate
+		}
+
+		latest, ok := latestByPurchase[key]
+		if !ok || *t.InstallmentNumber > *latest.InstallmentNumber {
+			latestByPurchase[key] = t
+		}
+	}
+
+	currentMonth := dateutil.ToMonthStart(now)
+
+	commitmentsByMonth := map[time.Time]*InstallmentCommitment{}
+	for _, t := range latestByPurchase {
+		remaining := int(*t.TotalInstallments - *t.InstallmentNumber)
+		monthStart := dateutil.ToMonthStart(t.Date.In(now.Location()))
+
+		for i := 1; i <= remaining; i++ {
+			month := monthStart.AddDate(0, i, 0)
+			if month.Before(currentMonth) {
+				continue
+			}
+
+			commitment, ok := commitmentsByMonth[month]
+			if !ok {
+				commitment = &InstallmentCommitment{Month: month}
+				commitmentsByMonth[month] = commitment
+			}
+			commitment.Amount += t.Amount
+			commitment.Installments++
+		}
+	}
+
+	commitments := make([]InstallmentCommitment, 0, len(commitmentsByMonth))
+	for _, commitment := range commitmentsByMonth {
+		commitments = append(commitments, *commitment)
+	}
+
+	slices.SortFunc(commitments, func(a, b InstallmentCommitment) int {
+		return a.Month.Compare(b.Month)
+	})
+
+	return commitments
+}
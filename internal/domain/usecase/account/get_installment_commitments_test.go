@@ -0,0 +1,150 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestBuildInstallmentCommitments(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	purchaseDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 5, 14, 15, 30, 0, 0, time.UTC)
+
+	installment := func(
+		name string,
+		amount int64,
+		date time.Time,
+		number, total int32,
+	) entity.Transaction {
+		return entity.Transaction{
+			Name:              name,
+			Amount:            amount,
+			Date:              date,
+			AccountID:         &accountID,
+			PurchaseDate:      &purchaseDate,
+			InstallmentNumber: ptr.New(number),
+			TotalInstallments: ptr.New(total),
+		}
+	}
+
+	month := func(m time.Month) time.Time {
+		return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		description  string
+		transactions []entity.Transaction
+		expected     []InstallmentCommitment
+	}{
+		{
+			description:  "no installments",
+			transactions: []entity.Transaction{},
+			expected:     []InstallmentCommitment{},
+		},
+		{
+			description: "projects the remaining installments",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.May), 3, 5),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.June), Amount: -1000, Installments: 1},
+				{Month: month(time.July), Amount: -1000, Installments: 1},
+			},
+		},
+		{
+			description: "uses the latest installment of a purchase",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.March), 1, 4),
+				installment("Store", -1000, month(time.May), 3, 4),
+				installment("Store", -1000, month(time.April), 2, 4),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.June), Amount: -1000, Installments: 1},
+			},
+		},
+		{
+			description: "purchase with its last installment charged",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.April), 3, 4),
+				installment("Store", -1000, month(time.May), 4, 4),
+			},
+			expected: []InstallmentCommitment{},
+		},
+		{
+			description: "uses the latest installment of names with the counter",
+			transactions: []entity.Transaction{
+				installment("Amazon Marketplace 3/5", -1000, month(time.March), 3, 5),
+				installment("Amazon Marketplace 4/5", -1000, month(time.April), 4, 5),
+				installment("Oficial*Oficialfarma 1/3", -500, month(time.April), 1, 3),
+				installment("Oficial*Oficialfarma 2/3", -500, month(time.May), 2, 3),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.May), Amount: -1000, Installments: 1},
+				{Month: month(time.June), Amount: -500, Installments: 1},
+			},
+		},
+		{
+			description: "purchases on the same day with different names",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.May), 1, 2),
+				installment("Market", -1000, month(time.May), 1, 2),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.June), Amount: -2000, Installments: 2},
+			},
+		},
+		{
+			description: "purchases on the same day with different amounts",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.May), 1, 2),
+				installment("Store", -3000, month(time.May), 1, 2),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.June), Amount: -4000, Installments: 2},
+			},
+		},
+		{
+			description: "skips the months already past",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.February), 1, 5),
+			},
+			expected: []InstallmentCommitment{
+				{Month: month(time.May), Amount: -1000, Installments: 1},
+				{Month: month(time.June), Amount: -1000, Installments: 1},
+			},
+		},
+		{
+			description: "purchase whose last month is past",
+			transactions: []entity.Transaction{
+				installment("Store", -1000, month(time.January), 1, 3),
+			},
+			expected: []InstallmentCommitment{},
+		},
+		{
+			description: "ignores transactions without installments",
+			transactions: []entity.Transaction{
+				{Name: "Store", Amount: -1000, Date: month(time.May)},
+			},
+			expected: []InstallmentCommitment{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(
+				t,
+				test.expected,
+				buildInstallmentCommitments(test.transactions, now),
+			)
+		})
+	}
+}
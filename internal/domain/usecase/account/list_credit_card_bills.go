@@ -0,0 +1,108 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListCreditCardBillsUseCase struct {
+	v   *validator.Validator
+	ar  repo.AccountRepo
+	tr  repo.TransactionRepo
+	cbr repo.CreditCardBillRepo
+}
+
+func NewListCreditCardBillsUseCase(
+	v *validator.Validator,
+	ar repo.AccountRepo,
+	tr repo.TransactionRepo,
+	cbr repo.CreditCardBillRepo,
+) *ListCreditCardBillsUseCase {
+	return &ListCreditCardBillsUseCase{
+		v:   v,
+		ar:  ar,
+		tr:  tr,
+		cbr: cbr,
+	}
+}
+
+type ListCreditCardBillsUseCaseInput struct {
+	UserID    uuid.UUID `json:"user_id"    validate:"required"`
+	AccountID uuid.UUID `json:"account_id" validate:"required"`
+}
+
+type ListCreditCardBillsUseCaseOutput struct {
+	Bills                  []entity.CreditCardBill `json:"bills"`
+	InstallmentCommitments []InstallmentCommitment `json:"installment_commitments"`
+}
+
+func (uc *ListCreditCardBillsUseCase) Execute(
+	ctx context.Context,
+	in ListCreditCardBillsUseCaseInput,
+) (*ListCreditCardBillsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	accounts, err := uc.ar.ListAccounts(ctx, repo.AccountOptions{
+		IDs:     []uuid.UUID{in.AccountID},
+		UserIDs: []uuid.UUID{in.UserID},
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	if len(accounts) == 0 {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	if accounts[0].Type != entity.AccountTypeCredit {
+		return nil, errs.ErrAccountNotCreditCard
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	var (
+		bills        []entity.CreditCardBill
+		transactions []entity.Transaction
+	)
+
+	g.Go(func() error {
+		var err error
+		bills, err = uc.cbr.ListCreditCardBillsByAccountID(gCtx, in.AccountID)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		transactions, err = uc.tr.ListInstallmentTransactions(gCtx, in.UserID)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var accountTransactions []entity.Transaction
+	for _, t := range transactions {
+		if t.AccountID != nil && *t.AccountID == in.AccountID {
+			accountTransactions = append(accountTransactions, t)
+		}
+	}
+
+	return &ListCreditCardBillsUseCaseOutput{
+		Bills: bills,
+		InstallmentCommitments: buildInstallmentCommitments(
+			accountTransactions,
+			time.Now(),
+		),
+	}, nil
+}
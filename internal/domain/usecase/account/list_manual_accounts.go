@@ -0,0 +1,51 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListManualAccountsUseCase struct {
+	ar repo.AccountRepo
+}
+
+func NewListManualAccountsUseCase(
+	ar repo.AccountRepo,
+) *ListManualAccountsUseCase {
+	return &ListManualAccountsUseCase{
+		ar: ar,
+	}
+}
+
+type ListManualAccountsUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListManualAccountsUseCaseOutput struct {
+	Items []entity.ManualAccount `json:"items"`
+}
+
+func (uc *ListManualAccountsUseCase) Execute(
+	ctx context.Context,
+	in ListManualAccountsUseCaseInput,
+) (*ListManualAccountsUseCaseOutput, error) {
+	accounts, err := uc.ar.ListManualAccounts(ctx, repo.AccountOptions{
+		UserIDs: []uuid.UUID{in.UserID},
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	if accounts == nil {
+		accounts = []entity.ManualAccount{}
+	}
+
+	return &ListManualAccountsUseCaseOutput{
+		Items: accounts,
+	}, nil
+}
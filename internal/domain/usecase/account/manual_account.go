@@ -0,0 +1,58 @@
+package account
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// defaultWalletName names the wallet when the user does not.
+const defaultWalletName = "Carteira"
+
+// getOwnManualAccount gets a manual account of the user with its balance.
+func getOwnManualAccount(
+	ctx context.Context,
+	ar repo.AccountRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.ManualAccount, error) {
+	accounts, err := ar.ListManualAccounts(ctx, repo.AccountOptions{
+		IDs:     []uuid.UUID{id},
+		UserIDs: []uuid.UUID{userID},
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if len(accounts) == 0 {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	return &accounts[0], nil
+}
+
+// validateWallet checks the user has no wallet yet, each user has at most
+// one.
+func validateWallet(
+	ctx context.Context,
+	ar repo.AccountRepo,
+	userID uuid.UUID,
+) error {
+	wallets, err := ar.ListAccounts(ctx, repo.AccountOptions{
+		UserIDs:  []uuid.UUID{userID},
+		Types:    []entity.AccountType{entity.AccountTypeWallet},
+		IsManual: ptr.New(true),
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+	if len(wallets) > 0 {
+		return errs.ErrWalletAlreadyExists
+	}
+
+	return nil
+}
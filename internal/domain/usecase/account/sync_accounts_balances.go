@@ -45,52 +45,66 @@ func NewSyncAccountsBalancesUseCase(
 	}
 }
 
-func (uc *SyncAccountsBalancesUseCase) Execute(ctx context.Context) error {
+type SyncAccountsBalancesUseCaseInput struct {
+	UserInstitutionIDs []uuid.UUID `json:"user_institution_ids"`
+}
+
+type SyncAccountsBalancesUseCaseOutput struct {
+	AccountsProcessed int `json:"accounts_processed"`
+}
+
+func (uc *SyncAccountsBalancesUseCase) Execute(
+	ctx context.Context,
+	in SyncAccountsBalancesUseCaseInput,
+) (*SyncAccountsBalancesUseCaseOutput, error) {
+	isSyncingAllAccounts := len(in.UserInstitutionIDs) == 0
 	offset := 0
 	cacheExp := time.Hour * 12
-	if _, err := uc.c.Scan(ctx, cache.KeySyncBalancesOffset, &offset); err != nil {
-		return errs.New(err)
+
+	accountOpts := repo.AccountOptions{
+		IsSubscriptionActive: ptr.New(true),
+		IsManual:             ptr.New(false),
 	}
 
-	if offset == -1 {
-		slog.Info("sync-balances: already completed")
-		return nil
+	if isSyncingAllAccounts {
+		if _, err := uc.c.Scan(ctx, cache.KeySyncBalancesOffset, &offset); err != nil {
+			return nil, errs.New(err)
+		}
+
+		if offset == -1 {
+			slog.Info("sync-balances: already completed")
+			return &SyncAccountsBalancesUseCaseOutput{}, nil
+		}
+
+		accountOpts.Limit = uint(uc.e.SyncBalancesMaxAccounts)
+		accountOpts.Offset = uint(offset)
+	} else {
+		accountOpts.UserInstitutionIDs = in.UserInstitutionIDs
 	}
 
-	accounts, err := uc.ar.ListFullAccounts(
-		ctx,
-		repo.AccountOptions{
-			Limit:                uint(uc.e.SyncBalancesMaxAccounts),
-			Offset:               uint(offset),
-			IsSubscriptionActive: ptr.New(true),
-		},
-	)
+	accounts, err := uc.ar.ListFullAccounts(ctx, accountOpts)
 	if err != nil {
-		return errs.New(err)
+		return nil, errs.New(err)
 	}
 
 	if len(accounts) == 0 {
+		if !isSyncingAllAccounts {
+			return &SyncAccountsBalancesUseCaseOutput{}, nil
+		}
+
 		if err := uc.c.Set(ctx, cache.KeySyncBalancesOffset, -1, cacheExp); err != nil {
-			return errs.New(err)
+			return nil, errs.New(err)
 		}
 
 		slog.Info("sync-balances: completed")
-		return nil
+		return &SyncAccountsBalancesUseCaseOutput{}, nil
 	}
 
 	accountsByUserID := make(map[uuid.UUID][]entity.FullAccount)
 	accountsByExternalIDs := make(map[string]entity.FullAccount)
 	for _, account := range accounts {
-		if account.UserID == nil {
-			slog.Error(
-				"sync-balances: account without user id",
-				"account", account,
-			)
-			continue
-		}
-
-		accountsByUserID[*account.UserID] = append(
-			accountsByUserID[*account.UserID],
+		accountsByUserID[account.UserID] = append(
+			accountsByUserID[account.UserID],
 			account,
 		)
 		accountsByExternalIDs[account.ExternalID] = account
@@ -109,23 +123,31 @@ func (uc *SyncAccountsBalancesUseCase) Execute(ctx context.Context) error {
 	}
 
 	if err := g.Wait(); err != nil {
-		return errs.New(err)
+		return nil, errs.New(err)
+	}
+
+	out := &SyncAccountsBalancesUseCaseOutput{
+		AccountsProcessed: len(accounts),
+	}
+
+	if !isSyncingAllAccounts {
+		return out, nil
 	}
 
 	if len(accounts) < uc.e.SyncBalancesMaxAccounts {
 		if err := uc.c.Set(ctx, cache.KeySyncBalancesOffset, -1, cacheExp); err != nil {
-			return errs.New(err)
+			return nil, errs.New(err)
 		}
 		slog.Info("sync-balances: completed")
-		return nil
+		return out, nil
 	}
 
 	offset += uc.e.SyncBalancesMaxAccounts
 	if err := uc.c.Set(ctx, cache.KeySyncBalancesOffset, offset, cacheExp); err != nil {
-		return errs.New(err)
+		return nil, errs.New(err)
 	}
 
-	return nil
+	return out, nil
 }
 
 func (uc *SyncAccountsBalancesUseCase) syncUserBalance(
@@ -0,0 +1,66 @@
+package account
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type UpdateManualAccountUseCase struct {
+	v  *validator.Validator
+	ar repo.AccountRepo
+}
+
+func NewUpdateManualAccountUseCase(
+	v *validator.Validator,
+	ar repo.AccountRepo,
+) *UpdateManualAccountUseCase {
+	return &UpdateManualAccountUseCase{
+		v:  v,
+		ar: ar,
+	}
+}
+
+type UpdateManualAccountUseCaseInput struct {
+	ID     uuid.UUID `json:"-" validate:"required"`
+	UserID uuid.UUID `json:"-" validate:"required"`
+	Name   string    `json:"name"`
+	// OpeningBalance is kept when nil, so the balance of the wallet can be
+	// corrected by changing it.
+	OpeningBalance *int64 `json:"opening_balance"`
+}
+
+func (uc *UpdateManualAccountUseCase) Execute(
+	ctx context.Context,
+	in UpdateManualAccountUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	account, err := getOwnManualAccount(ctx, uc.ar, in.ID, in.UserID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		name = account.Name
+	}
+
+	if err := uc.ar.UpdateAccount(ctx, repo.UpdateAccountParams{
+		ID:             in.ID,
+		Name:           name,
+		OpeningBalance: ptr.Coalesce(in.OpeningBalance, account.OpeningBalance),
+	}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/budget"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
@@ -44,6 +45,7 @@ type GenerateAIChatMessageUseCase struct {
 	tr    repo.TransactionRepo
 	gbuc  *budget.GetBudgetUseCase
 	gabuc *account.GetAccountsBalanceUseCase
+	lrsuc *recurring.ListRecurringSeriesUseCase
 }
 
 func NewGenerateAIChatMessageUseCase(
@@ -59,6 +61,7 @@ func NewGenerateAIChatMessageUseCase(
 	tr repo.TransactionRepo,
 	gbuc *budget.GetBudgetUseCase,
 	gabuc *account.GetAccountsBalanceUseCase,
+	lrsuc *recurring.ListRecurringSeriesUseCase,
 ) *GenerateAIChatMessageUseCase {
 	return &GenerateAIChatMessageUseCase{
 		v:     v,
@@ -73,6 +76,7 @@ func NewGenerateAIChatMessageUseCase(
 		tr:    tr,
 		gbuc:  gbuc,
 		gabuc: gabuc,
+		lrsuc: lrsuc,
 	}
 }
 
@@ -129,7 +133,10 @@ func (uc *GenerateAIChatMessageUseCase) Execute(
 	})
 
 	g.Go(func() (err error) {
-		transactionCategories, err = uc.tcr.ListTransactionCategories(subCtx)
+		transactionCategories, err = uc.tcr.ListTransactionCategories(
+			subCtx,
+			repo.TransactionCategoryOptions{UserID: in.UserID},
+		)
 		return err
 	})
 
@@ -426,6 +433,12 @@ Using your expertise in financial planning, please analyze the information provi
 			Func:        uc.getUserBudget(in.UserID),
 			Args:        buildGetBudgetArgs(),
 		},
+		{
+			Name:        "list_user_recurring_series",
+			Description: "List user's recurring payments and incomes, such as subscriptions, rent and salary, with their frequency, last amount and next expected date, flagging price increases and missed occurrences.",
+			Func:        uc.listUserRecurringSeries(in.UserID),
+			Args:        buildListRecurringSeriesArgs(),
+		},
 	}
 
 	message, err := uc.gp.Completion(
@@ -503,6 +516,8 @@ func (uc *GenerateAIChatMessageUseCase) buildTransactionOptionsFromArgs(
 		StartDate: *startDate,
 		EndDate:   *endDate,
 		IsIgnored: ptr.New(false),
+
+		ShouldExpandSplits: true,
 	}
 
 	categoryIDs := uc.parseUUIDsArg(args, ArgKeyCategoryIDs)
@@ -588,6 +603,8 @@ func (uc *GenerateAIChatMessageUseCase) buildAccountsBalanceOptionsFromArgs(
 		StartDate: *startDate,
 		EndDate:   *endDate,
 		IsIgnored: ptr.New(false),
+
+		ShouldExpandSplits: true,
 	}
 
 	institutionIDs := uc.parseUUIDsArg(args, ArgKeyInstitutionIDs)
@@ -637,6 +654,33 @@ func (uc *GenerateAIChatMessageUseCase) getUserBudget(
 	}
 }
 
+func (uc *GenerateAIChatMessageUseCase) listUserRecurringSeries(
+	userID uuid.UUID,
+) gpt.ToolFunc {
+	return func(ctx context.Context, args map[string]any) (string, error) {
+		recurringSeriesOutput, err := uc.lrsuc.Execute(
+			ctx,
+			recurring.ListRecurringSeriesUseCaseInput{
+				UserID: userID,
+			},
+		)
+		if err != nil {
+			return "", errs.New(err)
+		}
+
+		response := map[string]any{
+			"description": "The user's recurring series, where amounts are given in cents, negative amounts are expenses and positive amounts are incomes.",
+			"data":        recurringSeriesOutput.Items,
+		}
+		responseJSON, err := json.Marshal(response)
+		if err != nil {
+			return "", errs.New(err)
+		}
+
+		return string(responseJSON), nil
+	}
+}
+
 func (uc *GenerateAIChatMessageUseCase) parseRequiredDateArg(
 	args map[string]any,
 	key string,
@@ -829,3 +873,12 @@ func buildGetBudgetArgs() map[string]any {
 	}
 	return getBudgetArgs
 }
+
+func buildListRecurringSeriesArgs() map[string]any {
+	var listRecurringSeriesArgs = map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"additionalProperties": false,
+	}
+	return listRecurringSeriesArgs
+}
@@ -0,0 +1,67 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// MaxAttachmentSize is the maximum size in bytes of a single attachment.
+const MaxAttachmentSize int64 = 10 << 20
+
+// quotaByTier is the total size in bytes of attachments each tier can store.
+var quotaByTier = map[entity.Tier]int64{
+	entity.TierFree:    50 << 20,
+	entity.TierTrial:   1 << 30,
+	entity.TierPremium: 1 << 30,
+}
+
+var allowedContentTypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+// getOwnTransaction gets a transaction of the user.
+func getOwnTransaction(
+	ctx context.Context,
+	tr repo.TransactionRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.FullTransaction, error) {
+	transaction, err := tr.GetTransactionByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if transaction == nil || transaction.UserID != userID {
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	return transaction, nil
+}
+
+// getOwnAttachment gets an attachment of the transaction of the user.
+func getOwnAttachment(
+	ctx context.Context,
+	ar repo.AttachmentRepo,
+	id uuid.UUID,
+	transactionID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Attachment, error) {
+	attachment, err := ar.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if attachment == nil ||
+		attachment.UserID != userID ||
+		attachment.TransactionID != transactionID {
+		return nil, errs.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,58 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+	"github.com/google/uuid"
+)
+
+type DeleteAttachmentUseCase struct {
+	s  storage.Storage
+	ar repo.AttachmentRepo
+}
+
+func NewDeleteAttachmentUseCase(
+	s storage.Storage,
+	ar repo.AttachmentRepo,
+) *DeleteAttachmentUseCase {
+	return &DeleteAttachmentUseCase{
+		s:  s,
+		ar: ar,
+	}
+}
+
+type DeleteAttachmentUseCaseInput struct {
+	ID            uuid.UUID `json:"attachment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
+// Execute deletes the attachment file from the blob storage and its record.
+func (uc *DeleteAttachmentUseCase) Execute(
+	ctx context.Context,
+	in DeleteAttachmentUseCaseInput,
+) error {
+	attachment, err := getOwnAttachment(
+		ctx,
+		uc.ar,
+		in.ID,
+		in.TransactionID,
+		in.UserID,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.s.Delete(ctx, attachment.StorageKey); err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.ar.DeleteAttachment(ctx, attachment.ID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package attachment
+
+import (
+	"context"
+	"io"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+	"github.com/google/uuid"
+)
+
+type DownloadAttachmentUseCase struct {
+	s  storage.Storage
+	ar repo.AttachmentRepo
+}
+
+func NewDownloadAttachmentUseCase(
+	s storage.Storage,
+	ar repo.AttachmentRepo,
+) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{
+		s:  s,
+		ar: ar,
+	}
+}
+
+type DownloadAttachmentUseCaseInput struct {
+	ID            uuid.UUID `json:"attachment_id"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
+type DownloadAttachmentUseCaseOutput struct {
+	entity.Attachment
+	Body io.ReadCloser
+}
+
+// Execute returns the attachment with a reader for its file,
+// which must be closed by the caller.
+func (uc *DownloadAttachmentUseCase) Execute(
+	ctx context.Context,
+	in DownloadAttachmentUseCaseInput,
+) (*DownloadAttachmentUseCaseOutput, error) {
+	attachment, err := getOwnAttachment(
+		ctx,
+		uc.ar,
+		in.ID,
+		in.TransactionID,
+		in.UserID,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	body, err := uc.s.Download(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &DownloadAttachmentUseCaseOutput{
+		Attachment: *attachment,
+		Body:       body,
+	}, nil
+}
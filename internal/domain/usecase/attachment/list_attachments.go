@@ -0,0 +1,49 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type ListAttachmentsUseCase struct {
+	ar repo.AttachmentRepo
+	tr repo.TransactionRepo
+}
+
+func NewListAttachmentsUseCase(
+	ar repo.AttachmentRepo,
+	tr repo.TransactionRepo,
+) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		ar: ar,
+		tr: tr,
+	}
+}
+
+type ListAttachmentsUseCaseInput struct {
+	TransactionID uuid.UUID `json:"transaction_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
+func (uc *ListAttachmentsUseCase) Execute(
+	ctx context.Context,
+	in ListAttachmentsUseCaseInput,
+) ([]entity.Attachment, error) {
+	if _, err := getOwnTransaction(ctx, uc.tr, in.TransactionID, in.UserID); err != nil {
+		return nil, errs.New(err)
+	}
+
+	attachments, err := uc.ar.ListAttachmentsByTransactionID(
+		ctx,
+		in.TransactionID,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return attachments, nil
+}
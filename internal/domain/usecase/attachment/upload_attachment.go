@@ -0,0 +1,190 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+)
+
+const (
+	// quotaLockTTL bounds how long an upload holds the quota lock of the
+	// user, in case the process dies before releasing it.
+	quotaLockTTL = time.Minute
+	// quotaLockWait is how long an upload waits for the other uploads of the
+	// user to finish.
+	quotaLockWait     = 10 * time.Second
+	quotaLockInterval = 100 * time.Millisecond
+)
+
+type UploadAttachmentUseCase struct {
+	v  *validator.Validator
+	s  storage.Storage
+	c  cache.Cache
+	ar repo.AttachmentRepo
+	tr repo.TransactionRepo
+}
+
+func NewUploadAttachmentUseCase(
+	v *validator.Validator,
+	s storage.Storage,
+	c cache.Cache,
+	ar repo.AttachmentRepo,
+	tr repo.TransactionRepo,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		v:  v,
+		s:  s,
+		c:  c,
+		ar: ar,
+		tr: tr,
+	}
+}
+
+type UploadAttachmentUseCaseInput struct {
+	TransactionID uuid.UUID   `json:"-" validate:"required"`
+	UserID        uuid.UUID   `json:"-" validate:"required"`
+	Tier          entity.Tier `json:"-" validate:"required"`
+	Name          string      `json:"-" validate:"required"`
+	Data          []byte      `json:"-"`
+}
+
+// Execute stores the file in the blob storage and attaches it to the
+// transaction, the content type is detected from the file contents. The
+// uploads of a user are serialised, so concurrent ones can not exceed the
+// quota together.
+func (uc *UploadAttachmentUseCase) Execute(
+	ctx context.Context,
+	in UploadAttachmentUseCaseInput,
+) (*entity.Attachment, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	size := int64(len(in.Data))
+	if size == 0 {
+		return nil, errs.ErrAttachmentEmpty
+	}
+	if size > MaxAttachmentSize {
+		return nil, errs.ErrAttachmentTooLarge
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(in.Data))
+	if err != nil || !slices.Contains(allowedContentTypes, contentType) {
+		return nil, errs.ErrInvalidAttachmentType
+	}
+
+	if _, err := getOwnTransaction(ctx, uc.tr, in.TransactionID, in.UserID); err != nil {
+		return nil, errs.New(err)
+	}
+
+	release, err := uc.lockQuota(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	defer release()
+
+	usedSize, err := uc.ar.SumAttachmentsSizeByUserID(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if usedSize+size > quotaByTier[in.Tier] {
+		return nil, errs.ErrAttachmentQuotaExceeded
+	}
+
+	id := uuid.New()
+	key := storage.AttachmentKey(in.UserID, in.TransactionID, id)
+
+	if err := uc.s.Upload(ctx, key, in.Data, contentType); err != nil {
+		return nil, errs.New(err)
+	}
+
+	attachment, err := uc.ar.CreateAttachment(ctx, repo.CreateAttachmentParams{
+		ID:            id,
+		Name:          sanitizeName(in.Name),
+		ContentType:   contentType,
+		Size:          size,
+		StorageKey:    key,
+		TransactionID: in.TransactionID,
+		UserID:        in.UserID,
+	})
+	if err != nil {
+		if err := uc.s.Delete(ctx, key); err != nil {
+			slog.Error(
+				"upload-attachment: failed to delete orphan file",
+				"key", key,
+				"error", err,
+			)
+		}
+		return nil, errs.New(err)
+	}
+
+	return attachment, nil
+}
+
+// lockQuota waits for the quota lock of the user and returns the function
+// that releases it.
+func (uc *UploadAttachmentUseCase) lockQuota(
+	ctx context.Context,
+	userID uuid.UUID,
+) (release func(), err error) {
+	lockKey := fmt.Sprintf("%s:%s", cache.KeyAttachmentQuotaLock, userID)
+	lockToken := uuid.NewString()
+
+	ctx, cancel := context.WithTimeout(ctx, quotaLockWait)
+	defer cancel()
+
+	ticker := time.NewTicker(quotaLockInterval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := uc.c.SetNX(ctx, lockKey, lockToken, quotaLockTTL)
+		if err != nil {
+			return nil, errs.New(err)
+		}
+		if ok {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errs.ErrAttachmentUploadInProgress
+		case <-ticker.C:
+		}
+	}
+
+	return func() {
+		if _, err := uc.c.DeleteIfEqual(
+			context.Background(),
+			lockKey,
+			lockToken,
+		); err != nil {
+			slog.Error(
+				"upload-attachment: error releasing quota lock",
+				"user_id", userID,
+				"error", err,
+			)
+		}
+	}, nil
+}
+
+// sanitizeName keeps only the base name of the uploaded file,
+// as some clients send the full path.
+func sanitizeName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,170 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache/memcache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+)
+
+// slowStorage takes a while to upload, so concurrent uploads overlap.
+type slowStorage struct{}
+
+func (slowStorage) Upload(context.Context, storage.Key, []byte, string) error {
+	time.Sleep(50 * time.Millisecond)
+	return nil
+}
+
+func (slowStorage) Download(context.Context, storage.Key) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (slowStorage) Delete(context.Context, ...storage.Key) error {
+	return nil
+}
+
+type memoryAttachmentRepo struct {
+	repo.AttachmentRepo
+	mu    sync.Mutex
+	sizes map[uuid.UUID]int64
+}
+
+func (r *memoryAttachmentRepo) SumAttachmentsSizeByUserID(
+	_ context.Context,
+	userID uuid.UUID,
+) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.sizes[userID], nil
+}
+
+func (r *memoryAttachmentRepo) CreateAttachment(
+	_ context.Context,
+	params repo.CreateAttachmentParams,
+) (*entity.Attachment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sizes[params.UserID] += params.Size
+	return &entity.Attachment{ID: params.ID, Size: params.Size}, nil
+}
+
+type ownTransactionRepo struct {
+	repo.TransactionRepo
+	userID uuid.UUID
+}
+
+func (r ownTransactionRepo) GetTransactionByID(
+	_ context.Context,
+	id uuid.UUID,
+) (*entity.FullTransaction, error) {
+	return &entity.FullTransaction{
+		Transaction: entity.Transaction{ID: id, UserID: r.userID},
+	}, nil
+}
+
+func TestUploadAttachmentQuota(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	quota := quotaByTier[entity.TierFree]
+	// Each upload fits the quota alone, but not together
+	data := append([]byte("%PDF-1.4\n"), bytes.Repeat([]byte("a"), 8<<20)...)
+
+	tests := []struct {
+		description string
+		usedSize    int64
+		uploads     int
+		// expectedUploaded is how many of the concurrent uploads succeed.
+		expectedUploaded int
+	}{
+		{
+			description:      "uploads within the quota",
+			uploads:          1,
+			expectedUploaded: 1,
+		},
+		{
+			description:      "rejects uploads over the quota",
+			usedSize:         quota,
+			uploads:          1,
+			expectedUploaded: 0,
+		},
+		{
+			description:      "concurrent uploads do not exceed the quota together",
+			usedSize:         quota - int64(len(data)) - 1,
+			uploads:          5,
+			expectedUploaded: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			ar := &memoryAttachmentRepo{
+				sizes: map[uuid.UUID]int64{userID: test.usedSize},
+			}
+			uc := NewUploadAttachmentUseCase(
+				validator.New(),
+				slowStorage{},
+				memcache.NewMemCache(),
+				ar,
+				ownTransactionRepo{userID: userID},
+			)
+
+			var (
+				wg       sync.WaitGroup
+				mu       sync.Mutex
+				uploaded int
+			)
+			for range test.uploads {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					_, err := uc.Execute(
+						context.Background(),
+						UploadAttachmentUseCaseInput{
+							TransactionID: uuid.New(),
+							UserID:        userID,
+							Tier:          entity.TierFree,
+							Name:          "receipt.pdf",
+							Data:          data,
+						},
+					)
+					if err != nil {
+						assert.ErrorIs(t, err, errs.ErrAttachmentQuotaExceeded)
+						return
+					}
+
+					mu.Lock()
+					uploaded++
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, test.expectedUploaded, uploaded)
+
+			usedSize, _ := ar.SumAttachmentsSizeByUserID(
+				context.Background(),
+				userID,
+			)
+			assert.LessOrEqual(t, usedSize, max(quota, test.usedSize))
+		})
+	}
+}
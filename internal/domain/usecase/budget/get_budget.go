@@ -6,6 +6,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/money"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
@@ -16,20 +17,23 @@ import (
 )
 
 type GetBudgetUseCase struct {
-	v  *validator.Validator
-	br repo.BudgetRepo
-	tr repo.TransactionRepo
+	v   *validator.Validator
+	br  repo.BudgetRepo
+	tr  repo.TransactionRepo
+	rsr repo.RecurringSeriesRepo
 }
 
 func NewGetBudgetUseCase(
 	v *validator.Validator,
 	br repo.BudgetRepo,
 	tr repo.TransactionRepo,
+	rsr repo.RecurringSeriesRepo,
 ) *GetBudgetUseCase {
 	return &GetBudgetUseCase{
-		v:  v,
-		br: br,
-		tr: tr,
+		v:   v,
+		br:  br,
+		tr:  tr,
+		rsr: rsr,
 	}
 }
 
@@ -52,6 +56,7 @@ type GetBudgetUseCaseOutput struct {
 	AvailablePercentageVariation       int64                              `json:"available_percentage_variation"`
 	AvailablePerDay                    int64                              `json:"available_per_day,omitempty"`
 	AvailablePerDayPercentageVariation int64                              `json:"available_per_day_percentage_variation,omitempty"`
+	UpcomingRecurringExpenses          int64                              `json:"upcoming_recurring_expenses"`
 	ComparisonDates                    dateutil.ComparisonDates           `json:"comparison_dates"`
 	BudgetCategories                   []GetBudgetUseCaseBudgetCategories `json:"budget_categories"`
 }
@@ -86,6 +91,7 @@ func (uc *GetBudgetUseCase) Execute(
 		categories         []entity.TransactionCategory
 		spentPreviousMonth int64
 		spentByCategoryID  map[uuid.UUID]int64
+		recurringSeries    []entity.RecurringSeries
 	)
 
 	g.Go(func() error {
@@ -97,8 +103,9 @@ func (uc *GetBudgetUseCase) Execute(
 	})
 
 	baseTransactionOpts := repo.TransactionOptions{
-		IsIgnored: ptr.New(false),
-		IsExpense: true,
+		IsIgnored:  ptr.New(false),
+		IsTransfer: ptr.New(false),
+		IsExpense:  true,
 	}
 
 	g.Go(func() error {
@@ -127,6 +134,14 @@ func (uc *GetBudgetUseCase) Execute(
 		return err
 	})
 
+	g.Go(func() error {
+		recurringSeries, err = uc.rsr.ListRecurringSeriesByUserID(
+			gCtx,
+			in.UserID,
+		)
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return nil, errs.New(err)
 	}
@@ -171,6 +186,12 @@ func (uc *GetBudgetUseCase) Execute(
 		)
 	}
 
+	upcomingRecurringExpenses := uc.calculateUpcomingRecurringExpenses(
+		recurringSeries,
+		cmpDates.StartDate,
+		now,
+	)
+
 	out := GetBudgetUseCaseOutput{
 		Budget:                             *budget,
 		Spent:                              spent,
@@ -178,6 +199,7 @@ func (uc *GetBudgetUseCase) Execute(
 		AvailablePercentageVariation:       availablePercentageVariation,
 		AvailablePerDay:                    availablePerDay,
 		AvailablePerDayPercentageVariation: availablePerDayPercentageVariation,
+		UpcomingRecurringExpenses:          upcomingRecurringExpenses,
 		ComparisonDates:                    *cmpDates,
 		BudgetCategories:                   []GetBudgetUseCaseBudgetCategories{},
 	}
@@ -217,3 +239,28 @@ func (uc *GetBudgetUseCase) calculateAvailablePerDay(
 
 	return money.ToCents(availablePerDay)
 }
+
+// calculateUpcomingRecurringExpenses sums the recurring expenses still
+// expected until the end of the budget month.
+func (uc *GetBudgetUseCase) calculateUpcomingRecurringExpenses(
+	series []entity.RecurringSeries,
+	monthStart time.Time,
+	now time.Time,
+) int64 {
+	start := monthStart
+	if today := dateutil.ToDayStart(now); today.After(start) {
+		start = today
+	}
+	end := dateutil.ToMonthEnd(monthStart)
+
+	var upcoming int64
+	for _, s := range series {
+		if s.Amount >= 0 {
+			continue
+		}
+		dates := recurring.ExpectedDates(s, start, end)
+		upcoming += -1 * s.Amount * int64(len(dates))
+	}
+
+	return upcoming
+}
@@ -82,6 +82,7 @@ func (uc *GetBudgetCategoryUseCase) Execute(
 		EndDate:     monthEnd,
 		CategoryIDs: []uuid.UUID{in.CategoryID},
 		IsIgnored:   ptr.New(false),
+		IsTransfer:  ptr.New(false),
 		IsExpense:   true,
 	}
 
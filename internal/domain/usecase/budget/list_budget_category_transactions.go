@@ -45,6 +45,7 @@ func (uc *ListBudgetCategoryTransactionsUseCase) Execute(
 	}
 
 	isIgnored := false
+	isTransfer := false
 	categoryIDs := []uuid.UUID{in.CategoryID}
 
 	transactions, err := uc.lt.Execute(
@@ -58,6 +59,9 @@ func (uc *ListBudgetCategoryTransactionsUseCase) Execute(
 				CategoryIDs: categoryIDs,
 				IsExpense:   true,
 				IsIgnored:   &isIgnored,
+				IsTransfer:  &isTransfer,
+
+				ShouldExpandSplits: true,
 			},
 		},
 	)
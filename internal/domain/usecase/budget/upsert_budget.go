@@ -69,8 +69,8 @@ func (u *UpsertBudgetUseCase) Execute(
 
 	g, gCtx := errgroup.WithContext(ctx)
 	var (
-		budget          *entity.Budget
-		categoriesCount int64
+		budget     *entity.Budget
+		categories []entity.TransactionCategory
 	)
 
 	g.Go(func() error {
@@ -85,10 +85,11 @@ func (u *UpsertBudgetUseCase) Execute(
 	})
 
 	g.Go(func() error {
-		categoriesCount, err = u.cr.CountTransactionCategories(
+		categories, err = u.cr.ListTransactionCategories(
 			gCtx,
 			repo.TransactionCategoryOptions{
-				IDs: categoryIDs,
+				IDs:    categoryIDs,
+				UserID: in.UserID,
 			},
 		)
 		if err != nil {
@@ -101,10 +102,16 @@ func (u *UpsertBudgetUseCase) Execute(
 		return errs.New(err)
 	}
 
-	if categoriesCount != int64(len(in.Categories)) {
+	if len(categories) != len(in.Categories) {
 		return errs.ErrCategoriesNotFound
 	}
 
+	for _, c := range categories {
+		if c.ParentID != nil {
+			return errs.ErrBudgetSubCategory
+		}
+	}
+
 	err = u.tx.Do(ctx, func(ctx context.Context) error {
 		if budget == nil || !budget.Date.Equal(monthStart) {
 			budget, err = u.br.CreateBudget(ctx, repo.CreateBudgetParams{
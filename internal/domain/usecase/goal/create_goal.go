@@ -0,0 +1,57 @@
+package goal
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type CreateGoalUseCase struct {
+	v   *validator.Validator
+	gr  repo.GoalRepo
+	ar  repo.AccountRepo
+	cer *calc.CalculateEmergencyReserveUseCase
+}
+
+func NewCreateGoalUseCase(
+	v *validator.Validator,
+	gr repo.GoalRepo,
+	ar repo.AccountRepo,
+	cer *calc.CalculateEmergencyReserveUseCase,
+) *CreateGoalUseCase {
+	return &CreateGoalUseCase{
+		v:   v,
+		gr:  gr,
+		ar:  ar,
+		cer: cer,
+	}
+}
+
+type CreateGoalUseCaseInput struct {
+	GoalInput
+}
+
+func (uc *CreateGoalUseCase) Execute(
+	ctx context.Context,
+	in CreateGoalUseCaseInput,
+) (*entity.Goal, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	params, err := prepareGoalParams(ctx, uc.ar, uc.cer, in.GoalInput)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	goal, err := uc.gr.CreateGoal(ctx, params)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return goal, nil
+}
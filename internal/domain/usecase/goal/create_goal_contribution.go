@@ -0,0 +1,76 @@
+package goal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type CreateGoalContributionUseCase struct {
+	v   *validator.Validator
+	gr  repo.GoalRepo
+	gcr repo.GoalContributionRepo
+}
+
+func NewCreateGoalContributionUseCase(
+	v *validator.Validator,
+	gr repo.GoalRepo,
+	gcr repo.GoalContributionRepo,
+) *CreateGoalContributionUseCase {
+	return &CreateGoalContributionUseCase{
+		v:   v,
+		gr:  gr,
+		gcr: gcr,
+	}
+}
+
+type CreateGoalContributionUseCaseInput struct {
+	UserID uuid.UUID `json:"-" validate:"required"`
+	GoalID uuid.UUID `json:"-" validate:"required"`
+	// Amount is negative for withdrawals.
+	Amount int64 `json:"amount" validate:"required"`
+	// Date defaults to now.
+	Date *time.Time `json:"date"`
+}
+
+func (uc *CreateGoalContributionUseCase) Execute(
+	ctx context.Context,
+	in CreateGoalContributionUseCaseInput,
+) (*entity.GoalContribution, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	goal, err := getOwnGoal(ctx, uc.gr, in.GoalID, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if goal.AccountID != nil {
+		return nil, errs.ErrGoalHasLinkedAccount
+	}
+
+	date := time.Now()
+	if in.Date != nil {
+		date = *in.Date
+	}
+
+	contribution, err := uc.gcr.CreateGoalContribution(
+		ctx,
+		repo.CreateGoalContributionParams{
+			Amount: in.Amount,
+			Date:   date,
+			GoalID: goal.ID,
+		},
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return contribution, nil
+}
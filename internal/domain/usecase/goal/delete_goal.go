@@ -0,0 +1,51 @@
+package goal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type DeleteGoalUseCase struct {
+	tx tx.TX
+	gr repo.GoalRepo
+}
+
+func NewDeleteGoalUseCase(
+	tx tx.TX,
+	gr repo.GoalRepo,
+) *DeleteGoalUseCase {
+	return &DeleteGoalUseCase{
+		tx: tx,
+		gr: gr,
+	}
+}
+
+type DeleteGoalUseCaseInput struct {
+	ID     uuid.UUID `json:"goal_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute deletes a goal along with its contributions. The linked account
+// is kept.
+func (uc *DeleteGoalUseCase) Execute(
+	ctx context.Context,
+	in DeleteGoalUseCaseInput,
+) error {
+	if _, err := getOwnGoal(ctx, uc.gr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		return uc.gr.DeleteGoal(ctx, in.ID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package goal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type DeleteGoalContributionUseCase struct {
+	gr  repo.GoalRepo
+	gcr repo.GoalContributionRepo
+}
+
+func NewDeleteGoalContributionUseCase(
+	gr repo.GoalRepo,
+	gcr repo.GoalContributionRepo,
+) *DeleteGoalContributionUseCase {
+	return &DeleteGoalContributionUseCase{
+		gr:  gr,
+		gcr: gcr,
+	}
+}
+
+type DeleteGoalContributionUseCaseInput struct {
+	ID     uuid.UUID `json:"contribution_id"`
+	GoalID uuid.UUID `json:"goal_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (uc *DeleteGoalContributionUseCase) Execute(
+	ctx context.Context,
+	in DeleteGoalContributionUseCaseInput,
+) error {
+	if _, err := getOwnGoal(ctx, uc.gr, in.GoalID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	contribution, err := uc.gcr.GetGoalContributionByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if contribution == nil || contribution.GoalID != in.GoalID {
+		return errs.ErrGoalContributionNotFound
+	}
+
+	if err := uc.gcr.DeleteGoalContribution(ctx, in.ID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
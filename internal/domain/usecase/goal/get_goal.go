@@ -0,0 +1,51 @@
+package goal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type GetGoalUseCase struct {
+	gr repo.GoalRepo
+	pt *progressTracker
+}
+
+func NewGetGoalUseCase(
+	gr repo.GoalRepo,
+	abr repo.AccountBalanceRepo,
+	gcr repo.GoalContributionRepo,
+	cci *calc.CalculateCompoundInterestUseCase,
+) *GetGoalUseCase {
+	return &GetGoalUseCase{
+		gr: gr,
+		pt: newProgressTracker(abr, gcr, cci),
+	}
+}
+
+type GetGoalUseCaseInput struct {
+	ID     uuid.UUID `json:"goal_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (uc *GetGoalUseCase) Execute(
+	ctx context.Context,
+	in GetGoalUseCaseInput,
+) (*GoalProgress, error) {
+	goal, err := getOwnGoal(ctx, uc.gr, in.ID, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	progress, err := uc.pt.track(ctx, *goal, time.Now())
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return progress, nil
+}
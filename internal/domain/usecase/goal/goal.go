@@ -0,0 +1,139 @@
+package goal
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// GoalInput is the goal definition shared by the create and update use
+// cases.
+type GoalInput struct {
+	UserID uuid.UUID `json:"-"    validate:"required"`
+	Name   string    `json:"name" validate:"required"`
+	// TargetAmount may be left empty when EmergencyReserve is given.
+	TargetAmount int64     `json:"target_amount" validate:"min=0"`
+	Deadline     time.Time `json:"deadline"      validate:"required"`
+	// Interest is the annual interest earned by the savings, 800 is 8.00%.
+	Interest int64 `json:"interest" validate:"min=0,max=10000"`
+	// MonthlyContribution is how much the user plans to save each month,
+	// used to project the completion date.
+	MonthlyContribution int64 `json:"monthly_contribution" validate:"min=0"`
+	// AccountID links the goal to an account, whose balance is the goal
+	// progress. Without it the progress is the sum of the contributions.
+	AccountID        *uuid.UUID             `json:"account_id"`
+	EmergencyReserve *EmergencyReserveInput `json:"emergency_reserve" validate:"omitempty"`
+}
+
+// EmergencyReserveInput sets the target of the goal to the recommended
+// emergency reserve.
+type EmergencyReserveInput struct {
+	JobType         entity.JobType `json:"job_type"         validate:"required,oneof=ENTREPRENEUR EMPLOYEE CIVIL_SERVANT"`
+	MonthlyExpenses int64          `json:"monthly_expenses" validate:"required,min=1"`
+}
+
+// goalAccountTypes are the accounts a goal may be linked to, the ones the
+// user saves money in.
+var goalAccountTypes = []entity.AccountType{
+	entity.AccountTypeBank,
+	entity.AccountTypeWallet,
+}
+
+// prepareGoalParams validates the goal definition and resolves its target.
+func prepareGoalParams(
+	ctx context.Context,
+	ar repo.AccountRepo,
+	cer *calc.CalculateEmergencyReserveUseCase,
+	in GoalInput,
+) (repo.CreateGoalParams, error) {
+	params := repo.CreateGoalParams{
+		Name:                strings.TrimSpace(in.Name),
+		TargetAmount:        in.TargetAmount,
+		Deadline:            in.Deadline,
+		Interest:            in.Interest,
+		MonthlyContribution: in.MonthlyContribution,
+		AccountID:           in.AccountID,
+		UserID:              in.UserID,
+	}
+
+	if in.EmergencyReserve != nil {
+		// Only the recommended value is used, the completion is projected
+		// with the goal interest
+		out, err := cer.Execute(ctx, calc.CalculateEmergencyReserveUseCaseInput{
+			JobType:                  in.EmergencyReserve.JobType,
+			MonthlyExpenses:          in.EmergencyReserve.MonthlyExpenses,
+			MonthlyIncome:            in.MonthlyContribution,
+			MonthlySavingsPercentage: 100_00,
+		})
+		if err != nil {
+			return params, errs.New(err)
+		}
+		params.TargetAmount = out.RecommendedReserveInValue
+	}
+
+	if params.TargetAmount <= 0 {
+		return params, errs.ErrGoalTargetRequired
+	}
+
+	if in.AccountID != nil {
+		if err := validateGoalAccount(ctx, ar, in.UserID, *in.AccountID); err != nil {
+			return params, errs.New(err)
+		}
+	}
+
+	return params, nil
+}
+
+// validateGoalAccount checks the account belongs to the user and holds
+// savings.
+func validateGoalAccount(
+	ctx context.Context,
+	ar repo.AccountRepo,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+) error {
+	accounts, err := ar.ListAccounts(ctx, repo.AccountOptions{
+		IDs:     []uuid.UUID{accountID},
+		UserIDs: []uuid.UUID{userID},
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+	if len(accounts) == 0 {
+		return errs.ErrAccountNotFound
+	}
+	if !slices.Contains(
+		goalAccountTypes,
+		entity.AccountType(accounts[0].Type),
+	) {
+		return errs.ErrGoalAccountNotSupported
+	}
+
+	return nil
+}
+
+// getOwnGoal gets a goal of the user.
+func getOwnGoal(
+	ctx context.Context,
+	gr repo.GoalRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Goal, error) {
+	goal, err := gr.GetGoalByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if goal == nil || goal.UserID != userID {
+		return nil, errs.ErrGoalNotFound
+	}
+
+	return goal, nil
+}
@@ -0,0 +1,262 @@
+package goal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// maxProjectionMonths limits how far the completion date is projected.
+const maxProjectionMonths = 50 * 12
+
+type GoalProgress struct {
+	entity.Goal
+	CurrentAmount   int64 `json:"current_amount"`
+	RemainingAmount int64 `json:"remaining_amount"`
+	// ProgressPercentage is the current amount over the target, 5000 is
+	// 50.00%.
+	ProgressPercentage int64 `json:"progress_percentage"`
+	IsCompleted        bool  `json:"is_completed"`
+	RemainingMonths    int   `json:"remaining_months"`
+	// SuggestedMonthlyContribution is how much must be saved each month
+	// until the deadline to reach the target.
+	SuggestedMonthlyContribution int64 `json:"suggested_monthly_contribution"`
+	// ProjectedCompletionDate is when the target is reached saving the
+	// planned monthly contribution, or the average contribution so far. It
+	// is empty when nothing is being saved.
+	ProjectedCompletionDate *time.Time `json:"projected_completion_date"`
+}
+
+// progressTracker computes the progress of the goals and projects their
+// completion.
+type progressTracker struct {
+	abr repo.AccountBalanceRepo
+	gcr repo.GoalContributionRepo
+	cci *calc.CalculateCompoundInterestUseCase
+}
+
+func newProgressTracker(
+	abr repo.AccountBalanceRepo,
+	gcr repo.GoalContributionRepo,
+	cci *calc.CalculateCompoundInterestUseCase,
+) *progressTracker {
+	return &progressTracker{
+		abr: abr,
+		gcr: gcr,
+		cci: cci,
+	}
+}
+
+func (t *progressTracker) track(
+	ctx context.Context,
+	goal entity.Goal,
+	now time.Time,
+) (*GoalProgress, error) {
+	currentAmount, err := t.currentAmount(ctx, goal, now)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	progress := &GoalProgress{
+		Goal:            goal,
+		CurrentAmount:   currentAmount,
+		RemainingAmount: max(goal.TargetAmount-currentAmount, 0),
+		RemainingMonths: monthsBetween(now, goal.Deadline),
+	}
+
+	if goal.TargetAmount > 0 {
+		progress.ProgressPercentage = currentAmount * 100_00 / goal.TargetAmount
+	}
+
+	if progress.RemainingAmount == 0 {
+		progress.IsCompleted = true
+		return progress, nil
+	}
+
+	// A negative balance of the linked account is not projected to shrink
+	savings := max(currentAmount, 0)
+
+	progress.SuggestedMonthlyContribution, err = t.suggestMonthlyContribution(
+		ctx,
+		savings,
+		goal.TargetAmount,
+		goal.Interest,
+		max(progress.RemainingMonths, 1),
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	monthlyContribution := goal.MonthlyContribution
+	if monthlyContribution == 0 && goal.AccountID == nil {
+		monthsSinceCreation := monthsBetween(goal.CreatedAt, now) + 1
+		monthlyContribution = savings / int64(monthsSinceCreation)
+	}
+
+	months, err := t.projectMonths(
+		ctx,
+		savings,
+		goal.TargetAmount,
+		goal.Interest,
+		monthlyContribution,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if months > 0 {
+		date := now.AddDate(0, months, 0)
+		progress.ProjectedCompletionDate = &date
+	}
+
+	return progress, nil
+}
+
+// currentAmount is the balance of the linked account or the sum of the
+// contributions.
+func (t *progressTracker) currentAmount(
+	ctx context.Context,
+	goal entity.Goal,
+	now time.Time,
+) (int64, error) {
+	if goal.AccountID == nil {
+		return t.gcr.SumGoalContributionsByGoalID(ctx, goal.ID)
+	}
+
+	return t.abr.GetUserBalanceOnDate(
+		ctx,
+		goal.UserID,
+		now,
+		repo.AccountBalanceOptions{AccountIDs: []uuid.UUID{*goal.AccountID}},
+	)
+}
+
+// suggestMonthlyContribution finds the lowest monthly contribution that
+// reaches the target in the given months, with the savings earning the
+// goal interest.
+func (t *progressTracker) suggestMonthlyContribution(
+	ctx context.Context,
+	savings int64,
+	target int64,
+	interest int64,
+	months int,
+) (int64, error) {
+	remaining := target - savings
+	if remaining <= 0 {
+		return 0, nil
+	}
+
+	// Without interest the contributions only add up
+	high := ceilDiv(remaining, int64(months))
+	if interest == 0 {
+		return high, nil
+	}
+
+	reaches := func(monthlyContribution int64) (bool, error) {
+		out, err := t.cci.Execute(ctx, calc.CalculateCompoundInterestUseCaseInput{
+			InitialDeposit: savings,
+			MonthlyDeposit: monthlyContribution,
+			Interest:       interest,
+			InterestType:   entity.InterestTypeAnnual,
+			PeriodInMonths: months,
+		})
+		if err != nil {
+			return false, errs.New(err)
+		}
+		return out.TotalAmount >= target, nil
+	}
+
+	if savings > 0 {
+		ok, err := reaches(0)
+		if err != nil {
+			return 0, errs.New(err)
+		}
+		if ok {
+			return 0, nil
+		}
+	}
+
+	// The interest only helps, so the contribution without it is enough
+	low := int64(0)
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		ok, err := reaches(mid)
+		if err != nil {
+			return 0, errs.New(err)
+		}
+		if ok {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return high, nil
+}
+
+// projectMonths returns in how many months the target is reached saving
+// the monthly contribution, or 0 if it is not within maxProjectionMonths.
+func (t *progressTracker) projectMonths(
+	ctx context.Context,
+	savings int64,
+	target int64,
+	interest int64,
+	monthlyContribution int64,
+) (int, error) {
+	remaining := target - savings
+	if remaining <= 0 {
+		return 0, nil
+	}
+
+	if interest == 0 || savings == 0 && monthlyContribution == 0 {
+		if monthlyContribution == 0 {
+			return 0, nil
+		}
+		months := ceilDiv(remaining, monthlyContribution)
+		if months > maxProjectionMonths {
+			return 0, nil
+		}
+		return int(months), nil
+	}
+
+	out, err := t.cci.Execute(ctx, calc.CalculateCompoundInterestUseCaseInput{
+		InitialDeposit: savings,
+		MonthlyDeposit: monthlyContribution,
+		Interest:       interest,
+		InterestType:   entity.InterestTypeAnnual,
+		PeriodInMonths: maxProjectionMonths,
+	})
+	if err != nil {
+		return 0, errs.New(err)
+	}
+
+	for month := 1; month <= maxProjectionMonths; month++ {
+		if out.ByMonth[month].TotalAmount >= target {
+			return month, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// monthsBetween counts the whole months from start to end, 0 if end is
+// before start.
+func monthsBetween(start, end time.Time) int {
+	end = end.In(start.Location())
+
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	if end.Day() < start.Day() {
+		months--
+	}
+
+	return max(months, 0)
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,206 @@
+package goal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+)
+
+func TestMonthsBetween(t *testing.T) {
+	t.Parallel()
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		description string
+		start       time.Time
+		end         time.Time
+		expected    int
+	}{
+		{
+			description: "same day",
+			start:       date(2025, 1, 15),
+			end:         date(2025, 1, 15),
+			expected:    0,
+		},
+		{
+			description: "whole months",
+			start:       date(2025, 1, 15),
+			end:         date(2025, 4, 15),
+			expected:    3,
+		},
+		{
+			description: "partial month is not counted",
+			start:       date(2025, 1, 15),
+			end:         date(2025, 4, 14),
+			expected:    2,
+		},
+		{
+			description: "across years",
+			start:       date(2024, 11, 1),
+			end:         date(2026, 2, 1),
+			expected:    15,
+		},
+		{
+			description: "end before start",
+			start:       date(2025, 4, 15),
+			end:         date(2025, 1, 15),
+			expected:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, monthsBetween(test.start, test.end))
+		})
+	}
+}
+
+func TestProgressTrackerSuggestMonthlyContribution(t *testing.T) {
+	t.Parallel()
+
+	pt := newProgressTracker(
+		nil,
+		nil,
+		calc.NewCalculateCompoundInterestUseCase(validator.New()),
+	)
+
+	tests := []struct {
+		description string
+		savings     int64
+		target      int64
+		interest    int64
+		months      int
+		expected    int64
+	}{
+		{
+			description: "target reached",
+			savings:     1_000_00,
+			target:      1_000_00,
+			months:      12,
+			expected:    0,
+		},
+		{
+			description: "without interest",
+			savings:     100_00,
+			target:      1_000_00,
+			months:      12,
+			expected:    75_00,
+		},
+		{
+			description: "without interest rounds up",
+			target:      100_00,
+			months:      3,
+			expected:    33_34,
+		},
+		{
+			description: "interest lowers the contribution",
+			savings:     100_00,
+			target:      1_000_00,
+			interest:    12_00,
+			months:      12,
+			expected:    70_22,
+		},
+		{
+			description: "interest alone reaches the target",
+			savings:     1_000_00,
+			target:      1_050_00,
+			interest:    12_00,
+			months:      12,
+			expected:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := pt.suggestMonthlyContribution(
+				context.Background(),
+				test.savings,
+				test.target,
+				test.interest,
+				test.months,
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProgressTrackerProjectMonths(t *testing.T) {
+	t.Parallel()
+
+	pt := newProgressTracker(
+		nil,
+		nil,
+		calc.NewCalculateCompoundInterestUseCase(validator.New()),
+	)
+
+	tests := []struct {
+		description         string
+		savings             int64
+		target              int64
+		interest            int64
+		monthlyContribution int64
+		expected            int
+	}{
+		{
+			description: "nothing saved",
+			target:      1_000_00,
+			expected:    0,
+		},
+		{
+			description:         "without interest",
+			savings:             100_00,
+			target:              1_000_00,
+			monthlyContribution: 100_00,
+			expected:            9,
+		},
+		{
+			description:         "with interest",
+			savings:             100_00,
+			target:              1_000_00,
+			interest:            12_00,
+			monthlyContribution: 100_00,
+			expected:            9,
+		},
+		{
+			description: "interest alone",
+			savings:     1_000_00,
+			target:      2_000_00,
+			interest:    12_00,
+			expected:    74,
+		},
+		{
+			description:         "beyond the projection limit",
+			target:              1_000_000_00,
+			monthlyContribution: 1_00,
+			expected:            0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := pt.projectMonths(
+				context.Background(),
+				test.savings,
+				test.target,
+				test.interest,
+				test.monthlyContribution,
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
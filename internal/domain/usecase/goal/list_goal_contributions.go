@@ -0,0 +1,58 @@
+package goal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListGoalContributionsUseCase struct {
+	gr  repo.GoalRepo
+	gcr repo.GoalContributionRepo
+}
+
+func NewListGoalContributionsUseCase(
+	gr repo.GoalRepo,
+	gcr repo.GoalContributionRepo,
+) *ListGoalContributionsUseCase {
+	return &ListGoalContributionsUseCase{
+		gr:  gr,
+		gcr: gcr,
+	}
+}
+
+type ListGoalContributionsUseCaseInput struct {
+	GoalID uuid.UUID `json:"goal_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListGoalContributionsUseCaseOutput struct {
+	Items []entity.GoalContribution `json:"items"`
+}
+
+// Execute lists the contributions of a goal, the latest first.
+func (uc *ListGoalContributionsUseCase) Execute(
+	ctx context.Context,
+	in ListGoalContributionsUseCaseInput,
+) (*ListGoalContributionsUseCaseOutput, error) {
+	if _, err := getOwnGoal(ctx, uc.gr, in.GoalID, in.UserID); err != nil {
+		return nil, errs.New(err)
+	}
+
+	contributions, err := uc.gcr.ListGoalContributionsByGoalID(ctx, in.GoalID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	if contributions == nil {
+		contributions = []entity.GoalContribution{}
+	}
+
+	return &ListGoalContributionsUseCaseOutput{
+		Items: contributions,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package goal
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListGoalsUseCase struct {
+	gr repo.GoalRepo
+	pt *progressTracker
+}
+
+func NewListGoalsUseCase(
+	gr repo.GoalRepo,
+	abr repo.AccountBalanceRepo,
+	gcr repo.GoalContributionRepo,
+	cci *calc.CalculateCompoundInterestUseCase,
+) *ListGoalsUseCase {
+	return &ListGoalsUseCase{
+		gr: gr,
+		pt: newProgressTracker(abr, gcr, cci),
+	}
+}
+
+type ListGoalsUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListGoalsUseCaseOutput struct {
+	Items []GoalProgress `json:"items"`
+}
+
+// Execute lists the goals of the user by deadline, with their progress.
+func (uc *ListGoalsUseCase) Execute(
+	ctx context.Context,
+	in ListGoalsUseCaseInput,
+) (*ListGoalsUseCaseOutput, error) {
+	goals, err := uc.gr.ListGoalsByUserID(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	now := time.Now()
+	items := make([]GoalProgress, len(goals))
+
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, goal := range goals {
+		g.Go(func() error {
+			progress, err := uc.pt.track(gCtx, goal, now)
+			if err != nil {
+				return errs.New(err)
+			}
+			items[i] = *progress
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &ListGoalsUseCaseOutput{
+		Items: items,
+	}, nil
+}
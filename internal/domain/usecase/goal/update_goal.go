@@ -0,0 +1,72 @@
+package goal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/calc"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type UpdateGoalUseCase struct {
+	v   *validator.Validator
+	gr  repo.GoalRepo
+	ar  repo.AccountRepo
+	cer *calc.CalculateEmergencyReserveUseCase
+}
+
+func NewUpdateGoalUseCase(
+	v *validator.Validator,
+	gr repo.GoalRepo,
+	ar repo.AccountRepo,
+	cer *calc.CalculateEmergencyReserveUseCase,
+) *UpdateGoalUseCase {
+	return &UpdateGoalUseCase{
+		v:   v,
+		gr:  gr,
+		ar:  ar,
+		cer: cer,
+	}
+}
+
+type UpdateGoalUseCaseInput struct {
+	ID uuid.UUID `json:"-" validate:"required"`
+	GoalInput
+}
+
+// Execute replaces the goal definition. Contributions are kept when the goal
+// is linked to an account, and count again if it is unlinked.
+func (uc *UpdateGoalUseCase) Execute(
+	ctx context.Context,
+	in UpdateGoalUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	if _, err := getOwnGoal(ctx, uc.gr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	params, err := prepareGoalParams(ctx, uc.ar, uc.cer, in.GoalInput)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.gr.UpdateGoal(ctx, repo.UpdateGoalParams{
+		ID:                  in.ID,
+		Name:                params.Name,
+		TargetAmount:        params.TargetAmount,
+		Deadline:            params.Deadline,
+		Interest:            params.Interest,
+		MonthlyContribution: params.MonthlyContribution,
+		AccountID:           params.AccountID,
+	}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package imports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+)
+
+// csvPreset maps the columns of a CSV statement by their folded headers.
+type csvPreset struct {
+	dateColumns   []string
+	amountColumns []string
+	// descriptionColumns are joined when more than one is present, like the
+	// operation and the counterparty.
+	descriptionColumns []string
+	dateLayouts        []string
+	// shouldNegateAmount is set for credit card statements, which list
+	// purchases as positive amounts.
+	shouldNegateAmount bool
+}
+
+var csvPresets = map[entity.ImportCSVPreset]csvPreset{
+	entity.ImportCSVPresetGeneric: {
+		dateColumns: []string{
+			"data",
+			"date",
+			"data lancamento",
+			"data de lancamento",
+			"data da transacao",
+		},
+		amountColumns: []string{
+			"valor",
+			"amount",
+			"value",
+			"valor (r$)",
+			"quantia",
+		},
+		descriptionColumns: []string{
+			"historico",
+			"descricao",
+			"description",
+			"title",
+			"titulo",
+			"lancamento",
+			"memo",
+		},
+	},
+	entity.ImportCSVPresetNubankAccount: {
+		dateColumns:        []string{"data"},
+		amountColumns:      []string{"valor"},
+		descriptionColumns: []string{"descricao"},
+		dateLayouts:        []string{"02/01/2006"},
+	},
+	entity.ImportCSVPresetNubankCard: {
+		dateColumns:        []string{"date"},
+		amountColumns:      []string{"amount"},
+		descriptionColumns: []string{"title"},
+		dateLayouts:        []string{"2006-01-02"},
+		shouldNegateAmount: true,
+	},
+	entity.ImportCSVPresetInter: {
+		dateColumns:        []string{"data lancamento"},
+		amountColumns:      []string{"valor"},
+		descriptionColumns: []string{"historico", "descricao"},
+		dateLayouts:        []string{"02/01/2006"},
+	},
+}
+
+type csvColumns struct {
+	date, amount int
+	descriptions []int
+}
+
+// parseCSV reads a CSV statement. The delimiter is detected and the header
+// is searched for, since some banks add the account details above it.
+func parseCSV(
+	presetName entity.ImportCSVPreset,
+	data []byte,
+) ([]Row, error) {
+	preset, ok := csvPresets[presetName]
+	if !ok {
+		preset = csvPresets[entity.ImportCSVPresetGeneric]
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = detectCSVDelimiter(data)
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	var (
+		rows    []Row
+		columns *csvColumns
+	)
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if parseErr := (*csv.ParseError)(nil); errors.As(err, &parseErr) {
+			return nil, newLineError(parseErr.Line, parseErr.Err)
+		}
+		if err != nil {
+			return nil, errs.New(err)
+		}
+		line, _ := r.FieldPos(0)
+
+		if columns == nil {
+			columns = findCSVColumns(preset, record)
+			continue
+		}
+
+		if isBlankRecord(record) {
+			continue
+		}
+
+		row, err := parseCSVRecord(preset, *columns, record)
+		if err != nil {
+			return nil, newLineError(line, err)
+		}
+
+		rows = append(rows, row)
+	}
+
+	if columns == nil {
+		return nil, errs.ErrImportCSVColumnsNotFound
+	}
+
+	return rows, nil
+}
+
+func detectCSVDelimiter(data []byte) rune {
+	head := string(data[:min(len(data), 4096)])
+
+	delimiter, count := ',', strings.Count(head, ",")
+	for _, candidate := range []rune{';', '\t'} {
+		if c := strings.Count(head, string(candidate)); c > count {
+			delimiter, count = candidate, c
+		}
+	}
+
+	return delimiter
+}
+
+// findCSVColumns returns the columns of the preset if the record is the
+// header.
+func findCSVColumns(preset csvPreset, record []string) *csvColumns {
+	headers := make([]string, len(record))
+	for i, header := range record {
+		headers[i] = fold(header)
+	}
+
+	indexOf := func(names []string) int {
+		for _, name := range names {
+			if i := slices.Index(headers, name); i >= 0 {
+				return i
+			}
+		}
+		return -1
+	}
+
+	columns := csvColumns{
+		date:   indexOf(preset.dateColumns),
+		amount: indexOf(preset.amountColumns),
+	}
+	for _, name := range preset.descriptionColumns {
+		if i := slices.Index(headers, name); i >= 0 {
+			columns.descriptions = append(columns.descriptions, i)
+		}
+	}
+
+	if columns.date < 0 || columns.amount < 0 ||
+		len(columns.descriptions) == 0 {
+		return nil
+	}
+
+	return &columns
+}
+
+func parseCSVRecord(
+	preset csvPreset,
+	columns csvColumns,
+	record []string,
+) (Row, error) {
+	field := func(i int) string {
+		if i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	date, err := parseDate(field(columns.date), preset.dateLayouts...)
+	if err != nil {
+		return Row{}, err
+	}
+
+	amount, err := parseCents(field(columns.amount))
+	if err != nil {
+		return Row{}, err
+	}
+	if preset.shouldNegateAmount {
+		amount = -amount
+	}
+
+	descriptions := []string{}
+	for _, i := range columns.descriptions {
+		if description := field(i); description != "" &&
+			!slices.Contains(descriptions, description) {
+			descriptions = append(descriptions, description)
+		}
+	}
+
+	return Row{
+		Date:   date,
+		Name:   strings.Join(descriptions, " - "),
+		Amount: amount,
+	}, nil
+}
+
+func isBlankRecord(record []string) bool {
+	return !slices.ContainsFunc(record, func(field string) bool {
+		return strings.TrimSpace(field) != ""
+	})
+}
@@ -0,0 +1,48 @@
+package imports
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+// buildExternalIDs hashes the content of each row, so importing the same
+// statement again, or one that overlaps it, skips the rows already imported.
+// Equal rows of the same file, like two coffees on the same day, are told
+// apart by their position among the equal rows. The bank id is used instead
+// when the file has it.
+func buildExternalIDs(accountID uuid.UUID, rows []Row) []string {
+	externalIDs := make([]string, 0, len(rows))
+	occurrences := map[string]int{}
+
+	for _, row := range rows {
+		content := fmt.Sprintf(
+			"%s|%s|%d|%s",
+			accountID,
+			row.Date.Format("2006-01-02"),
+			row.Amount,
+			strings.ToLower(strings.Join(strings.Fields(row.Name), " ")),
+		)
+
+		if row.BankID != "" {
+			content += "|" + row.BankID
+		} else {
+			occurrence := occurrences[content]
+			occurrences[content]++
+			content += fmt.Sprintf("|%d", occurrence)
+		}
+
+		hash := sha256.Sum256([]byte(content))
+		externalIDs = append(
+			externalIDs,
+			entity.ImportExternalIDPrefix+hex.EncodeToString(hash[:16]),
+		)
+	}
+
+	return externalIDs
+}
@@ -0,0 +1,314 @@
+package imports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// MaxImportFileSize is the largest statement file accepted, in bytes.
+const MaxImportFileSize = 5 << 20
+
+type ImportTransactionsUseCase struct {
+	e   *env.Env
+	v   *validator.Validator
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	ar  repo.AccountRepo
+	tcr repo.TransactionCategoryRepo
+	pmr repo.PaymentMethodRepo
+	rr  repo.RuleRepo
+}
+
+func NewImportTransactionsUseCase(
+	e *env.Env,
+	v *validator.Validator,
+	tx tx.TX,
+	tr repo.TransactionRepo,
+	ar repo.AccountRepo,
+	tcr repo.TransactionCategoryRepo,
+	pmr repo.PaymentMethodRepo,
+	rr repo.RuleRepo,
+) *ImportTransactionsUseCase {
+	return &ImportTransactionsUseCase{
+		e:   e,
+		v:   v,
+		tx:  tx,
+		tr:  tr,
+		ar:  ar,
+		tcr: tcr,
+		pmr: pmr,
+		rr:  rr,
+	}
+}
+
+type ImportTransactionsUseCaseInput struct {
+	UserID   uuid.UUID `json:"-" validate:"required"`
+	FileName string    `json:"-"`
+	Data     []byte    `json:"-"`
+
+	// Format is detected from the file when empty.
+	Format    entity.ImportFormat    `json:"format"     validate:"omitempty,oneof=CSV OFX QIF"`
+	CSVPreset entity.ImportCSVPreset `json:"csv_preset" validate:"omitempty,oneof=GENERIC NUBANK_ACCOUNT NUBANK_CARD INTER"`
+
+	// AccountID is the manual account the transactions are imported into,
+	// when empty a manual account named AccountName is created.
+	AccountID       *uuid.UUID `json:"account_id"        validate:"required_without=AccountName"`
+	AccountName     string     `json:"account_name"      validate:"required_without=AccountID"`
+	PaymentMethodID uuid.UUID  `json:"payment_method_id" validate:"required"`
+
+	// IsPreview parses the file and flags the duplicates without saving.
+	IsPreview bool `json:"-"`
+}
+
+type ImportTransactionsUseCaseOutput struct {
+	AccountID  *uuid.UUID                            `json:"account_id,omitzero"`
+	Total      int                                   `json:"total"`
+	Duplicates int                                   `json:"duplicates"`
+	Imported   int                                   `json:"imported"`
+	Items      []ImportTransactionsUseCaseItemOutput `json:"items"`
+}
+
+type ImportTransactionsUseCaseItemOutput struct {
+	Row
+	ExternalID  string     `json:"external_id"`
+	CategoryID  uuid.UUID  `json:"category_id"`
+	IsIgnored   bool       `json:"is_ignored"`
+	TagID       *uuid.UUID `json:"tag_id,omitzero"`
+	IsDuplicate bool       `json:"is_duplicate"`
+}
+
+// Execute reads a statement file into transactions of a manual account. The
+// external id of each transaction is a hash of its content, so rows already
+// imported are flagged as duplicates and skipped. The rules of the user are
+// applied as in synced transactions.
+func (uc *ImportTransactionsUseCase) Execute(
+	ctx context.Context,
+	in ImportTransactionsUseCaseInput,
+) (*ImportTransactionsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if len(in.Data) > MaxImportFileSize {
+		return nil, errs.ErrImportFileTooLarge
+	}
+
+	if in.Format == "" {
+		format, ok := DetectFormat(in.FileName, in.Data)
+		if !ok {
+			return nil, errs.ErrInvalidImportFile
+		}
+		in.Format = format
+	}
+
+	rows, err := Parse(in.Format, in.CSVPreset, in.Data)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	if in.AccountID != nil {
+		g.Go(func() error {
+			accounts, err := uc.ar.ListAccounts(gCtx, repo.AccountOptions{
+				IDs:     []uuid.UUID{*in.AccountID},
+				UserIDs: []uuid.UUID{in.UserID},
+			})
+			if err != nil {
+				return errs.New(err)
+			}
+			if len(accounts) == 0 {
+				return errs.ErrAccountNotFound
+			}
+			if accounts[0].UserInstitutionID != nil {
+				return errs.ErrManualAccountRequired
+			}
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		paymentMethod, err := uc.pmr.GetPaymentMethodByID(
+			gCtx,
+			in.PaymentMethodID,
+		)
+		if err != nil {
+			return errs.New(err)
+		}
+		if paymentMethod == nil {
+			return errs.ErrPaymentMethodNotFound
+		}
+		return nil
+	})
+
+	var defaultCategory *entity.TransactionCategory
+	g.Go(func() error {
+		var err error
+		defaultCategory, err = uc.tcr.GetDefaultTransactionCategory(gCtx)
+		if err != nil {
+			return errs.New(err)
+		}
+		if defaultCategory == nil {
+			return errs.ErrCategoryNotFound
+		}
+		return nil
+	})
+
+	var rules []entity.Rule
+	g.Go(func() error {
+		var err error
+		rules, err = uc.rr.ListRules(gCtx, repo.RuleOptions{UserID: in.UserID})
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	accountID := ptr.Deref(in.AccountID)
+	if in.AccountID == nil {
+		accountID = uuid.New()
+	}
+
+	externalIDs := buildExternalIDs(accountID, rows)
+
+	existingExternalIDs := map[string]struct{}{}
+	if in.AccountID != nil {
+		ids, err := uc.tr.ListTransactionExternalIDs(
+			ctx,
+			repo.ListTransactionExternalIDsParams{
+				UserID:      in.UserID,
+				ExternalIds: externalIDs,
+			},
+		)
+		if err != nil {
+			return nil, errs.New(err)
+		}
+		for _, id := range ids {
+			existingExternalIDs[id] = struct{}{}
+		}
+	}
+
+	ruleEngine := rule.NewEngine(rules, uc.e.MaxLevenshteinDistancePercentage)
+
+	out := &ImportTransactionsUseCaseOutput{
+		Total: len(rows),
+		Items: make([]ImportTransactionsUseCaseItemOutput, 0, len(rows)),
+	}
+
+	for i, row := range rows {
+		target := rule.Target{
+			Name:            row.Name,
+			Amount:          row.Amount,
+			PaymentMethodID: in.PaymentMethodID,
+			CategoryID:      defaultCategory.ID,
+		}
+		ruleEngine.Apply(&target)
+
+		row.Name = target.Name
+		_, isDuplicate := existingExternalIDs[externalIDs[i]]
+		if isDuplicate {
+			out.Duplicates++
+		}
+
+		out.Items = append(out.Items, ImportTransactionsUseCaseItemOutput{
+			Row:         row,
+			ExternalID:  externalIDs[i],
+			CategoryID:  target.CategoryID,
+			IsIgnored:   target.IsIgnored,
+			TagID:       target.TagID,
+			IsDuplicate: isDuplicate,
+		})
+	}
+
+	if in.IsPreview {
+		return out, nil
+	}
+
+	params := []repo.CreateTransactionsParams{}
+	externalIDsByTagID := map[uuid.UUID][]string{}
+	for _, item := range out.Items {
+		if item.IsDuplicate {
+			continue
+		}
+
+		param := repo.CreateTransactionsParams{}
+		if err := copier.Copy(&param, item.Row); err != nil {
+			return nil, errs.New(err)
+		}
+		param.ExternalID = ptr.New(item.ExternalID)
+		param.PaymentMethodID = in.PaymentMethodID
+		param.UserID = in.UserID
+		param.AccountID = &accountID
+		param.CategoryID = item.CategoryID
+		param.IsIgnored = item.IsIgnored
+		param.Status = entity.TransactionStatusPosted
+		params = append(params, param)
+
+		if item.TagID != nil {
+			externalIDsByTagID[*item.TagID] = append(
+				externalIDsByTagID[*item.TagID],
+				item.ExternalID,
+			)
+		}
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		if in.AccountID == nil {
+			if err := uc.ar.CreateAccounts(ctx, []repo.CreateAccountsParams{
+				{
+					ID:         accountID,
+					ExternalID: accountID.String(),
+					Name:       in.AccountName,
+					Type:       entity.AccountTypeBank,
+					UserID:     in.UserID,
+				},
+			}); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		if len(params) == 0 {
+			return nil
+		}
+
+		if err := uc.tr.CreateTransactions(ctx, params); err != nil {
+			return errs.New(err)
+		}
+
+		for tagID, externalIDs := range externalIDsByTagID {
+			if err := uc.tr.TagTransactionsByExternalIDs(
+				ctx,
+				repo.TagTransactionsByExternalIDsParams{
+					TagID:       tagID,
+					UserID:      in.UserID,
+					ExternalIds: externalIDs,
+				},
+			); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	out.AccountID = &accountID
+	out.Imported = len(params)
+
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package imports
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parseOFX reads the transactions of an OFX statement. Both the SGML
+// (OFX 1.x), where most tags are not closed, and the XML (OFX 2.x) versions
+// are read by taking the text after each tag as its value.
+func parseOFX(data []byte) ([]Row, error) {
+	content := string(data)
+	if !strings.Contains(strings.ToUpper(content), "<OFX>") {
+		return nil, fmt.Errorf("missing OFX tag")
+	}
+
+	var (
+		rows          []Row
+		transaction   map[string]string
+		transactionNo int
+	)
+
+	for _, chunk := range strings.Split(content, "<")[1:] {
+		tag, value, _ := strings.Cut(chunk, ">")
+		tag = strings.ToUpper(strings.TrimSpace(tag))
+		value = strings.TrimSpace(value)
+
+		switch tag {
+		case "STMTTRN":
+			transaction = map[string]string{}
+			transactionNo++
+
+		case "/STMTTRN":
+			if transaction == nil {
+				continue
+			}
+
+			row, err := parseOFXTransaction(transaction)
+			if err != nil {
+				return nil, newLineError(transactionNo, err)
+			}
+			rows = append(rows, row)
+			transaction = nil
+
+		default:
+			if transaction != nil && !strings.HasPrefix(tag, "/") {
+				transaction[tag] = decodeOFXEntities(value)
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+func parseOFXTransaction(transaction map[string]string) (Row, error) {
+	// Dates may be followed by the time and the timezone, like
+	// "20240105120000[-3:BRT]"
+	posted := transaction["DTPOSTED"]
+	date, err := parseDate(posted[:min(len(posted), 8)], "20060102")
+	if err != nil {
+		return Row{}, err
+	}
+
+	amount, err := parseCents(transaction["TRNAMT"])
+	if err != nil {
+		return Row{}, err
+	}
+
+	name := transaction["NAME"]
+	if memo := transaction["MEMO"]; name == "" ||
+		(memo != "" && strings.HasPrefix(memo, name)) {
+		name = memo
+	}
+
+	return Row{
+		Date:   date,
+		Name:   name,
+		Amount: amount,
+		BankID: transaction["FITID"],
+	}, nil
+}
+
+var ofxEntitiesReplacer = strings.NewReplacer(
+	"&amp;", "&",
+	"&lt;", "<",
+	"&gt;", ">",
+	"&quot;", `"`,
+	"&apos;", "'",
+)
+
+func decodeOFXEntities(value string) string {
+	return ofxEntitiesReplacer.Replace(value)
+}
@@ -0,0 +1,184 @@
+package imports
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+)
+
+// Row is a transaction read from a statement file.
+type Row struct {
+	Date   time.Time `json:"date"`
+	Name   string    `json:"name"`
+	Amount int64     `json:"amount"`
+
+	// BankID is the id the bank gave the transaction, only OFX files have it.
+	BankID string `json:"-"`
+}
+
+// Parse reads the transactions of a statement file. The preset is only used
+// by CSV files.
+func Parse(
+	format entity.ImportFormat,
+	preset entity.ImportCSVPreset,
+	data []byte,
+) ([]Row, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var (
+		rows []Row
+		err  error
+	)
+
+	switch format {
+	case entity.ImportFormatCSV:
+		rows, err = parseCSV(preset, data)
+	case entity.ImportFormatOFX:
+		rows, err = parseOFX(data)
+	case entity.ImportFormatQIF:
+		rows, err = parseQIF(data)
+	default:
+		return nil, errs.ErrInvalidImportFile
+	}
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	if len(rows) == 0 {
+		return nil, errs.ErrImportFileEmpty
+	}
+
+	return rows, nil
+}
+
+// DetectFormat infers the format of a statement file from its extension,
+// falling back to its contents.
+func DetectFormat(fileName string, data []byte) (entity.ImportFormat, bool) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return entity.ImportFormatCSV, true
+	case ".ofx":
+		return entity.ImportFormatOFX, true
+	case ".qif":
+		return entity.ImportFormatQIF, true
+	}
+
+	head := strings.ToUpper(string(data[:min(len(data), 512)]))
+	switch {
+	case strings.Contains(head, "OFXHEADER") || strings.Contains(head, "<OFX>"):
+		return entity.ImportFormatOFX, true
+	case strings.Contains(head, "!TYPE:"):
+		return entity.ImportFormatQIF, true
+	}
+
+	return "", false
+}
+
+// parseCents parses a money amount into cents. Thousands separators, the
+// currency symbol and signs after the number or in parentheses are
+// accepted. The last dot or comma followed by up to two digits is the
+// decimal separator, so both "1.234,56" and "1,234.56" work.
+func parseCents(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "R$")
+	value = strings.ReplaceAll(value, " ", "")
+
+	isNegative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		isNegative = true
+		value = value[1 : len(value)-1]
+	}
+	if strings.HasSuffix(value, "-") {
+		isNegative = true
+		value = strings.TrimSuffix(value, "-")
+	}
+	if rest, ok := strings.CutPrefix(value, "-"); ok {
+		isNegative = !isNegative
+		value = rest
+	}
+	value = strings.TrimPrefix(value, "+")
+	value = strings.TrimPrefix(value, "R$")
+
+	integer, fraction := value, ""
+	if i := strings.LastIndexAny(value, ".,"); i >= 0 &&
+		len(value)-i-1 <= 2 {
+		integer, fraction = value[:i], value[i+1:]
+	}
+	integer = strings.NewReplacer(".", "", ",", "").Replace(integer)
+	fraction = (fraction + "00")[:2]
+
+	if integer == "" {
+		integer = "0"
+	}
+
+	cents, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	if isNegative {
+		cents = -cents
+	}
+
+	return cents, nil
+}
+
+// dateLayouts are tried in order, day first since most statements are from
+// brazilian banks.
+var dateLayouts = []string{
+	"02/01/2006",
+	"2/1/2006",
+	"02/01/06",
+	"2/1/06",
+	"2006-01-02",
+	"02-01-2006",
+	"02.01.2006",
+}
+
+func parseDate(value string, layouts ...string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(layouts) == 0 {
+		layouts = dateLayouts
+	}
+
+	// Dates may be followed by the time, like "2024-01-05T10:00:00Z"
+	date := value
+	if i := strings.IndexAny(date, " T"); i > 0 {
+		date = date[:i]
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+func newLineError(line int, err error) error {
+	return errs.New(
+		fmt.Sprintf("%s: linha %d, %s", errs.ErrInvalidImportFile.Message, line, err),
+		errs.ErrCodeValidation,
+	)
+}
+
+var foldReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e",
+	"í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u",
+	"ç", "c",
+)
+
+// fold lowercases the text and drops its accents, to compare headers.
+func fold(text string) string {
+	return foldReplacer.Replace(strings.ToLower(strings.TrimSpace(text)))
+}
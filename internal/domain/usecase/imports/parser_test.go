@@ -0,0 +1,314 @@
+package imports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+func TestParseCents(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		expected int64
+		wantErr  bool
+	}{
+		{value: "10", expected: 1000},
+		{value: "-10,5", expected: -1050},
+		{value: "1.234,56", expected: 123456},
+		{value: "1,234.56", expected: 123456},
+		{value: "1.234", expected: 123400},
+		{value: "R$ -45,90", expected: -4590},
+		{value: "-R$ 45,90", expected: -4590},
+		{value: "(45.90)", expected: -4590},
+		{value: "45,90-", expected: -4590},
+		{value: "+0.01", expected: 1},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+
+			cents, err := parseCents(test.value)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, cents)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	expected := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		layouts []string
+		wantErr bool
+	}{
+		{value: "05/01/2024"},
+		{value: "5/1/2024"},
+		{value: "05/01/24"},
+		{value: "2024-01-05"},
+		{value: "2024-01-05T10:00:00Z"},
+		{value: "05/01/2024 10:00"},
+		{value: "2024-01-05", layouts: []string{"02/01/2006"}, wantErr: true},
+		{value: "01/13/2024", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+
+			date, err := parseDate(test.value, test.layouts...)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, date)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	date := func(day int) time.Time {
+		return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		description string
+		format      entity.ImportFormat
+		preset      entity.ImportCSVPreset
+		data        string
+		expected    []Row
+		wantErr     bool
+	}{
+		{
+			description: "generic CSV with semicolons and account details",
+			format:      entity.ImportFormatCSV,
+			data: "\xef\xbb\xbfConta;12345\n" +
+				"Data;Descrição;Valor\n" +
+				"05/01/2024;Padaria;-12,50\n" +
+				";;\n" +
+				"06/01/2024;\"Salário; janeiro\";1.500,00\n",
+			expected: []Row{
+				{Date: date(5), Name: "Padaria", Amount: -1250},
+				{Date: date(6), Name: "Salário; janeiro", Amount: 150000},
+			},
+		},
+		{
+			description: "nubank account CSV",
+			format:      entity.ImportFormatCSV,
+			preset:      entity.ImportCSVPresetNubankAccount,
+			data: "Data,Valor,Identificador,Descrição\n" +
+				"05/01/2024,-32.90,abc,Compra no débito - Mercado\n",
+			expected: []Row{
+				{Date: date(5), Name: "Compra no débito - Mercado", Amount: -3290},
+			},
+		},
+		{
+			description: "nubank card CSV negates purchases",
+			format:      entity.ImportFormatCSV,
+			preset:      entity.ImportCSVPresetNubankCard,
+			data: "date,title,amount\n" +
+				"2024-01-05,Restaurante,45.90\n" +
+				"2024-01-06,Pagamento recebido,-100.00\n",
+			expected: []Row{
+				{Date: date(5), Name: "Restaurante", Amount: -4590},
+				{Date: date(6), Name: "Pagamento recebido", Amount: 10000},
+			},
+		},
+		{
+			description: "inter CSV joins history and description",
+			format:      entity.ImportFormatCSV,
+			preset:      entity.ImportCSVPresetInter,
+			data: "Extrato Conta Corrente\n" +
+				"Data Lançamento;Histórico;Descrição;Valor;Saldo\n" +
+				"05/01/2024;Pix enviado;Maria;-50,00;950,00\n",
+			expected: []Row{
+				{Date: date(5), Name: "Pix enviado - Maria", Amount: -5000},
+			},
+		},
+		{
+			description: "CSV without the preset columns",
+			format:      entity.ImportFormatCSV,
+			data:        "foo,bar\n1,2\n",
+			wantErr:     true,
+		},
+		{
+			description: "CSV with an invalid amount",
+			format:      entity.ImportFormatCSV,
+			data:        "data,descricao,valor\n05/01/2024,Padaria,abc\n",
+			wantErr:     true,
+		},
+		{
+			description: "CSV with only the header",
+			format:      entity.ImportFormatCSV,
+			data:        "data,descricao,valor\n",
+			wantErr:     true,
+		},
+		{
+			description: "SGML OFX",
+			format:      entity.ImportFormatOFX,
+			data: "OFXHEADER:100\nDATA:OFXSGML\n\n" +
+				"<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><BANKTRANLIST>\n" +
+				"<STMTTRN>\n<TRNTYPE>DEBIT\n<DTPOSTED>20240105120000[-3:BRT]\n" +
+				"<TRNAMT>-12.50\n<FITID>1\n<NAME>PADARIA\n<MEMO>PADARIA &amp; CAFE\n</STMTTRN>\n" +
+				"<STMTTRN>\n<TRNTYPE>CREDIT\n<DTPOSTED>20240106\n" +
+				"<TRNAMT>1500.00\n<FITID>2\n<MEMO>SALARIO\n</STMTTRN>\n" +
+				"</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>\n",
+			expected: []Row{
+				{Date: date(5), Name: "PADARIA & CAFE", Amount: -1250, BankID: "1"},
+				{Date: date(6), Name: "SALARIO", Amount: 150000, BankID: "2"},
+			},
+		},
+		{
+			description: "XML OFX",
+			format:      entity.ImportFormatOFX,
+			data: `<?xml version="1.0"?><OFX><STMTTRN>` +
+				`<DTPOSTED>20240105</DTPOSTED><TRNAMT>-1.5</TRNAMT>` +
+				`<FITID>a</FITID><NAME>Metro</NAME></STMTTRN></OFX>`,
+			expected: []Row{
+				{Date: date(5), Name: "Metro", Amount: -150, BankID: "a"},
+			},
+		},
+		{
+			description: "file without the OFX tag",
+			format:      entity.ImportFormatOFX,
+			data:        "<STMTTRN></STMTTRN>",
+			wantErr:     true,
+		},
+		{
+			description: "QIF",
+			format:      entity.ImportFormatQIF,
+			data: "!Type:Bank\n" +
+				"D05/01'24\nT-12.50\nPPadaria\n^\n" +
+				"D06/01/2024\nU1,500.00\nMSalario\n^\n",
+			expected: []Row{
+				{Date: date(5), Name: "Padaria", Amount: -1250},
+				{Date: date(6), Name: "Salario", Amount: 150000},
+			},
+		},
+		{
+			description: "QIF with an invalid date",
+			format:      entity.ImportFormatQIF,
+			data:        "!Type:Bank\nDfoo\nT1\n^\n",
+			wantErr:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := Parse(test.format, test.preset, []byte(test.data))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, rows)
+		})
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fileName string
+		data     string
+		expected entity.ImportFormat
+		ok       bool
+	}{
+		{fileName: "extrato.CSV", expected: entity.ImportFormatCSV, ok: true},
+		{fileName: "extrato.ofx", expected: entity.ImportFormatOFX, ok: true},
+		{fileName: "extrato.qif", expected: entity.ImportFormatQIF, ok: true},
+		{
+			fileName: "extrato",
+			data:     "OFXHEADER:100",
+			expected: entity.ImportFormatOFX,
+			ok:       true,
+		},
+		{
+			fileName: "extrato.txt",
+			data:     "!Type:Bank",
+			expected: entity.ImportFormatQIF,
+			ok:       true,
+		},
+		{fileName: "extrato.txt", data: "foo"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fileName, func(t *testing.T) {
+			t.Parallel()
+
+			format, ok := DetectFormat(test.fileName, []byte(test.data))
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, format)
+		})
+	}
+}
+
+func TestBuildExternalIDs(t *testing.T) {
+	t.Parallel()
+
+	accountID := uuid.New()
+	date := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	rows := []Row{
+		{Date: date, Name: "Café", Amount: -500},
+		{Date: date, Name: " café ", Amount: -500},
+		{Date: date, Name: "Café", Amount: -600},
+	}
+
+	ids := buildExternalIDs(accountID, rows)
+	assert.Len(t, ids, 3)
+	assert.NotEqual(t, ids[0], ids[1], "equal rows are told apart")
+	assert.NotEqual(t, ids[0], ids[2])
+	for _, id := range ids {
+		assert.Regexp(t, "^import:[0-9a-f]{32}$", id)
+	}
+
+	assert.Equal(
+		t,
+		ids,
+		buildExternalIDs(accountID, rows),
+		"the same file has the same ids",
+	)
+	assert.Equal(
+		t,
+		ids[:1],
+		buildExternalIDs(accountID, rows[1:2]),
+		"overlapping files share the ids",
+	)
+	assert.NotEqual(t, ids, buildExternalIDs(uuid.New(), rows))
+
+	withBankIDs := []Row{
+		{Date: date, Name: "Café", Amount: -500, BankID: "1"},
+		{Date: date, Name: "Café", Amount: -500, BankID: "2"},
+	}
+	bankIDs := buildExternalIDs(accountID, withBankIDs)
+	assert.NotEqual(t, bankIDs[0], bankIDs[1])
+	assert.Equal(
+		t,
+		bankIDs[1:],
+		buildExternalIDs(accountID, withBankIDs[1:]),
+	)
+}
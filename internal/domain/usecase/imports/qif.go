@@ -0,0 +1,80 @@
+package imports
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// parseQIF reads the transactions of a QIF statement, where each line
+// starts with the field code and transactions end with "^". Two digit years
+// may be written after an apostrophe, like "05/01'24".
+func parseQIF(data []byte) ([]Row, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	var (
+		rows           []Row
+		fields         = map[byte]string{}
+		line, rowStart int
+	)
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "!") {
+			continue
+		}
+
+		if text[0] != '^' {
+			if len(fields) == 0 {
+				rowStart = line
+			}
+			fields[text[0]] = strings.TrimSpace(text[1:])
+			continue
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		row, err := parseQIFTransaction(fields)
+		if err != nil {
+			return nil, newLineError(rowStart, err)
+		}
+		rows = append(rows, row)
+		fields = map[byte]string{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func parseQIFTransaction(fields map[byte]string) (Row, error) {
+	date, err := parseDate(strings.ReplaceAll(fields['D'], "'", "/"))
+	if err != nil {
+		return Row{}, err
+	}
+
+	amount := fields['T']
+	if amount == "" {
+		amount = fields['U']
+	}
+	cents, err := parseCents(amount)
+	if err != nil {
+		return Row{}, err
+	}
+
+	name := fields['P']
+	if name == "" {
+		name = fields['M']
+	}
+
+	return Row{
+		Date:   date,
+		Name:   name,
+		Amount: cents,
+	}, nil
+}
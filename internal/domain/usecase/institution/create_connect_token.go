@@ -0,0 +1,87 @@
+package institution
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type CreateConnectTokenUseCase struct {
+	v   *validator.Validator
+	o   openfinance.Client
+	uir repo.UserInstitutionRepo
+}
+
+func NewCreateConnectTokenUseCase(
+	v *validator.Validator,
+	o openfinance.Client,
+	uir repo.UserInstitutionRepo,
+) *CreateConnectTokenUseCase {
+	return &CreateConnectTokenUseCase{
+		v:   v,
+		o:   o,
+		uir: uir,
+	}
+}
+
+type CreateConnectTokenUseCaseInput struct {
+	UserID            uuid.UUID   `json:"-"                   validate:"required"`
+	Tier              entity.Tier `json:"-"                   validate:"required"`
+	UserInstitutionID *uuid.UUID  `json:"user_institution_id"`
+}
+
+type CreateConnectTokenUseCaseOutput struct {
+	AccessToken string `json:"access_token"`
+}
+
+func (uc *CreateConnectTokenUseCase) Execute(
+	ctx context.Context,
+	in CreateConnectTokenUseCaseInput,
+) (*CreateConnectTokenUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if in.Tier != entity.TierTrial && in.Tier != entity.TierPremium {
+		return nil, errs.ErrUnauthorizedTier
+	}
+
+	var opts []openfinance.ConnectTokenOption
+	if in.UserInstitutionID != nil {
+		userInstitution, err := uc.uir.GetUserInstitutionByID(
+			ctx,
+			*in.UserInstitutionID,
+		)
+		if err != nil {
+			return nil, errs.New(err)
+		}
+
+		if userInstitution == nil || userInstitution.UserID != in.UserID {
+			return nil, errs.ErrUserInstitutionNotFound
+		}
+
+		opts = append(
+			opts,
+			openfinance.WithConnectTokenItemID(userInstitution.ExternalID),
+		)
+	}
+
+	accessToken, err := uc.o.CreateConnectToken(
+		ctx,
+		in.UserID.String(),
+		opts...,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &CreateConnectTokenUseCaseOutput{
+		AccessToken: accessToken,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package institution
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type DeleteUserInstitutionUseCase struct {
+	v   *validator.Validator
+	o   openfinance.Client
+	tx  tx.TX
+	ar  repo.AccountRepo
+	tr  repo.TransactionRepo
+	uir repo.UserInstitutionRepo
+}
+
+func NewDeleteUserInstitutionUseCase(
+	v *validator.Validator,
+	o openfinance.Client,
+	tx tx.TX,
+	ar repo.AccountRepo,
+	tr repo.TransactionRepo,
+	uir repo.UserInstitutionRepo,
+) *DeleteUserInstitutionUseCase {
+	return &DeleteUserInstitutionUseCase{
+		v:   v,
+		o:   o,
+		tx:  tx,
+		ar:  ar,
+		tr:  tr,
+		uir: uir,
+	}
+}
+
+type DeleteUserInstitutionUseCaseInput struct {
+	UserID            uuid.UUID `json:"-" validate:"required"`
+	UserInstitutionID uuid.UUID `json:"-" validate:"required"`
+	HideTransactions  bool      `json:"-"`
+}
+
+func (uc *DeleteUserInstitutionUseCase) Execute(
+	ctx context.Context,
+	in DeleteUserInstitutionUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	userInstitution, err := uc.uir.GetUserInstitutionByID(
+		ctx,
+		in.UserInstitutionID,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if userInstitution == nil || userInstitution.UserID != in.UserID {
+		return errs.ErrUserInstitutionNotFound
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		// Hidden transactions are kept out of the listings but, unlike the
+		// deleted ones, never reach the trash or the purge job.
+		if in.HideTransactions {
+			if err := uc.tr.HideTransactionsByUserInstitutionID(
+				ctx,
+				userInstitution.ID,
+			); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		if err := uc.ar.DeleteAccountsByUserInstitutionID(
+			ctx,
+			userInstitution.ID,
+		); err != nil {
+			return errs.New(err)
+		}
+
+		if err := uc.uir.DeleteUserInstitution(
+			ctx,
+			userInstitution.ID,
+		); err != nil {
+			return errs.New(err)
+		}
+
+		// The connection is removed from the provider last, so a provider
+		// failure rolls back the deletion and the user can retry.
+		return uc.o.DeleteItem(ctx, userInstitution.ExternalID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
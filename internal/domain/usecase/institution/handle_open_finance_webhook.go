@@ -0,0 +1,259 @@
+package institution
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// webhookSyncTimeout limits the sync of a user institution started by a
+// webhook, it also expires the sync lock.
+const webhookSyncTimeout = 10 * time.Minute
+
+type HandleOpenFinanceWebhookUseCase struct {
+	v   *validator.Validator
+	tx  tx.TX
+	c   cache.Cache
+	ar  repo.AccountRepo
+	uir repo.UserInstitutionRepo
+	st  *transaction.SyncTransactionsUseCase
+	sab *account.SyncAccountsBalancesUseCase
+}
+
+func NewHandleOpenFinanceWebhookUseCase(
+	v *validator.Validator,
+	tx tx.TX,
+	c cache.Cache,
+	ar repo.AccountRepo,
+	uir repo.UserInstitutionRepo,
+	st *transaction.SyncTransactionsUseCase,
+	sab *account.SyncAccountsBalancesUseCase,
+) *HandleOpenFinanceWebhookUseCase {
+	return &HandleOpenFinanceWebhookUseCase{
+		v:   v,
+		tx:  tx,
+		c:   c,
+		ar:  ar,
+		uir: uir,
+		st:  st,
+		sab: sab,
+	}
+}
+
+type HandleOpenFinanceWebhookUseCaseInput struct {
+	// EventID identifies the delivery, replays are rejected by the webhook
+	// middleware.
+	EventID string `json:"eventId" validate:"required"`
+	Event   string `json:"event"   validate:"required"`
+	ItemID  string `json:"itemId"  validate:"required"`
+}
+
+func (uc *HandleOpenFinanceWebhookUseCase) Execute(
+	ctx context.Context,
+	in HandleOpenFinanceWebhookUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	userInstitution, err := uc.uir.GetUserInstitutionByExternalID(
+		ctx,
+		in.ItemID,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if userInstitution == nil {
+		slog.Info(
+			"open-finance-webhook: user institution not found",
+			"event", in.Event,
+			"item_id", in.ItemID,
+		)
+		return nil
+	}
+
+	switch in.Event {
+	case pluggy.WebhookEventItemUpdated:
+		// A successful update means any previous connection issue was
+		// solved.
+		if err := uc.updateStatus(
+			ctx,
+			userInstitution,
+			entity.UserInstitutionStatusConnected,
+		); err != nil {
+			return errs.New(err)
+		}
+
+		// The sync outlasts the provider timeout, which would retry the
+		// webhook and start concurrent syncs, so it runs in background.
+		go uc.syncUserInstitution(userInstitution.ID)
+		return nil
+
+	case pluggy.WebhookEventItemLoginError,
+		pluggy.WebhookEventItemWaitingUserInput:
+		return uc.updateStatus(
+			ctx,
+			userInstitution,
+			entity.UserInstitutionStatusNeedsReconnection,
+		)
+
+	case pluggy.WebhookEventItemDeleted,
+		pluggy.WebhookEventItemConsentRevoked:
+		return uc.deleteUserInstitution(ctx, userInstitution.ID)
+
+	default:
+		slog.Info(
+			"open-finance-webhook: unhandled event",
+			"event", in.Event,
+			"item_id", in.ItemID,
+		)
+		return nil
+	}
+}
+
+// syncUserInstitution syncs the transactions and balances of the user
+// institution, unless a sync of it is already running. It runs detached from
+// the request, so a panic is recovered and logged instead of crashing the
+// server.
+func (uc *HandleOpenFinanceWebhookUseCase) syncUserInstitution(
+	userInstitutionID uuid.UUID,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(
+				"open-finance-webhook: panic syncing user institution",
+				"user_institution_id", userInstitutionID,
+				"panic", r,
+				"stack", string(debug.Stack()),
+			)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		webhookSyncTimeout,
+	)
+	defer cancel()
+
+	lockKey := fmt.Sprintf(
+		"%s:%s",
+		cache.KeyUserInstitutionSyncLock,
+		userInstitutionID,
+	)
+	lockToken := uuid.NewString()
+	ok, err := uc.c.SetNX(ctx, lockKey, lockToken, webhookSyncTimeout)
+	if err != nil {
+		slog.Error(
+			"open-finance-webhook: error acquiring sync lock",
+			"user_institution_id", userInstitutionID,
+			"err", err,
+		)
+		return
+	}
+	if !ok {
+		slog.Info(
+			"open-finance-webhook: user institution is already syncing",
+			"user_institution_id", userInstitutionID,
+		)
+		return
+	}
+	defer func() {
+		if _, err := uc.c.DeleteIfEqual(
+			context.Background(),
+			lockKey,
+			lockToken,
+		); err != nil {
+			slog.Error(
+				"open-finance-webhook: error releasing sync lock",
+				"user_institution_id", userInstitutionID,
+				"err", err,
+			)
+		}
+	}()
+
+	userInstitutionIDs := []uuid.UUID{userInstitutionID}
+
+	if _, err := uc.st.Execute(
+		ctx,
+		transaction.SyncTransactionsUseCaseInput{
+			UserInstitutionIDs: userInstitutionIDs,
+		},
+	); err != nil {
+		slog.Error(
+			"open-finance-webhook: error syncing transactions",
+			"user_institution_id", userInstitutionID,
+			"err", err,
+		)
+	}
+
+	if _, err := uc.sab.Execute(
+		ctx,
+		account.SyncAccountsBalancesUseCaseInput{
+			UserInstitutionIDs: userInstitutionIDs,
+		},
+	); err != nil {
+		slog.Error(
+			"open-finance-webhook: error syncing balances",
+			"user_institution_id", userInstitutionID,
+			"err", err,
+		)
+	}
+}
+
+func (uc *HandleOpenFinanceWebhookUseCase) updateStatus(
+	ctx context.Context,
+	userInstitution *entity.UserInstitution,
+	status entity.UserInstitutionStatus,
+) error {
+	if userInstitution.Status == status {
+		return nil
+	}
+
+	if err := uc.uir.UpdateUserInstitutionStatus(
+		ctx,
+		repo.UpdateUserInstitutionStatusParams{
+			ID:     userInstitution.ID,
+			Status: status,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (uc *HandleOpenFinanceWebhookUseCase) deleteUserInstitution(
+	ctx context.Context,
+	userInstitutionID uuid.UUID,
+) error {
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.ar.DeleteAccountsByUserInstitutionID(
+			ctx,
+			userInstitutionID,
+		); err != nil {
+			return errs.New(err)
+		}
+
+		return uc.uir.DeleteUserInstitution(ctx, userInstitutionID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package institution
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type ListUserInstitutionsUseCase struct {
+	ir repo.InstitutionRepo
+}
+
+func NewListUserInstitutionsUseCase(
+	ir repo.InstitutionRepo,
+) *ListUserInstitutionsUseCase {
+	return &ListUserInstitutionsUseCase{
+		ir: ir,
+	}
+}
+
+type ListUserInstitutionsUseCaseInput struct {
+	usecase.PaginationInput
+	Search string    `json:"search"`
+	UserID uuid.UUID `json:"-"`
+}
+
+func (uc *ListUserInstitutionsUseCase) Execute(
+	ctx context.Context,
+	in ListUserInstitutionsUseCaseInput,
+) (*entity.PaginatedList[entity.FullInstitution], error) {
+	opts := repo.InstitutionOptions{
+		Search:  in.Search,
+		UserIDs: []uuid.UUID{in.UserID},
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	var institutions []entity.FullInstitution
+	var count int64
+
+	g.Go(func() error {
+		var err error
+		count, err = uc.ir.CountInstitutions(gCtx, opts)
+		return err
+	})
+
+	listOpts := opts
+	listOpts.Limit, listOpts.Offset = usecase.PreparePaginationInput(
+		in.PaginationInput,
+	)
+
+	g.Go(func() error {
+		var err error
+		institutions, err = uc.ir.ListFullInstitutions(gCtx, listOpts)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := entity.PaginatedList[entity.FullInstitution]{
+		Items: institutions,
+	}
+
+	usecase.PreparePaginationOutput(&out, in.PaginationInput, count)
+
+	return &out, nil
+}
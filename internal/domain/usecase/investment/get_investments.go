@@ -0,0 +1,125 @@
+package investment
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type GetInvestmentsUseCase struct {
+	v  *validator.Validator
+	ir repo.InvestmentRepo
+}
+
+func NewGetInvestmentsUseCase(
+	v *validator.Validator,
+	ir repo.InvestmentRepo,
+) *GetInvestmentsUseCase {
+	return &GetInvestmentsUseCase{
+		v:  v,
+		ir: ir,
+	}
+}
+
+type GetInvestmentsUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type InvestmentTypeTotal struct {
+	Type    entity.InvestmentType `json:"type"`
+	Balance int64                 `json:"balance"`
+}
+
+type InvestmentInstitutionTotal struct {
+	InstitutionID uuid.UUID `json:"institution_id"`
+	Name          string    `json:"name"`
+	Logo          *string   `json:"logo,omitzero"`
+	Balance       int64     `json:"balance"`
+}
+
+type GetInvestmentsUseCaseOutput struct {
+	TotalBalance  int64                        `json:"total_balance"`
+	ByType        []InvestmentTypeTotal        `json:"by_type"`
+	ByInstitution []InvestmentInstitutionTotal `json:"by_institution"`
+	Investments   []entity.FullInvestment      `json:"investments"`
+}
+
+func (uc *GetInvestmentsUseCase) Execute(
+	ctx context.Context,
+	in GetInvestmentsUseCaseInput,
+) (*GetInvestmentsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	investments, err := uc.ir.ListFullInvestments(ctx, repo.InvestmentOptions{
+		UserIDs: []uuid.UUID{in.UserID},
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := &GetInvestmentsUseCaseOutput{
+		ByType:        []InvestmentTypeTotal{},
+		ByInstitution: []InvestmentInstitutionTotal{},
+		Investments:   investments,
+	}
+	if out.Investments == nil {
+		out.Investments = []entity.FullInvestment{}
+	}
+
+	typeTotalIndexes := map[entity.InvestmentType]int{}
+	institutionTotalIndexes := map[uuid.UUID]int{}
+	for _, investment := range investments {
+		out.TotalBalance += investment.Balance
+
+		i, ok := typeTotalIndexes[investment.Type]
+		if !ok {
+			i = len(out.ByType)
+			typeTotalIndexes[investment.Type] = i
+			out.ByType = append(out.ByType, InvestmentTypeTotal{
+				Type: investment.Type,
+			})
+		}
+		out.ByType[i].Balance += investment.Balance
+
+		if investment.InstitutionID == nil {
+			continue
+		}
+
+		i, ok = institutionTotalIndexes[*investment.InstitutionID]
+		if !ok {
+			i = len(out.ByInstitution)
+			institutionTotalIndexes[*investment.InstitutionID] = i
+
+			institutionTotal := InvestmentInstitutionTotal{
+				InstitutionID: *investment.InstitutionID,
+				Logo:          investment.InstitutionLogo,
+			}
+			if investment.InstitutionName != nil {
+				institutionTotal.Name = *investment.InstitutionName
+			}
+			out.ByInstitution = append(out.ByInstitution, institutionTotal)
+		}
+		out.ByInstitution[i].Balance += investment.Balance
+	}
+
+	slices.SortStableFunc(out.ByType, func(a, b InvestmentTypeTotal) int {
+		return cmp.Compare(b.Balance, a.Balance)
+	})
+	slices.SortStableFunc(
+		out.ByInstitution,
+		func(a, b InvestmentInstitutionTotal) int {
+			return cmp.Compare(b.Balance, a.Balance)
+		},
+	)
+
+	return out, nil
+}
@@ -0,0 +1,218 @@
+package investment
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type SyncInvestmentsUseCase struct {
+	e   *env.Env
+	tx  tx.TX
+	o   openfinance.Client
+	c   cache.Cache
+	uir repo.UserInstitutionRepo
+	ir  repo.InvestmentRepo
+	ipr repo.InvestmentPositionRepo
+}
+
+func NewSyncInvestmentsUseCase(
+	e *env.Env,
+	tx tx.TX,
+	o openfinance.Client,
+	c cache.Cache,
+	uir repo.UserInstitutionRepo,
+	ir repo.InvestmentRepo,
+	ipr repo.InvestmentPositionRepo,
+) *SyncInvestmentsUseCase {
+	return &SyncInvestmentsUseCase{
+		e:   e,
+		tx:  tx,
+		o:   o,
+		c:   c,
+		uir: uir,
+		ir:  ir,
+		ipr: ipr,
+	}
+}
+
+type SyncInvestmentsUseCaseInput struct {
+	UserInstitutionIDs []uuid.UUID `json:"user_institution_ids"`
+}
+
+type SyncInvestmentsUseCaseOutput struct {
+	ConnectionsProcessed int `json:"connections_processed"`
+}
+
+func (uc *SyncInvestmentsUseCase) Execute(
+	ctx context.Context,
+	in SyncInvestmentsUseCaseInput,
+) (*SyncInvestmentsUseCaseOutput, error) {
+	isSyncingAllConnections := len(in.UserInstitutionIDs) == 0
+	offset := 0
+	cacheExp := time.Hour * 24
+
+	userInstitutionOpts := repo.UserInstitutionOptions{
+		IsSubscriptionActive: ptr.New(true),
+	}
+
+	if isSyncingAllConnections {
+		if _, err := uc.c.Scan(ctx, cache.KeySyncInvestmentsOffset, &offset); err != nil {
+			return nil, errs.New(err)
+		}
+
+		if offset == -1 {
+			slog.Info("sync-investments: already completed")
+			return &SyncInvestmentsUseCaseOutput{}, nil
+		}
+
+		userInstitutionOpts.Limit = uint(uc.e.SyncInvestmentsMaxConnections)
+		userInstitutionOpts.Offset = uint(offset)
+	} else {
+		userInstitutionOpts.IDs = in.UserInstitutionIDs
+	}
+
+	userInstitutions, err := uc.uir.ListUserInstitutions(
+		ctx,
+		userInstitutionOpts,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	g := errgroup.Group{}
+	maxRequestsAtOnce := 5
+	g.SetLimit(maxRequestsAtOnce)
+
+	for _, userInstitution := range userInstitutions {
+		g.Go(func() error {
+			if err := uc.syncUserInstitutionInvestments(
+				ctx,
+				userInstitution,
+			); err != nil {
+				slog.Error(
+					"sync-investments: error syncing user institution",
+					"user_institution_id", userInstitution.ID,
+					"error", err,
+				)
+				return errs.New(err)
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := &SyncInvestmentsUseCaseOutput{
+		ConnectionsProcessed: len(userInstitutions),
+	}
+
+	if !isSyncingAllConnections {
+		return out, nil
+	}
+
+	if len(userInstitutions) < uc.e.SyncInvestmentsMaxConnections {
+		if err := uc.c.Set(ctx, cache.KeySyncInvestmentsOffset, -1, cacheExp); err != nil {
+			return nil, errs.New(err)
+		}
+		slog.Info("sync-investments: completed")
+		return out, nil
+	}
+
+	offset += uc.e.SyncInvestmentsMaxConnections
+	if err := uc.c.Set(ctx, cache.KeySyncInvestmentsOffset, offset, cacheExp); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return out, nil
+}
+
+func (uc *SyncInvestmentsUseCase) syncUserInstitutionInvestments(
+	ctx context.Context,
+	userInstitution entity.UserInstitution,
+) error {
+	openFinanceInvestments, err := uc.o.ListInvestments(
+		ctx,
+		userInstitution.ExternalID,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	externalIDs := make([]string, len(openFinanceInvestments))
+	for i, investment := range openFinanceInvestments {
+		externalIDs[i] = investment.ExternalID
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		createPositionsParams := make(
+			[]repo.CreateInvestmentPositionsParams,
+			len(openFinanceInvestments),
+		)
+		for i, openFinanceInvestment := range openFinanceInvestments {
+			// The upsert restores investments that were removed by a previous
+			// sync and reappeared at the provider.
+			investment, err := uc.ir.UpsertInvestment(
+				ctx,
+				repo.UpsertInvestmentParams{
+					ExternalID:        openFinanceInvestment.ExternalID,
+					Name:              openFinanceInvestment.Name,
+					Type:              openFinanceInvestment.Type,
+					Subtype:           openFinanceInvestment.Subtype,
+					UserInstitutionID: userInstitution.ID,
+				},
+			)
+			if err != nil {
+				return errs.New(err)
+			}
+
+			createPositionsParams[i] = repo.CreateInvestmentPositionsParams{
+				InvestmentID: investment.ID,
+				Amount:       openFinanceInvestment.Balance,
+			}
+		}
+
+		// Investments the provider no longer returns were redeemed or closed
+		if err := uc.ir.DeleteInvestmentsNotInExternalIDs(
+			ctx,
+			repo.DeleteInvestmentsNotInExternalIDsParams{
+				UserInstitutionID: userInstitution.ID,
+				ExternalIds:       externalIDs,
+			},
+		); err != nil {
+			return errs.New(err)
+		}
+
+		if len(createPositionsParams) == 0 {
+			return nil
+		}
+
+		if err := uc.ipr.CreateInvestmentPositions(
+			ctx,
+			createPositionsParams,
+		); err != nil {
+			return errs.New(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
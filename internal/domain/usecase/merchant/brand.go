@@ -0,0 +1,81 @@
+package merchant
+
+import (
+	"strings"
+)
+
+type brand struct {
+	slug               string
+	name               string
+	domain             string
+	categoryExternalID string
+	// aliases are the folded names of the brand with no spaces, matched
+	// against the first words of the cleaned description.
+	aliases []string
+}
+
+// brands are the known merchants, with more specific names first, so
+// "Amazon Prime" is not matched as "Amazon".
+var brands = []brand{
+	{slug: "ifood", name: "iFood", domain: "ifood.com.br", categoryExternalID: "11020000", aliases: []string{"ifood"}},
+	{slug: "rappi", name: "Rappi", domain: "rappi.com.br", categoryExternalID: "11020000", aliases: []string{"rappi"}},
+	{slug: "uber", name: "Uber", domain: "uber.com", categoryExternalID: "19010000", aliases: []string{"uber", "helpuber", "ubertrip"}},
+	{slug: "99", name: "99", domain: "99app.com", categoryExternalID: "19010000", aliases: []string{"99app", "99pop", "99taxi", "99tecnologia"}},
+	{slug: "netflix", name: "Netflix", domain: "netflix.com", categoryExternalID: "09020000", aliases: []string{"netflix"}},
+	{slug: "spotify", name: "Spotify", domain: "spotify.com", categoryExternalID: "09030000", aliases: []string{"spotify"}},
+	{slug: "disney-plus", name: "Disney+", domain: "disneyplus.com", categoryExternalID: "09020000", aliases: []string{"disneyplus", "disney"}},
+	{slug: "max", name: "Max", domain: "max.com", categoryExternalID: "09020000", aliases: []string{"hbomax", "maxcom"}},
+	{slug: "amazon-prime", name: "Amazon Prime", domain: "primevideo.com", categoryExternalID: "09020000", aliases: []string{"amazonprime", "primevideo"}},
+	{slug: "amazon", name: "Amazon", domain: "amazon.com.br", categoryExternalID: "08010000", aliases: []string{"amazon", "amzn", "amazonmktplc"}},
+	{slug: "mercado-livre", name: "Mercado Livre", domain: "mercadolivre.com.br", categoryExternalID: "08010000", aliases: []string{"mercadolivre", "mercadolibre"}},
+	{slug: "shopee", name: "Shopee", domain: "shopee.com.br", categoryExternalID: "08010000", aliases: []string{"shopee"}},
+	{slug: "aliexpress", name: "AliExpress", domain: "aliexpress.com", categoryExternalID: "08010000", aliases: []string{"aliexpress"}},
+	{slug: "magalu", name: "Magalu", domain: "magazineluiza.com.br", categoryExternalID: "08000000", aliases: []string{"magalu", "magazineluiza"}},
+	{slug: "google", name: "Google", domain: "google.com", categoryExternalID: "09000000", aliases: []string{"google"}},
+	{slug: "apple", name: "Apple", domain: "apple.com", categoryExternalID: "09000000", aliases: []string{"apple", "applecombill"}},
+	{slug: "microsoft", name: "Microsoft", domain: "microsoft.com", categoryExternalID: "09000000", aliases: []string{"microsoft"}},
+	{slug: "openai", name: "OpenAI", domain: "openai.com", categoryExternalID: "09000000", aliases: []string{"openai", "chatgpt"}},
+	{slug: "steam", name: "Steam", domain: "steampowered.com", categoryExternalID: "09010000", aliases: []string{"steam", "steampowered", "steamgames"}},
+	{slug: "airbnb", name: "Airbnb", domain: "airbnb.com.br", categoryExternalID: "12020000", aliases: []string{"airbnb"}},
+	{slug: "latam", name: "LATAM", domain: "latamairlines.com", categoryExternalID: "12010000", aliases: []string{"latam", "latamair"}},
+	{slug: "drogasil", name: "Drogasil", domain: "drogasil.com.br", categoryExternalID: "18020000", aliases: []string{"drogasil"}},
+	{slug: "droga-raia", name: "Droga Raia", domain: "drogaraia.com.br", categoryExternalID: "18020000", aliases: []string{"drogaraia"}},
+	{slug: "carrefour", name: "Carrefour", domain: "carrefour.com.br", categoryExternalID: "10000000", aliases: []string{"carrefour"}},
+	{slug: "mcdonalds", name: "McDonald's", domain: "mcdonalds.com.br", categoryExternalID: "11010000", aliases: []string{"mcdonalds", "mcdonald"}},
+	{slug: "burger-king", name: "Burger King", domain: "burgerking.com.br", categoryExternalID: "11010000", aliases: []string{"burgerking"}},
+	{slug: "starbucks", name: "Starbucks", domain: "starbucks.com.br", categoryExternalID: "11010000", aliases: []string{"starbucks"}},
+	{slug: "smart-fit", name: "Smart Fit", domain: "smartfit.com.br", categoryExternalID: "07030000", aliases: []string{"smartfit"}},
+	{slug: "renner", name: "Renner", domain: "lojasrenner.com.br", categoryExternalID: "08040000", aliases: []string{"renner", "lojasrenner"}},
+	{slug: "kalunga", name: "Kalunga", domain: "kalunga.com.br", categoryExternalID: "08080000", aliases: []string{"kalunga"}},
+	{slug: "claro", name: "Claro", domain: "claro.com.br", categoryExternalID: "07010000", aliases: []string{"claro"}},
+	{slug: "vivo", name: "Vivo", domain: "vivo.com.br", categoryExternalID: "07010000", aliases: []string{"vivo", "telefonica"}},
+	{slug: "cemig", name: "Cemig", domain: "cemig.com.br", categoryExternalID: "17020000", aliases: []string{"cemig"}},
+	{slug: "shell", name: "Shell", domain: "shell.com.br", categoryExternalID: "19050000", aliases: []string{"shell"}},
+	{slug: "ipiranga", name: "Ipiranga", domain: "ipiranga.com.br", categoryExternalID: "19050000", aliases: []string{"ipiranga", "postoipiranga"}},
+}
+
+// matchBrand finds the brand whose alias equals the first words of the
+// name joined, so "MC DONALDS" and "McDonalds" both match McDonald's.
+func matchBrand(words []string) (brand, bool) {
+	prefixes := make([]string, 0, len(words))
+	joined := ""
+	for _, word := range foldWords(words) {
+		joined += onlyLettersAndDigits(word)
+		prefixes = append(prefixes, joined)
+	}
+
+	for _, b := range brands {
+		for _, alias := range b.aliases {
+			for _, prefix := range prefixes {
+				if prefix == alias {
+					return b, true
+				}
+				if len(prefix) >= len(alias) || !strings.HasPrefix(alias, prefix) {
+					break
+				}
+			}
+		}
+	}
+
+	return brand{}, false
+}
@@ -0,0 +1,138 @@
+package merchant
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListMerchantsUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+	mr repo.MerchantRepo
+}
+
+func NewListMerchantsUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+	mr repo.MerchantRepo,
+) *ListMerchantsUseCase {
+	return &ListMerchantsUseCase{
+		v:  v,
+		tr: tr,
+		mr: mr,
+	}
+}
+
+type ListMerchantsUseCaseInput struct {
+	repo.TransactionOptions
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+type ListMerchantsUseCaseItem struct {
+	entity.Merchant
+	Income  int64 `json:"income"`
+	Expense int64 `json:"expense"`
+}
+
+type ListMerchantsUseCaseOutput struct {
+	Items []ListMerchantsUseCaseItem `json:"items"`
+}
+
+// Execute lists the merchants of the user transactions matching the options,
+// with the income and expense of each one, biggest expenses first. Transfers
+// between the user accounts are left out.
+func (uc *ListMerchantsUseCase) Execute(
+	ctx context.Context,
+	in ListMerchantsUseCaseInput,
+) (*ListMerchantsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	in.IsTransfer = ptr.New(false)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	var incomesByMerchantID, expensesByMerchantID map[uuid.UUID]int64
+
+	g.Go(func() (err error) {
+		opts := in.TransactionOptions
+		opts.IsIncome = true
+
+		incomesByMerchantID, err = uc.tr.SumTransactionsByMerchant(
+			gCtx,
+			in.UserID,
+			opts,
+		)
+		return err
+	})
+
+	g.Go(func() (err error) {
+		opts := in.TransactionOptions
+		opts.IsExpense = true
+
+		expensesByMerchantID, err = uc.tr.SumTransactionsByMerchant(
+			gCtx,
+			in.UserID,
+			opts,
+		)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	ids := make(
+		[]uuid.UUID,
+		0,
+		len(incomesByMerchantID)+len(expensesByMerchantID),
+	)
+	for id := range incomesByMerchantID {
+		ids = append(ids, id)
+	}
+	for id := range expensesByMerchantID {
+		if _, ok := incomesByMerchantID[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	out := &ListMerchantsUseCaseOutput{
+		Items: []ListMerchantsUseCaseItem{},
+	}
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	merchants, err := uc.mr.ListMerchantsByIDs(ctx, ids)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	for _, m := range merchants {
+		out.Items = append(out.Items, ListMerchantsUseCaseItem{
+			Merchant: m,
+			Income:   incomesByMerchantID[m.ID],
+			Expense:  expensesByMerchantID[m.ID],
+		})
+	}
+
+	slices.SortFunc(out.Items, func(a, b ListMerchantsUseCaseItem) int {
+		return cmp.Or(
+			cmp.Compare(a.Expense, b.Expense),
+			cmp.Compare(b.Income, a.Income),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return out, nil
+}
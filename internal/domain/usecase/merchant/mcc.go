@@ -0,0 +1,103 @@
+package merchant
+
+// categoryExternalIDsByMCC maps the merchant category codes of card
+// purchases to the open finance categories.
+var categoryExternalIDsByMCC = map[int32]string{
+	4111: "19020000", // Commuter transport
+	4112: "19020000", // Passenger railways
+	4121: "19010000", // Taxicabs and limousines
+	4131: "12040000", // Bus lines
+	4511: "12010000", // Airlines
+	4722: "12000000", // Travel agencies
+	4784: "19050000", // Tolls
+	4812: "07010000", // Telecommunication equipment
+	4814: "07010000", // Telecommunication services
+	4899: "07010000", // Cable and pay television
+	4900: "17020000", // Utilities
+	5045: "08020000", // Computers and software
+	5094: "08000000", // Jewelry
+	5192: "08060000", // Books and newspapers
+	5200: "17030000", // Home supply warehouses
+	5251: "17030000", // Hardware stores
+	5300: "10000000", // Wholesale clubs
+	5310: "08000000", // Discount stores
+	5311: "08000000", // Department stores
+	5331: "08000000", // Variety stores
+	5399: "08000000", // General merchandise
+	5411: "10000000", // Grocery stores and supermarkets
+	5422: "10000000", // Meat provisioners
+	5441: "10000000", // Candy stores
+	5451: "10000000", // Dairy stores
+	5462: "11010000", // Bakeries
+	5499: "10000000", // Convenience stores
+	5541: "19050000", // Service stations
+	5542: "19050000", // Automated fuel dispensers
+	5611: "08040000", // Men's clothing
+	5621: "08040000", // Women's clothing
+	5641: "08050000", // Children's wear
+	5651: "08040000", // Family clothing
+	5661: "08040000", // Shoe stores
+	5691: "08040000", // Clothing stores
+	5712: "17030000", // Furniture
+	5732: "08020000", // Electronics stores
+	5734: "08020000", // Computer software stores
+	5812: "11010000", // Restaurants
+	5813: "11010000", // Bars
+	5814: "11010000", // Fast food
+	5912: "18020000", // Drug stores and pharmacies
+	5941: "08070000", // Sporting goods
+	5942: "08060000", // Book stores
+	5943: "08080000", // Stationery stores
+	5945: "08050000", // Toy stores
+	5977: "08000000", // Cosmetic stores
+	5995: "08030000", // Pet shops
+	5999: "08000000", // Miscellaneous retail
+	7011: "12020000", // Hotels
+	7230: "07030000", // Beauty shops
+	7298: "07030000", // Spas
+	7372: "09000000", // Computer programming
+	7523: "19050000", // Parking lots
+	7538: "19050000", // Automotive service shops
+	7832: "07040000", // Movie theaters
+	7922: "07040000", // Theatrical producers
+	7941: "07040000", // Sports clubs
+	7991: "07040000", // Tourist attractions
+	7997: "07030000", // Membership clubs
+	8011: "18000000", // Doctors
+	8021: "18010000", // Dentists
+	8042: "18030000", // Optometrists
+	8062: "18040000", // Hospitals
+	8071: "18040000", // Medical laboratories
+	8099: "18000000", // Medical services
+	8211: "07020000", // Schools
+	8220: "07020000", // Colleges and universities
+	8299: "07020000", // Schools and educational services
+	8398: "13000000", // Charitable organizations
+	9311: "15000000", // Tax payments
+}
+
+type mccRange struct {
+	min, max           int32
+	categoryExternalID string
+}
+
+// mccRanges are the codes given to each airline, car rental and hotel.
+var mccRanges = []mccRange{
+	{min: 3000, max: 3350, categoryExternalID: "12010000"},
+	{min: 3351, max: 3500, categoryExternalID: "19030000"},
+	{min: 3501, max: 3999, categoryExternalID: "12020000"},
+}
+
+func categoryExternalIDByMCC(mcc int32) string {
+	if categoryExternalID, ok := categoryExternalIDsByMCC[mcc]; ok {
+		return categoryExternalID
+	}
+
+	for _, r := range mccRanges {
+		if mcc >= r.min && mcc <= r.max {
+			return r.categoryExternalID
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,400 @@
+package merchant
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+// Input is the raw data of a transaction its merchant is normalised from.
+type Input struct {
+	Name                 string
+	RawName              string
+	CounterpartyName     *string
+	CounterpartyDocument *string
+	MCC                  *int32
+}
+
+// Merchant is the canonical merchant of a transaction. Transactions with the
+// same key belong to the same merchant.
+type Merchant struct {
+	Key      string
+	Name     string
+	Document *string
+	MCC      *int32
+	Logo     *string
+	// CategoryExternalID is the open finance category of the MCC or of the
+	// brand, empty when neither is known.
+	CategoryExternalID string
+}
+
+const (
+	cnpjLength     = 14
+	cnpjRootLength = 8
+)
+
+// purchasePrefixes are the words banks add before the merchant name of
+// purchases and payments. They are compared folded and longest first.
+var purchasePrefixes = []string{
+	"compra no debito",
+	"compra no credito",
+	"compra cartao",
+	"compra",
+	"pagamento efetuado",
+	"pagamento de boleto",
+	"pagamento de",
+	"pagamento",
+	"debito automatico",
+	"deb aut",
+}
+
+// transferPrefixes are the words banks add before the counterparty name of
+// transfers, which are not a sign of a purchase, since most are to people.
+var transferPrefixes = []string{
+	"pix - enviado",
+	"pix - recebido",
+	"pix enviado",
+	"pix recebido",
+	"pix",
+	"ted",
+	"doc",
+}
+
+// acquirerPrefixes are the payment processors that prefix the merchant
+// name, separated by an asterisk, like "PAG*JoseDaSilva".
+var acquirerPrefixes = []string{
+	"pag",
+	"pg",
+	"pagseguro",
+	"mp",
+	"mercadopago",
+	"ton",
+	"sumup",
+	"stone",
+	"cielo",
+	"ec",
+	"pp",
+	"paypal",
+	"dl",
+	"ebn",
+	"iz",
+	"zp",
+	"sq",
+}
+
+// legalSuffixes are the company types dropped from the end of names.
+var legalSuffixes = []string{
+	"sa",
+	"ltda",
+	"me",
+	"mei",
+	"epp",
+	"eireli",
+}
+
+// ignoredNames are the names left by bank operations, which have no merchant.
+var ignoredNames = []string{
+	"fatura",
+	"fatura cartao",
+	"boleto",
+	"enviado",
+	"recebido",
+}
+
+// connectors are kept lowercase in display names.
+var connectors = []string{
+	"de",
+	"da",
+	"do",
+	"das",
+	"dos",
+	"e",
+}
+
+// countries and cities are dropped from the end of card descriptors, like
+// "IFOOD *IFOOD SAO PAULO BR".
+var countries = []string{"br", "bra"}
+
+var cities = [][]string{
+	{"sao", "paulo"},
+	{"rio", "de", "janeiro"},
+	{"belo", "horizonte"},
+	{"porto", "alegre"},
+	{"curitiba"},
+	{"brasilia"},
+	{"salvador"},
+	{"recife"},
+	{"fortaleza"},
+	{"osasco"},
+	{"barueri"},
+	{"campinas"},
+	{"contagem"},
+	{"florianopolis"},
+	{"goiania"},
+	{"sp"},
+	{"rj"},
+	{"mg"},
+}
+
+var domainSuffixes = []string{".com.br", ".com", ".net", ".br"}
+
+var (
+	installmentRegex = regexp.MustCompile(`\s+(parc\s*)?\d{1,2}/\d{1,2}\s*$`)
+	dateTimeRegex    = regexp.MustCompile(
+		`\b\d{1,2}/\d{1,2}(/\d{2,4})?\b|\b\d{1,2}:\d{2}\b|\b\d{1,2}h\d{2}(min)?\b`,
+	)
+)
+
+var foldReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// Normalize turns the raw data of a transaction into its merchant. It is
+// identified by the known brand it matches, else by the CNPJ root of the
+// counterparty, else by the cleaned description. Descriptions with no sign
+// of a purchase or a payment, like bank operations, have no merchant.
+func Normalize(in Input) (Merchant, bool) {
+	description := cmp.Or(
+		strings.TrimSpace(in.Name),
+		strings.TrimSpace(in.RawName),
+	)
+	words, isPurchase := cleanDescription(description)
+
+	var document *string
+	if in.CounterpartyDocument != nil {
+		if d := onlyDigits(*in.CounterpartyDocument); len(d) == cnpjLength {
+			document = &d
+		}
+	}
+
+	if document != nil && in.CounterpartyName != nil {
+		if counterpartyWords := cleanWords(
+			*in.CounterpartyName,
+		); len(counterpartyWords) > 0 {
+			words = counterpartyWords
+		}
+	}
+
+	m := Merchant{
+		Document: document,
+		MCC:      in.MCC,
+	}
+	if in.MCC != nil {
+		m.CategoryExternalID = categoryExternalIDByMCC(*in.MCC)
+	}
+
+	if b, ok := matchBrand(words); ok {
+		m.Key = "brand:" + b.slug
+		m.Name = b.name
+		m.Logo = ptr.New("https://" + b.domain + "/favicon.ico")
+		m.CategoryExternalID = cmp.Or(m.CategoryExternalID, b.categoryExternalID)
+		return m, true
+	}
+
+	if len(words) == 0 ||
+		slices.Contains(ignoredNames, strings.Join(foldWords(words), " ")) {
+		return Merchant{}, false
+	}
+
+	switch {
+	case document != nil:
+		m.Key = "cnpj:" + (*document)[:cnpjRootLength]
+	case isPurchase || in.MCC != nil:
+		m.Key = "name:" + strings.Join(foldWords(words), " ")
+	default:
+		return Merchant{}, false
+	}
+
+	m.Name = titleCase(words)
+
+	return m, true
+}
+
+// cleanDescription drops the operation, acquirer, dates, installments and
+// location banks add around the merchant name of a description. It reports
+// whether any of them showed the description is a purchase or a payment.
+func cleanDescription(description string) ([]string, bool) {
+	// Some banks send the operation and the counterparty split by a pipe,
+	// like "Transferência enviada|John Doe".
+	if i := strings.LastIndex(description, "|"); i >= 0 {
+		description = description[i+1:]
+	}
+
+	var isPurchase bool
+
+	description, isPurchase = cutPrefix(description, purchasePrefixes)
+	if !isPurchase {
+		description, _ = cutPrefix(description, transferPrefixes)
+	}
+
+	description = installmentRegex.ReplaceAllString(description, "")
+	description = dateTimeRegex.ReplaceAllString(description, " ")
+
+	parts := slices.DeleteFunc(
+		strings.Split(description, "*"),
+		func(part string) bool {
+			return strings.TrimSpace(part) == ""
+		},
+	)
+	if len(parts) > 1 {
+		isPurchase = true
+		if slices.Contains(acquirerPrefixes, fold(strings.TrimSpace(parts[0]))) {
+			parts = parts[1:]
+		}
+	}
+	if len(parts) == 0 {
+		return nil, isPurchase
+	}
+
+	words := trimLocation(cleanWords(parts[0]))
+
+	return words, isPurchase
+}
+
+// cutPrefix drops the first of the folded prefixes the description starts
+// with as whole words.
+func cutPrefix(description string, prefixes []string) (string, bool) {
+	folded := fold(description)
+	for _, prefix := range prefixes {
+		rest, ok := strings.CutPrefix(folded, prefix)
+		if !ok || (rest != "" && unicode.IsLetter([]rune(rest)[0])) {
+			continue
+		}
+		return string([]rune(description)[len([]rune(prefix)):]), true
+	}
+	return description, false
+}
+
+// cleanWords splits the name into words, dropping domains, numbers,
+// punctuation and legal suffixes. Camel cased words are split, so
+// "JoseDaSilva" becomes "Jose Da Silva".
+func cleanWords(name string) []string {
+	fields := strings.Fields(name)
+	for len(fields) > 1 && slices.Contains(
+		legalSuffixes,
+		onlyLetters(fold(fields[len(fields)-1])),
+	) {
+		fields = fields[:len(fields)-1]
+	}
+
+	words := []string{}
+	for _, field := range fields {
+		lowerField := strings.ToLower(field)
+		for _, suffix := range domainSuffixes {
+			if strings.HasSuffix(lowerField, suffix) {
+				field = field[:len(field)-len(suffix)]
+				break
+			}
+		}
+
+		for _, part := range strings.Split(field, ".") {
+			part = strings.TrimFunc(part, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '&'
+			})
+			if onlyLetters(part) == "" {
+				continue
+			}
+			words = append(words, splitCamelCase(part)...)
+		}
+	}
+
+	return words
+}
+
+func splitCamelCase(word string) []string {
+	runes := []rune(word)
+	if !slices.ContainsFunc(runes, unicode.IsLower) {
+		return []string{word}
+	}
+
+	words := []string{}
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+func trimLocation(words []string) []string {
+	folded := foldWords(words)
+
+	if len(folded) > 1 && slices.Contains(countries, folded[len(folded)-1]) {
+		words, folded = words[:len(words)-1], folded[:len(folded)-1]
+	}
+
+	for _, city := range cities {
+		if len(folded) > len(city) && slices.Equal(
+			folded[len(folded)-len(city):],
+			city,
+		) {
+			return words[:len(words)-len(city)]
+		}
+	}
+
+	return words
+}
+
+func titleCase(words []string) string {
+	titled := make([]string, 0, len(words))
+	for i, word := range words {
+		runes := []rune(strings.ToLower(word))
+		if i == 0 || !slices.Contains(connectors, fold(word)) {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		titled = append(titled, string(runes))
+	}
+	return strings.Join(titled, " ")
+}
+
+// fold lowercases the text and drops its accents, keeping its rune count.
+func fold(text string) string {
+	return foldReplacer.Replace(strings.ToLower(text))
+}
+
+func foldWords(words []string) []string {
+	folded := make([]string, 0, len(words))
+	for _, word := range words {
+		folded = append(folded, fold(word))
+	}
+	return folded
+}
+
+func onlyDigits(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+func onlyLetters(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+func onlyLettersAndDigits(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
@@ -0,0 +1,134 @@
+package merchant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description                string
+		in                         Input
+		expectedOK                 bool
+		expectedKey                string
+		expectedName               string
+		expectedLogo               bool
+		expectedCategoryExternalID string
+	}{
+		{
+			description:                "matches brand after acquirer and location",
+			in:                         Input{Name: "IFOOD *IFOOD SAO PAULO BR"},
+			expectedOK:                 true,
+			expectedKey:                "brand:ifood",
+			expectedName:               "iFood",
+			expectedLogo:               true,
+			expectedCategoryExternalID: "11020000",
+		},
+		{
+			description:  "drops acquirer and splits camel case",
+			in:           Input{Name: "PAG*JoseDaSilva"},
+			expectedOK:   true,
+			expectedKey:  "name:jose da silva",
+			expectedName: "Jose da Silva",
+		},
+		{
+			description: "ignores descriptions with no sign of purchase",
+			in:          Input{Name: "LOJAS AMERICANAS 1199 03/10"},
+			expectedOK:  false,
+		},
+		{
+			description:                "drops installments and numbers of card purchases",
+			in:                         Input{Name: "LOJAS AMERICANAS 1199 03/10", MCC: ptr.New(int32(5311))},
+			expectedOK:                 true,
+			expectedKey:                "name:lojas americanas",
+			expectedName:               "Lojas Americanas",
+			expectedCategoryExternalID: "08000000",
+		},
+		{
+			description:  "drops purchase prefix and dates",
+			in:           Input{Name: "Compra no débito 12/03 Padaria São João"},
+			expectedOK:   true,
+			expectedKey:  "name:padaria sao joao",
+			expectedName: "Padaria São João",
+		},
+		{
+			description: "identifies company counterparty by CNPJ root",
+			in: Input{
+				Name:                 "PIX - ENVIADO 08/12 17:15 JAM JUNIOR",
+				CounterpartyName:     ptr.New("JAM JUNIOR SERVICOS LTDA"),
+				CounterpartyDocument: ptr.New("12.345.678/0001-90"),
+			},
+			expectedOK:   true,
+			expectedKey:  "cnpj:12345678",
+			expectedName: "Jam Junior Servicos",
+		},
+		{
+			description:                "matches brand from counterparty name",
+			in:                         Input{Name: "Pagamento de boleto", CounterpartyName: ptr.New("CEMIG DISTRIBUICAO S.A."), CounterpartyDocument: ptr.New("06981180000116")},
+			expectedOK:                 true,
+			expectedKey:                "brand:cemig",
+			expectedName:               "Cemig",
+			expectedLogo:               true,
+			expectedCategoryExternalID: "17020000",
+		},
+		{
+			description:                "matches brand with spaces and domain",
+			in:                         Input{Name: "MC DONALDS.COM.BR"},
+			expectedOK:                 true,
+			expectedKey:                "brand:mcdonalds",
+			expectedName:               "McDonald's",
+			expectedLogo:               true,
+			expectedCategoryExternalID: "11010000",
+		},
+		{
+			description:                "prefers MCC category over brand category",
+			in:                         Input{Name: "AMAZON MARKETPLACE", MCC: ptr.New(int32(5942))},
+			expectedOK:                 true,
+			expectedKey:                "brand:amazon",
+			expectedName:               "Amazon",
+			expectedLogo:               true,
+			expectedCategoryExternalID: "08060000",
+		},
+		{
+			description: "ignores transfers to people",
+			in:          Input{Name: "Transferência enviada|John Doe"},
+			expectedOK:  false,
+		},
+		{
+			description: "ignores bill payments",
+			in:          Input{Name: "Pagamento de fatura"},
+			expectedOK:  false,
+		},
+		{
+			description:                "falls back to raw name",
+			in:                         Input{RawName: "NETFLIX.COM"},
+			expectedOK:                 true,
+			expectedKey:                "brand:netflix",
+			expectedName:               "Netflix",
+			expectedLogo:               true,
+			expectedCategoryExternalID: "09020000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			m, ok := Normalize(tt.in)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedKey, m.Key)
+			assert.Equal(t, tt.expectedName, m.Name)
+			assert.Equal(t, tt.expectedLogo, m.Logo != nil)
+			assert.Equal(
+				t,
+				tt.expectedCategoryExternalID,
+				m.CategoryExternalID,
+			)
+		})
+	}
+}
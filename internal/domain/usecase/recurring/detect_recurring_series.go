@@ -0,0 +1,105 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// historyMonths is how far back the transactions are scanned, enough for
+// yearly series to show up twice.
+const historyMonths = 25
+
+type DetectRecurringSeriesUseCase struct {
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	rsr repo.RecurringSeriesRepo
+}
+
+func NewDetectRecurringSeriesUseCase(
+	tx tx.TX,
+	tr repo.TransactionRepo,
+	rsr repo.RecurringSeriesRepo,
+) *DetectRecurringSeriesUseCase {
+	return &DetectRecurringSeriesUseCase{
+		tx:  tx,
+		tr:  tr,
+		rsr: rsr,
+	}
+}
+
+type DetectRecurringSeriesUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute scans the user transaction history and replaces the user
+// recurring series with the detected ones. Series keep their id while they
+// are still detected.
+func (uc *DetectRecurringSeriesUseCase) Execute(
+	ctx context.Context,
+	in DetectRecurringSeriesUseCaseInput,
+) error {
+	now := time.Now()
+
+	transactions, err := uc.tr.ListTransactions(
+		ctx,
+		in.UserID,
+		repo.TransactionOptions{
+			StartDate: now.AddDate(0, -historyMonths, 0),
+			EndDate:   now,
+			IsIgnored: ptr.New(false),
+		},
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	detected := DetectSeries(transactions, now)
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		ids := make([]uuid.UUID, 0, len(detected))
+		for _, s := range detected {
+			series, err := uc.rsr.UpsertRecurringSeries(
+				ctx,
+				repo.UpsertRecurringSeriesParams{
+					Name:            s.Name,
+					NormalizedName:  s.NormalizedName,
+					Frequency:       s.Frequency,
+					Amount:          s.Amount,
+					PreviousAmount:  s.PreviousAmount,
+					AverageAmount:   s.AverageAmount,
+					Occurrences:     int32(s.Occurrences),
+					FirstDate:       s.FirstDate,
+					LastDate:        s.LastDate,
+					NextDate:        s.NextDate,
+					UserID:          in.UserID,
+					PaymentMethodID: s.PaymentMethodID,
+					CategoryID:      s.CategoryID,
+				},
+			)
+			if err != nil {
+				return errs.New(err)
+			}
+			ids = append(ids, series.ID)
+		}
+
+		return uc.rsr.DeleteRecurringSeriesExcept(
+			ctx,
+			repo.DeleteRecurringSeriesExceptParams{
+				UserID: in.UserID,
+				Ids:    ids,
+			},
+		)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
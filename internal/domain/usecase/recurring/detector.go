@@ -0,0 +1,374 @@
+package recurring
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
+)
+
+// Series is a recurring series detected from the transactions of a user.
+type Series struct {
+	Name            string
+	NormalizedName  string
+	Frequency       entity.RecurringFrequency
+	Amount          int64
+	PreviousAmount  *int64
+	AverageAmount   int64
+	Occurrences     int
+	FirstDate       time.Time
+	LastDate        time.Time
+	NextDate        time.Time
+	PaymentMethodID uuid.UUID
+	CategoryID      uuid.UUID
+}
+
+type frequencyRule struct {
+	frequency entity.RecurringFrequency
+	// minDays and maxDays are the accepted interval between occurrences.
+	minDays int
+	maxDays int
+	// minOccurrences is the number of occurrences needed to trust the series.
+	minOccurrences int
+	// graceDays is how late an occurrence can be before it is missed.
+	graceDays int
+}
+
+var frequencyRules = []frequencyRule{
+	{
+		frequency:      entity.RecurringFrequencyWeekly,
+		minDays:        5,
+		maxDays:        9,
+		minOccurrences: 4,
+		graceDays:      3,
+	},
+	{
+		frequency:      entity.RecurringFrequencyMonthly,
+		minDays:        25,
+		maxDays:        35,
+		minOccurrences: 3,
+		graceDays:      7,
+	},
+	{
+		frequency:      entity.RecurringFrequencyYearly,
+		minDays:        350,
+		maxDays:        380,
+		minOccurrences: 2,
+		graceDays:      15,
+	},
+}
+
+const (
+	// amountTolerance is how much an occurrence amount can differ from the
+	// median amount of the series, so price changes are still matched.
+	amountTolerance = 0.25
+	// minRegularIntervals is the share of intervals that must match the
+	// frequency, allowing a few skipped or extra occurrences.
+	minRegularIntervals = 0.75
+	// maxMissedPeriods is how many periods a series can go without
+	// occurrences before it is considered finished.
+	maxMissedPeriods = 2
+)
+
+type seriesKey struct {
+	normalizedName  string
+	paymentMethodID uuid.UUID
+}
+
+// DetectSeries groups the transactions by normalised name and payment method
+// and returns the groups that repeat weekly, monthly or yearly with similar
+// amounts. Ignored transactions and installments are skipped, installments
+// are already tracked as credit card commitments.
+func DetectSeries(
+	transactions []entity.Transaction,
+	now time.Time,
+) []Series {
+	groups := make(map[seriesKey][]entity.Transaction)
+	for _, t := range transactions {
+		if t.IsIgnored || t.Amount == 0 {
+			continue
+		}
+		if t.TotalInstallments != nil && *t.TotalInstallments > 1 {
+			continue
+		}
+
+		normalizedName := NormalizeName(t.Name)
+		if normalizedName == "" {
+			continue
+		}
+
+		key := seriesKey{
+			normalizedName:  normalizedName,
+			paymentMethodID: t.PaymentMethodID,
+		}
+		groups[key] = append(groups[key], t)
+	}
+
+	series := []Series{}
+	for key, group := range groups {
+		s, ok := detectGroupSeries(group, now)
+		if !ok {
+			continue
+		}
+		s.NormalizedName = key.normalizedName
+		s.PaymentMethodID = key.paymentMethodID
+		series = append(series, s)
+	}
+
+	slices.SortFunc(series, func(a, b Series) int {
+		return cmp.Or(
+			a.NextDate.Compare(b.NextDate),
+			cmp.Compare(a.NormalizedName, b.NormalizedName),
+		)
+	})
+
+	return series
+}
+
+func detectGroupSeries(
+	transactions []entity.Transaction,
+	now time.Time,
+) (Series, bool) {
+	transactions = filterDominantSign(transactions)
+	transactions = filterSimilarAmounts(transactions)
+	transactions = uniqueByDay(transactions)
+
+	if len(transactions) < 2 {
+		return Series{}, false
+	}
+
+	intervals := make([]int, 0, len(transactions)-1)
+	for i := 1; i < len(transactions); i++ {
+		intervals = append(
+			intervals,
+			daysBetween(transactions[i-1].Date, transactions[i].Date),
+		)
+	}
+
+	rule, ok := matchFrequencyRule(intervals)
+	if !ok || len(transactions) < rule.minOccurrences {
+		return Series{}, false
+	}
+
+	last := transactions[len(transactions)-1]
+	nextDate := addPeriod(last.Date, rule.frequency)
+
+	maxMissedDays := rule.maxDays*maxMissedPeriods + rule.graceDays
+	if daysBetween(nextDate, now) > maxMissedDays {
+		return Series{}, false
+	}
+
+	var total int64
+	for _, t := range transactions {
+		total += t.Amount
+	}
+
+	previousAmount := transactions[len(transactions)-2].Amount
+
+	return Series{
+		Name:           last.Name,
+		Frequency:      rule.frequency,
+		Amount:         last.Amount,
+		PreviousAmount: &previousAmount,
+		AverageAmount:  total / int64(len(transactions)),
+		Occurrences:    len(transactions),
+		FirstDate:      transactions[0].Date,
+		LastDate:       last.Date,
+		NextDate:       nextDate,
+		CategoryID:     last.CategoryID,
+	}, true
+}
+
+// matchFrequencyRule finds the frequency of the median interval and checks
+// most intervals agree with it.
+func matchFrequencyRule(intervals []int) (frequencyRule, bool) {
+	sorted := slices.Clone(intervals)
+	slices.Sort(sorted)
+	median := sorted[len(sorted)/2]
+
+	for _, rule := range frequencyRules {
+		if median < rule.minDays || median > rule.maxDays {
+			continue
+		}
+
+		regular := 0
+		for _, interval := range intervals {
+			if interval >= rule.minDays && interval <= rule.maxDays {
+				regular++
+			}
+		}
+
+		if float64(regular)/float64(len(intervals)) < minRegularIntervals {
+			return frequencyRule{}, false
+		}
+
+		return rule, true
+	}
+
+	return frequencyRule{}, false
+}
+
+// filterDominantSign keeps only the expenses or the incomes of the group,
+// whichever are the majority, so refunds do not break the series. The
+// result is sorted by date.
+func filterDominantSign(
+	transactions []entity.Transaction,
+) []entity.Transaction {
+	expenses := 0
+	for _, t := range transactions {
+		if t.Amount < 0 {
+			expenses++
+		}
+	}
+	isExpense := expenses*2 >= len(transactions)
+
+	filtered := make([]entity.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		if (t.Amount < 0) == isExpense {
+			filtered = append(filtered, t)
+		}
+	}
+
+	slices.SortFunc(filtered, func(a, b entity.Transaction) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return filtered
+}
+
+// filterSimilarAmounts drops the transactions too far from the median amount.
+func filterSimilarAmounts(
+	transactions []entity.Transaction,
+) []entity.Transaction {
+	if len(transactions) == 0 {
+		return transactions
+	}
+
+	amounts := make([]int64, 0, len(transactions))
+	for _, t := range transactions {
+		amounts = append(amounts, abs(t.Amount))
+	}
+	slices.Sort(amounts)
+	median := float64(amounts[len(amounts)/2])
+
+	filtered := make([]entity.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		diff := float64(abs(t.Amount)) - median
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff <= median*amountTolerance {
+			filtered = append(filtered, t)
+		}
+	}
+
+	return filtered
+}
+
+// uniqueByDay keeps the last transaction of each day,
+// transactions must be sorted by date.
+func uniqueByDay(transactions []entity.Transaction) []entity.Transaction {
+	unique := make([]entity.Transaction, 0, len(transactions))
+	for _, t := range transactions {
+		if n := len(unique); n > 0 && isSameDay(unique[n-1].Date, t.Date) {
+			unique[n-1] = t
+			continue
+		}
+		unique = append(unique, t)
+	}
+	return unique
+}
+
+// MissedOccurrences counts the expected occurrences of the series that are
+// past their grace period without a matching transaction.
+func MissedOccurrences(s entity.RecurringSeries, now time.Time) int {
+	idx := slices.IndexFunc(frequencyRules, func(r frequencyRule) bool {
+		return r.frequency == s.Frequency
+	})
+	if idx == -1 {
+		return 0
+	}
+	rule := frequencyRules[idx]
+
+	missed := 0
+	for next := s.NextDate; now.After(next.AddDate(0, 0, rule.graceDays)); {
+		missed++
+		next = addPeriod(next, s.Frequency)
+	}
+
+	return missed
+}
+
+// ExpectedDates lists the expected occurrences of the series between start
+// and end, inclusive.
+func ExpectedDates(
+	s entity.RecurringSeries,
+	start time.Time,
+	end time.Time,
+) []time.Time {
+	dates := []time.Time{}
+	for next := s.NextDate; !next.After(end); next = addPeriod(next, s.Frequency) {
+		if !next.Before(start) {
+			dates = append(dates, next)
+		}
+	}
+	return dates
+}
+
+// HasPriceIncrease reports whether the last occurrence of an expense series
+// cost more than the one before it.
+func HasPriceIncrease(s entity.RecurringSeries) bool {
+	return s.Amount < 0 && s.PreviousAmount != nil &&
+		s.Amount < *s.PreviousAmount
+}
+
+// NormalizeName lowercases the name and drops digits and punctuation, so
+// dates, ids and installment counters banks add to names are ignored.
+func NormalizeName(name string) string {
+	fields := strings.FieldsFunc(
+		strings.ToLower(name),
+		func(r rune) bool {
+			return !unicode.IsLetter(r)
+		},
+	)
+	return strings.Join(fields, " ")
+}
+
+func addPeriod(
+	date time.Time,
+	frequency entity.RecurringFrequency,
+) time.Time {
+	switch frequency {
+	case entity.RecurringFrequencyWeekly:
+		return date.AddDate(0, 0, 7)
+	case entity.RecurringFrequencyYearly:
+		return date.AddDate(1, 0, 0)
+	default:
+		// Keeps the day of the month, clamped to the last day of shorter months
+		nextMonth := dateutil.ToMonthStart(date).AddDate(0, 1, 0)
+		return dateutil.ToMonthDay(nextMonth, date.Day())
+	}
+}
+
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Round(24*time.Hour) / (24 * time.Hour))
+}
+
+func isSameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
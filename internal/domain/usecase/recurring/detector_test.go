@@ -0,0 +1,214 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestDetectSeries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 28, 12, 0, 0, 0, time.UTC)
+	paymentMethodID := uuid.New()
+	categoryID := uuid.New()
+
+	newTransaction := func(
+		name string,
+		amount int64,
+		date time.Time,
+	) entity.Transaction {
+		return entity.Transaction{
+			ID:              uuid.New(),
+			Name:            name,
+			Amount:          amount,
+			Date:            date,
+			PaymentMethodID: paymentMethodID,
+			CategoryID:      categoryID,
+		}
+	}
+
+	monthly := func(day int) time.Time {
+		return time.Date(2025, 1, day, 10, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		description           string
+		transactions          []entity.Transaction
+		expectedSeries        int
+		expectedFrequency     entity.RecurringFrequency
+		expectedAmount        int64
+		expectedNextDate      time.Time
+		expectedOccurrences   int
+		expectedNormalized    string
+		expectedPriceIncrease bool
+	}{
+		{
+			description: "detects monthly subscription with price increase",
+			transactions: []entity.Transaction{
+				newTransaction("NETFLIX.COM 01/25", -3990, monthly(5)),
+				newTransaction("NETFLIX.COM 02/25", -3990, monthly(5).AddDate(0, 1, 0)),
+				newTransaction("NETFLIX.COM 03/25", -3990, monthly(6).AddDate(0, 2, 0)),
+				newTransaction("NETFLIX.COM 04/25", -4490, monthly(5).AddDate(0, 3, 0)),
+			},
+			expectedSeries:        1,
+			expectedFrequency:     entity.RecurringFrequencyMonthly,
+			expectedAmount:        -4490,
+			expectedNextDate:      time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC),
+			expectedOccurrences:   4,
+			expectedNormalized:    "netflix com",
+			expectedPriceIncrease: true,
+		},
+		{
+			description: "detects weekly payments",
+			transactions: []entity.Transaction{
+				newTransaction("Personal trainer", -10000, now.AddDate(0, 0, -28)),
+				newTransaction("Personal trainer", -10000, now.AddDate(0, 0, -21)),
+				newTransaction("Personal trainer", -10000, now.AddDate(0, 0, -14)),
+				newTransaction("Personal trainer", -10000, now.AddDate(0, 0, -7)),
+			},
+			expectedSeries:      1,
+			expectedFrequency:   entity.RecurringFrequencyWeekly,
+			expectedAmount:      -10000,
+			expectedNextDate:    now,
+			expectedOccurrences: 4,
+			expectedNormalized:  "personal trainer",
+		},
+		{
+			description: "detects yearly payments",
+			transactions: []entity.Transaction{
+				newTransaction("IPVA", -150000, now.AddDate(-2, 0, -10)),
+				newTransaction("IPVA", -160000, now.AddDate(-1, 0, -10)),
+			},
+			expectedSeries:        1,
+			expectedFrequency:     entity.RecurringFrequencyYearly,
+			expectedAmount:        -160000,
+			expectedNextDate:      now.AddDate(0, 0, -10),
+			expectedOccurrences:   2,
+			expectedNormalized:    "ipva",
+			expectedPriceIncrease: true,
+		},
+		{
+			description: "ignores irregular purchases",
+			transactions: []entity.Transaction{
+				newTransaction("Padaria", -1500, now.AddDate(0, 0, -40)),
+				newTransaction("Padaria", -1600, now.AddDate(0, 0, -38)),
+				newTransaction("Padaria", -1400, now.AddDate(0, 0, -20)),
+				newTransaction("Padaria", -1500, now.AddDate(0, 0, -3)),
+			},
+			expectedSeries: 0,
+		},
+		{
+			description: "ignores finished series",
+			transactions: []entity.Transaction{
+				newTransaction("Academia", -9990, now.AddDate(0, -9, 0)),
+				newTransaction("Academia", -9990, now.AddDate(0, -8, 0)),
+				newTransaction("Academia", -9990, now.AddDate(0, -7, 0)),
+			},
+			expectedSeries: 0,
+		},
+		{
+			description: "ignores installments",
+			transactions: []entity.Transaction{
+				{
+					Name:              "Loja 1/3",
+					Amount:            -5000,
+					Date:              now.AddDate(0, -2, 0),
+					TotalInstallments: ptr.New(int32(3)),
+				},
+				{
+					Name:              "Loja 2/3",
+					Amount:            -5000,
+					Date:              now.AddDate(0, -1, 0),
+					TotalInstallments: ptr.New(int32(3)),
+				},
+				{
+					Name:              "Loja 3/3",
+					Amount:            -5000,
+					Date:              now,
+					TotalInstallments: ptr.New(int32(3)),
+				},
+			},
+			expectedSeries: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			series := DetectSeries(test.transactions, now)
+			if !assert.Len(t, series, test.expectedSeries) ||
+				test.expectedSeries == 0 {
+				return
+			}
+
+			s := series[0]
+			assert.Equal(t, test.expectedFrequency, s.Frequency)
+			assert.Equal(t, test.expectedAmount, s.Amount)
+			assert.True(
+				t,
+				test.expectedNextDate.Equal(s.NextDate),
+				"expected next date %s, got %s",
+				test.expectedNextDate,
+				s.NextDate,
+			)
+			assert.Equal(t, test.expectedOccurrences, s.Occurrences)
+			assert.Equal(t, test.expectedNormalized, s.NormalizedName)
+			assert.Equal(t, paymentMethodID, s.PaymentMethodID)
+			assert.Equal(
+				t,
+				test.expectedPriceIncrease,
+				HasPriceIncrease(entity.RecurringSeries{
+					Amount:         s.Amount,
+					PreviousAmount: s.PreviousAmount,
+				}),
+			)
+		})
+	}
+}
+
+func TestMissedOccurrences(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		nextDate    time.Time
+		expected    int
+	}{
+		{
+			description: "is not missed before the next date",
+			nextDate:    now.AddDate(0, 0, 3),
+			expected:    0,
+		},
+		{
+			description: "is not missed within the grace period",
+			nextDate:    now.AddDate(0, 0, -5),
+			expected:    0,
+		},
+		{
+			description: "counts missed occurrences",
+			nextDate:    now.AddDate(0, -1, -10),
+			expected:    2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			missed := MissedOccurrences(entity.RecurringSeries{
+				Frequency: entity.RecurringFrequencyMonthly,
+				NextDate:  test.nextDate,
+			}, now)
+			assert.Equal(t, test.expected, missed)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListRecurringSeriesUseCase struct {
+	rsr repo.RecurringSeriesRepo
+}
+
+func NewListRecurringSeriesUseCase(
+	rsr repo.RecurringSeriesRepo,
+) *ListRecurringSeriesUseCase {
+	return &ListRecurringSeriesUseCase{
+		rsr: rsr,
+	}
+}
+
+type ListRecurringSeriesUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListRecurringSeriesUseCaseItem struct {
+	entity.RecurringSeries
+	HasPriceIncrease  bool `json:"has_price_increase"`
+	MissedOccurrences int  `json:"missed_occurrences"`
+}
+
+type ListRecurringSeriesUseCaseOutput struct {
+	Items []ListRecurringSeriesUseCaseItem `json:"items"`
+}
+
+// Execute lists the user recurring series sorted by the next expected date,
+// flagging price increases and occurrences that did not happen.
+func (uc *ListRecurringSeriesUseCase) Execute(
+	ctx context.Context,
+	in ListRecurringSeriesUseCaseInput,
+) (*ListRecurringSeriesUseCaseOutput, error) {
+	series, err := uc.rsr.ListRecurringSeriesByUserID(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	now := time.Now()
+	out := ListRecurringSeriesUseCaseOutput{
+		Items: make([]ListRecurringSeriesUseCaseItem, 0, len(series)),
+	}
+	for _, s := range series {
+		out.Items = append(out.Items, ListRecurringSeriesUseCaseItem{
+			RecurringSeries:   s,
+			HasPriceIncrease:  HasPriceIncrease(s),
+			MissedOccurrences: MissedOccurrences(s, now),
+		})
+	}
+
+	return &out, nil
+}
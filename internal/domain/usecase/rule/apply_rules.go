@@ -0,0 +1,150 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ApplyRulesUseCase struct {
+	e  *env.Env
+	tx tx.TX
+	rr repo.RuleRepo
+	tr repo.TransactionRepo
+}
+
+func NewApplyRulesUseCase(
+	e *env.Env,
+	tx tx.TX,
+	rr repo.RuleRepo,
+	tr repo.TransactionRepo,
+) *ApplyRulesUseCase {
+	return &ApplyRulesUseCase{
+		e:  e,
+		tx: tx,
+		rr: rr,
+		tr: tr,
+	}
+}
+
+type ApplyRulesUseCaseInput struct {
+	UserID uuid.UUID `json:"-"`
+}
+
+type ApplyRulesUseCaseOutput struct {
+	TransactionsUpdated int `json:"transactions_updated"`
+}
+
+// Execute runs the rules of the user over all of their transactions. Fields
+// the user has edited by hand are kept, like on sync.
+func (uc *ApplyRulesUseCase) Execute(
+	ctx context.Context,
+	in ApplyRulesUseCaseInput,
+) (*ApplyRulesUseCaseOutput, error) {
+	g, gCtx := errgroup.WithContext(ctx)
+
+	var (
+		rules        []entity.Rule
+		transactions []entity.Transaction
+	)
+
+	g.Go(func() error {
+		var err error
+		rules, err = uc.rr.ListRules(gCtx, repo.RuleOptions{UserID: in.UserID})
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		transactions, err = uc.tr.ListTransactions(gCtx, in.UserID)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if len(rules) == 0 {
+		return &ApplyRulesUseCaseOutput{}, nil
+	}
+
+	engine := NewEngine(rules, uc.e.MaxLevenshteinDistancePercentage)
+
+	var params []repo.ApplyTransactionRulesParams
+	transactionIDsByTagID := map[uuid.UUID][]uuid.UUID{}
+	for _, t := range transactions {
+		target := Target{
+			Name:            t.Name,
+			Amount:          t.Amount,
+			PaymentMethodID: t.PaymentMethodID,
+			InstitutionID:   t.InstitutionID,
+			CategoryID:      t.CategoryID,
+			IsIgnored:       t.IsIgnored,
+		}
+		if !engine.Apply(&target) {
+			continue
+		}
+
+		// Tags are only ever added, so they never clash with manual edits.
+		if target.TagID != nil {
+			transactionIDsByTagID[*target.TagID] = append(
+				transactionIDsByTagID[*target.TagID],
+				t.ID,
+			)
+		}
+
+		if t.IsNameOverridden {
+			target.Name = t.Name
+		}
+		if t.IsCategoryOverridden {
+			target.CategoryID = t.CategoryID
+			target.IsIgnored = t.IsIgnored
+		}
+
+		if target.Name == t.Name &&
+			target.CategoryID == t.CategoryID &&
+			target.IsIgnored == t.IsIgnored {
+			continue
+		}
+
+		params = append(params, repo.ApplyTransactionRulesParams{
+			ID:         t.ID,
+			Name:       target.Name,
+			CategoryID: target.CategoryID,
+			IsIgnored:  target.IsIgnored,
+		})
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		for _, p := range params {
+			if err := uc.tr.ApplyTransactionRules(ctx, p); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		for tagID, transactionIDs := range transactionIDsByTagID {
+			if err := uc.tr.TagTransactions(ctx, repo.TagTransactionsParams{
+				TransactionIds: transactionIDs,
+				TagID:          tagID,
+			}); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &ApplyRulesUseCaseOutput{
+		TransactionsUpdated: len(params),
+	}, nil
+}
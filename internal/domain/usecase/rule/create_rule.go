@@ -0,0 +1,74 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type CreateRuleUseCase struct {
+	v   *validator.Validator
+	rr  repo.RuleRepo
+	tcr repo.TransactionCategoryRepo
+	pmr repo.PaymentMethodRepo
+	ir  repo.InstitutionRepo
+	tgr repo.TagRepo
+}
+
+func NewCreateRuleUseCase(
+	v *validator.Validator,
+	rr repo.RuleRepo,
+	tcr repo.TransactionCategoryRepo,
+	pmr repo.PaymentMethodRepo,
+	ir repo.InstitutionRepo,
+	tgr repo.TagRepo,
+) *CreateRuleUseCase {
+	return &CreateRuleUseCase{
+		v:   v,
+		rr:  rr,
+		tcr: tcr,
+		pmr: pmr,
+		ir:  ir,
+		tgr: tgr,
+	}
+}
+
+type CreateRuleUseCaseInput struct {
+	RuleInput
+}
+
+func (uc *CreateRuleUseCase) Execute(
+	ctx context.Context,
+	in CreateRuleUseCaseInput,
+) (*entity.Rule, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if err := validateRuleInput(
+		ctx,
+		in.RuleInput,
+		uc.tcr,
+		uc.pmr,
+		uc.ir,
+		uc.tgr,
+	); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var params repo.CreateRuleParams
+	if err := copier.Copy(&params, in.RuleInput); err != nil {
+		return nil, errs.New(err)
+	}
+
+	rule, err := uc.rr.CreateRule(ctx, params)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return rule, nil
+}
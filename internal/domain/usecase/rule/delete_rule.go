@@ -0,0 +1,45 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type DeleteRuleUseCase struct {
+	rr repo.RuleRepo
+}
+
+func NewDeleteRuleUseCase(
+	rr repo.RuleRepo,
+) *DeleteRuleUseCase {
+	return &DeleteRuleUseCase{
+		rr: rr,
+	}
+}
+
+type DeleteRuleUseCaseInput struct {
+	ID     uuid.UUID `json:"rule_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (uc *DeleteRuleUseCase) Execute(
+	ctx context.Context,
+	in DeleteRuleUseCaseInput,
+) error {
+	rule, err := uc.rr.GetRuleByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if rule == nil || rule.UserID != in.UserID {
+		return errs.ErrRuleNotFound
+	}
+
+	if err := uc.rr.DeleteRule(ctx, in.ID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package rule
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/strutil"
+)
+
+// Target is the transaction data rules match against and act on.
+type Target struct {
+	Name            string
+	Amount          int64
+	PaymentMethodID uuid.UUID
+	InstitutionID   *uuid.UUID
+	CategoryID      uuid.UUID
+	IsIgnored       bool
+	TagID           *uuid.UUID
+}
+
+// Engine applies the rules of a user to transactions.
+type Engine struct {
+	rules                     []compiledRule
+	maxNameDistancePercentage float64
+}
+
+type compiledRule struct {
+	entity.Rule
+	nameRegex *regexp.Regexp
+}
+
+// NewEngine builds an engine over rules, which must be sorted by priority.
+// Rules with an invalid regex are skipped, they are validated on save so
+// this only happens if the pattern was changed outside the api.
+func NewEngine(
+	rules []entity.Rule,
+	maxNameDistancePercentage float64,
+) *Engine {
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, r := range rules {
+		c := compiledRule{Rule: r}
+
+		if r.NameMatchType != nil && r.NamePattern != nil &&
+			*r.NameMatchType == entity.RuleNameMatchTypeRegex {
+			nameRegex, err := compileNamePattern(*r.NamePattern)
+			if err != nil {
+				slog.Error(
+					"rule: invalid name pattern",
+					"rule", r,
+					"err", err,
+				)
+				continue
+			}
+			c.nameRegex = nameRegex
+		}
+
+		compiled = append(compiled, c)
+	}
+
+	return &Engine{
+		rules:                     compiled,
+		maxNameDistancePercentage: maxNameDistancePercentage,
+	}
+}
+
+// Apply runs the rules against t, updating it in place, and reports whether
+// any rule matched. Each action is taken from the first matching rule that
+// sets it, so higher priority rules win. Conditions always match the
+// original transaction data, a rename never feeds into the next rules.
+func (e *Engine) Apply(t *Target) bool {
+	original := *t
+
+	var (
+		matched     bool
+		hasName     bool
+		hasCategory bool
+		hasIgnored  bool
+	)
+
+	for _, r := range e.rules {
+		if !e.match(r, original) {
+			continue
+		}
+		matched = true
+
+		if r.RenameTo != nil && !hasName {
+			t.Name = *r.RenameTo
+			hasName = true
+		}
+		if r.CategoryID != nil && !hasCategory {
+			t.CategoryID = *r.CategoryID
+			hasCategory = true
+		}
+		if r.IsIgnored != nil && !hasIgnored {
+			t.IsIgnored = *r.IsIgnored
+			hasIgnored = true
+		}
+		if r.TagID != nil && t.TagID == nil {
+			t.TagID = r.TagID
+		}
+	}
+
+	return matched
+}
+
+func (e *Engine) match(r compiledRule, t Target) bool {
+	if r.PaymentMethodID != nil && *r.PaymentMethodID != t.PaymentMethodID {
+		return false
+	}
+
+	if r.InstitutionID != nil &&
+		(t.InstitutionID == nil || *r.InstitutionID != *t.InstitutionID) {
+		return false
+	}
+
+	if r.Direction != nil {
+		switch *r.Direction {
+		case entity.RuleDirectionIncome:
+			if t.Amount <= 0 {
+				return false
+			}
+		case entity.RuleDirectionExpense:
+			if t.Amount >= 0 {
+				return false
+			}
+		}
+	}
+
+	amount := abs(t.Amount)
+	if r.MinAmount != nil && amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && amount > *r.MaxAmount {
+		return false
+	}
+
+	if r.NameMatchType == nil || r.NamePattern == nil {
+		return true
+	}
+
+	switch *r.NameMatchType {
+	case entity.RuleNameMatchTypeContains:
+		return strings.Contains(
+			normalizeName(t.Name),
+			normalizeName(*r.NamePattern),
+		)
+	case entity.RuleNameMatchTypeRegex:
+		return r.nameRegex != nil && r.nameRegex.MatchString(t.Name)
+	case entity.RuleNameMatchTypeFuzzy:
+		return e.matchFuzzyName(*r.NamePattern, t.Name)
+	default:
+		return false
+	}
+}
+
+// matchFuzzyName compares pattern with every run of words of name with the
+// same length, so "uber trip" matches "UBER *TRIP HELP.UBER.COM".
+func (e *Engine) matchFuzzyName(pattern, name string) bool {
+	patternWords := strings.Fields(normalizeName(pattern))
+	nameWords := strings.Fields(normalizeName(name))
+	if len(patternWords) == 0 {
+		return false
+	}
+
+	size := min(len(patternWords), len(nameWords))
+	pattern = strings.Join(patternWords, " ")
+	for i := 0; i+size <= len(nameWords); i++ {
+		distance := strutil.LevenshteinDistancePercentage(
+			pattern,
+			strings.Join(nameWords[i:i+size], " "),
+		)
+		if distance <= e.maxNameDistancePercentage {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileNamePattern compiles a regex name pattern, matching case
+// insensitively like the other match types.
+func compileNamePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + pattern)
+}
+
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
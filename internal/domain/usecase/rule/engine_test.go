@@ -0,0 +1,215 @@
+package rule
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+const maxNameDistancePercentage = 0.3
+
+func TestEngineApply(t *testing.T) {
+	t.Parallel()
+
+	paymentMethodID := uuid.New()
+	institutionID := uuid.New()
+	categoryID := uuid.New()
+	otherCategoryID := uuid.New()
+	tagID := uuid.New()
+
+	newTarget := func() Target {
+		return Target{
+			Name:            "UBER *TRIP HELP.UBER.COM",
+			Amount:          -2590,
+			PaymentMethodID: paymentMethodID,
+			InstitutionID:   &institutionID,
+			CategoryID:      uuid.New(),
+		}
+	}
+
+	tests := []struct {
+		description      string
+		rules            []entity.Rule
+		expectedMatch    bool
+		expectedName     string
+		expectedIgnored  bool
+		expectedCategory *uuid.UUID
+		expectedTag      *uuid.UUID
+	}{
+		{
+			description: "should match names containing the pattern",
+			rules: []entity.Rule{{
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+				NamePattern:   ptr.New("uber  *trip"),
+				RenameTo:      ptr.New("Uber"),
+			}},
+			expectedMatch: true,
+			expectedName:  "Uber",
+		},
+		{
+			description: "should match names by regex case insensitively",
+			rules: []entity.Rule{{
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeRegex),
+				NamePattern:   ptr.New(`^uber\b`),
+				CategoryID:    &categoryID,
+			}},
+			expectedMatch:    true,
+			expectedCategory: &categoryID,
+		},
+		{
+			description: "should match similar names",
+			rules: []entity.Rule{{
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeFuzzy),
+				NamePattern:   ptr.New("uber trip"),
+				IsIgnored:     ptr.New(true),
+			}},
+			expectedMatch:   true,
+			expectedIgnored: true,
+		},
+		{
+			description: "should not match different names",
+			rules: []entity.Rule{{
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeFuzzy),
+				NamePattern:   ptr.New("ifood"),
+				IsIgnored:     ptr.New(true),
+			}},
+		},
+		{
+			description: "should match the absolute amount range",
+			rules: []entity.Rule{{
+				MinAmount: ptr.New(int64(2000)),
+				MaxAmount: ptr.New(int64(3000)),
+				IsIgnored: ptr.New(true),
+			}},
+			expectedMatch:   true,
+			expectedIgnored: true,
+		},
+		{
+			description: "should not match amounts out of the range",
+			rules: []entity.Rule{{
+				MaxAmount: ptr.New(int64(1000)),
+				IsIgnored: ptr.New(true),
+			}},
+		},
+		{
+			description: "should not match other directions",
+			rules: []entity.Rule{{
+				Direction: ptr.New(entity.RuleDirectionIncome),
+				IsIgnored: ptr.New(true),
+			}},
+		},
+		{
+			description: "should require every condition to match",
+			rules: []entity.Rule{{
+				NameMatchType:   ptr.New(entity.RuleNameMatchTypeContains),
+				NamePattern:     ptr.New("uber"),
+				PaymentMethodID: ptr.New(uuid.New()),
+				IsIgnored:       ptr.New(true),
+			}},
+		},
+		{
+			description: "should match payment method and institution",
+			rules: []entity.Rule{{
+				PaymentMethodID: &paymentMethodID,
+				InstitutionID:   &institutionID,
+				IsIgnored:       ptr.New(true),
+			}},
+			expectedMatch:   true,
+			expectedIgnored: true,
+		},
+		{
+			description: "should take each action from the first matching rule",
+			rules: []entity.Rule{
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("uber"),
+					CategoryID:    &categoryID,
+				},
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("uber"),
+					CategoryID:    &otherCategoryID,
+					RenameTo:      ptr.New("Uber"),
+				},
+			},
+			expectedMatch:    true,
+			expectedName:     "Uber",
+			expectedCategory: &categoryID,
+		},
+		{
+			description: "should tag with the first matching rule",
+			rules: []entity.Rule{
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("uber"),
+					TagID:         &tagID,
+				},
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("uber"),
+					TagID:         ptr.New(uuid.New()),
+				},
+			},
+			expectedMatch: true,
+			expectedTag:   &tagID,
+		},
+		{
+			description: "should match the original name after a rename",
+			rules: []entity.Rule{
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("uber"),
+					RenameTo:      ptr.New("Transporte"),
+				},
+				{
+					NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+					NamePattern:   ptr.New("transporte"),
+					IsIgnored:     ptr.New(true),
+				},
+			},
+			expectedMatch: true,
+			expectedName:  "Transporte",
+		},
+		{
+			description: "should skip rules with an invalid regex",
+			rules: []entity.Rule{{
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeRegex),
+				NamePattern:   ptr.New("uber("),
+				IsIgnored:     ptr.New(true),
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			asserts := assert.New(t)
+
+			// Arrange
+			engine := NewEngine(test.rules, maxNameDistancePercentage)
+			target := newTarget()
+			original := target
+
+			// Act
+			matched := engine.Apply(&target)
+
+			// Assert
+			asserts.Equal(test.expectedMatch, matched)
+			asserts.Equal(
+				cmp.Or(test.expectedName, original.Name),
+				target.Name,
+			)
+			asserts.Equal(test.expectedIgnored, target.IsIgnored)
+			asserts.Equal(
+				ptr.Coalesce(test.expectedCategory, original.CategoryID),
+				target.CategoryID,
+			)
+			asserts.Equal(test.expectedTag, target.TagID)
+		})
+	}
+}
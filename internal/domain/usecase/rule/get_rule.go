@@ -0,0 +1,42 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type GetRuleUseCase struct {
+	rr repo.RuleRepo
+}
+
+func NewGetRuleUseCase(
+	rr repo.RuleRepo,
+) *GetRuleUseCase {
+	return &GetRuleUseCase{
+		rr: rr,
+	}
+}
+
+type GetRuleUseCaseInput struct {
+	ID     uuid.UUID `json:"rule_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (uc *GetRuleUseCase) Execute(
+	ctx context.Context,
+	in GetRuleUseCaseInput,
+) (*entity.Rule, error) {
+	rule, err := uc.rr.GetRuleByID(ctx, in.ID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if rule == nil || rule.UserID != in.UserID {
+		return nil, errs.ErrRuleNotFound
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,71 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"golang.org/x/sync/errgroup"
+)
+
+type ListRulesUseCase struct {
+	rr repo.RuleRepo
+}
+
+func NewListRulesUseCase(
+	rr repo.RuleRepo,
+) *ListRulesUseCase {
+	return &ListRulesUseCase{
+		rr: rr,
+	}
+}
+
+type ListRulesUseCaseInput struct {
+	usecase.PaginationInput
+	repo.RuleOptions
+}
+
+func (uc *ListRulesUseCase) Execute(
+	ctx context.Context,
+	in ListRulesUseCaseInput,
+) (*entity.PaginatedList[entity.Rule], error) {
+	g, gCtx := errgroup.WithContext(ctx)
+	var rules []entity.Rule
+	var count int64
+
+	g.Go(func() error {
+		var err error
+		count, err = uc.rr.CountRules(
+			gCtx,
+			in.RuleOptions,
+		)
+		return err
+	})
+
+	in.Limit, in.Offset = usecase.PreparePaginationInput(
+		in.PaginationInput,
+	)
+
+	g.Go(func() error {
+		var err error
+		rules, err = uc.rr.ListRules(
+			gCtx,
+			in.RuleOptions,
+		)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := entity.PaginatedList[entity.Rule]{
+		Items: rules,
+	}
+
+	usecase.PreparePaginationOutput(&out, in.PaginationInput, count)
+
+	return &out, nil
+}
@@ -0,0 +1,142 @@
+package rule
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// RuleInput is the rule definition shared by the create and update use cases.
+// Conditions are combined with AND, and at least one condition and one
+// action are required.
+type RuleInput struct {
+	UserID          uuid.UUID  `json:"-"                 validate:"required"`
+	Name            string     `json:"name"              validate:"required"`
+	Priority        int32      `json:"priority"`
+	NameMatchType   *string    `json:"name_match_type"   validate:"required_with=NamePattern,omitempty,oneof=CONTAINS REGEX FUZZY"`
+	NamePattern     *string    `json:"name_pattern"      validate:"required_with=NameMatchType,omitempty,min=1"`
+	MinAmount       *int64     `json:"min_amount"        validate:"omitempty,min=0"`
+	MaxAmount       *int64     `json:"max_amount"        validate:"omitempty,min=0"`
+	Direction       *string    `json:"direction"         validate:"omitempty,oneof=INCOME EXPENSE"`
+	PaymentMethodID *uuid.UUID `json:"payment_method_id"`
+	InstitutionID   *uuid.UUID `json:"institution_id"`
+	CategoryID      *uuid.UUID `json:"category_id"`
+	RenameTo        *string    `json:"rename_to"         validate:"omitempty,min=1"`
+	IsIgnored       *bool      `json:"is_ignored"`
+	TagID           *uuid.UUID `json:"tag_id"`
+}
+
+func validateRuleInput(
+	ctx context.Context,
+	in RuleInput,
+	tcr repo.TransactionCategoryRepo,
+	pmr repo.PaymentMethodRepo,
+	ir repo.InstitutionRepo,
+	tgr repo.TagRepo,
+) error {
+	hasCondition := in.NamePattern != nil ||
+		in.MinAmount != nil ||
+		in.MaxAmount != nil ||
+		in.Direction != nil ||
+		in.PaymentMethodID != nil ||
+		in.InstitutionID != nil
+	if !hasCondition {
+		return errs.ErrRuleWithoutCondition
+	}
+
+	hasAction := in.CategoryID != nil ||
+		in.RenameTo != nil ||
+		in.IsIgnored != nil ||
+		in.TagID != nil
+	if !hasAction {
+		return errs.ErrRuleWithoutAction
+	}
+
+	if in.MinAmount != nil && in.MaxAmount != nil &&
+		*in.MinAmount > *in.MaxAmount {
+		return errs.ErrInvalidRuleAmountRange
+	}
+
+	if in.NameMatchType != nil && in.NamePattern != nil &&
+		*in.NameMatchType == entity.RuleNameMatchTypeRegex {
+		if _, err := compileNamePattern(*in.NamePattern); err != nil {
+			return errs.ErrInvalidRuleNamePattern
+		}
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	if in.CategoryID != nil {
+		g.Go(func() error {
+			category, err := tcr.GetTransactionCategoryByID(gCtx, *in.CategoryID)
+			if err != nil {
+				return err
+			}
+			if category == nil ||
+				(category.UserID != nil && *category.UserID != in.UserID) {
+				return errs.ErrCategoryNotFound
+			}
+			return nil
+		})
+	}
+
+	if in.PaymentMethodID != nil {
+		g.Go(func() error {
+			paymentMethod, err := pmr.GetPaymentMethodByID(
+				gCtx,
+				*in.PaymentMethodID,
+			)
+			if err != nil {
+				return err
+			}
+			if paymentMethod == nil {
+				return errs.ErrPaymentMethodNotFound
+			}
+			return nil
+		})
+	}
+
+	if in.InstitutionID != nil {
+		g.Go(func() error {
+			institutions, err := ir.ListInstitutions(
+				gCtx,
+				repo.InstitutionOptions{UserIDs: []uuid.UUID{in.UserID}},
+			)
+			if err != nil {
+				return err
+			}
+			if !slices.ContainsFunc(
+				institutions,
+				func(i entity.Institution) bool { return i.ID == *in.InstitutionID },
+			) {
+				return errs.ErrInstitutionNotFound
+			}
+			return nil
+		})
+	}
+
+	if in.TagID != nil {
+		g.Go(func() error {
+			tag, err := tgr.GetTagByID(gCtx, *in.TagID)
+			if err != nil {
+				return err
+			}
+			if tag == nil || tag.UserID != in.UserID {
+				return errs.ErrTagNotFound
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
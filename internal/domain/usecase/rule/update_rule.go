@@ -0,0 +1,83 @@
+package rule
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type UpdateRuleUseCase struct {
+	v   *validator.Validator
+	rr  repo.RuleRepo
+	tcr repo.TransactionCategoryRepo
+	pmr repo.PaymentMethodRepo
+	ir  repo.InstitutionRepo
+	tgr repo.TagRepo
+}
+
+func NewUpdateRuleUseCase(
+	v *validator.Validator,
+	rr repo.RuleRepo,
+	tcr repo.TransactionCategoryRepo,
+	pmr repo.PaymentMethodRepo,
+	ir repo.InstitutionRepo,
+	tgr repo.TagRepo,
+) *UpdateRuleUseCase {
+	return &UpdateRuleUseCase{
+		v:   v,
+		rr:  rr,
+		tcr: tcr,
+		pmr: pmr,
+		ir:  ir,
+		tgr: tgr,
+	}
+}
+
+type UpdateRuleUseCaseInput struct {
+	ID uuid.UUID `json:"-" validate:"required"`
+	RuleInput
+}
+
+func (uc *UpdateRuleUseCase) Execute(
+	ctx context.Context,
+	in UpdateRuleUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	rule, err := uc.rr.GetRuleByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if rule == nil || rule.UserID != in.UserID {
+		return errs.ErrRuleNotFound
+	}
+
+	if err := validateRuleInput(
+		ctx,
+		in.RuleInput,
+		uc.tcr,
+		uc.pmr,
+		uc.ir,
+		uc.tgr,
+	); err != nil {
+		return errs.New(err)
+	}
+
+	var params repo.UpdateRuleParams
+	if err := copier.Copy(&params, in.RuleInput); err != nil {
+		return errs.New(err)
+	}
+	params.ID = in.ID
+
+	if err := uc.rr.UpdateRule(ctx, params); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
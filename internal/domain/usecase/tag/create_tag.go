@@ -0,0 +1,54 @@
+package tag
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type CreateTagUseCase struct {
+	v  *validator.Validator
+	tr repo.TagRepo
+}
+
+func NewCreateTagUseCase(
+	v *validator.Validator,
+	tr repo.TagRepo,
+) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type CreateTagUseCaseInput struct {
+	TagInput
+}
+
+func (uc *CreateTagUseCase) Execute(
+	ctx context.Context,
+	in CreateTagUseCaseInput,
+) (*entity.Tag, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if err := validateTagName(ctx, uc.tr, in.TagInput, uuid.Nil); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tag, err := uc.tr.CreateTag(ctx, repo.CreateTagParams{
+		Name:   strings.TrimSpace(in.Name),
+		UserID: in.UserID,
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,50 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type DeleteTagUseCase struct {
+	tx tx.TX
+	tr repo.TagRepo
+}
+
+func NewDeleteTagUseCase(
+	tx tx.TX,
+	tr repo.TagRepo,
+) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tx: tx,
+		tr: tr,
+	}
+}
+
+type DeleteTagUseCaseInput struct {
+	ID     uuid.UUID `json:"tag_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute deletes a tag, removing it from the transactions and the rules
+// that add it.
+func (uc *DeleteTagUseCase) Execute(
+	ctx context.Context,
+	in DeleteTagUseCaseInput,
+) error {
+	if _, err := getOwnTag(ctx, uc.tr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		return uc.tr.DeleteTag(ctx, in.ID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"golang.org/x/sync/errgroup"
+)
+
+type ListTagsUseCase struct {
+	tr repo.TagRepo
+}
+
+func NewListTagsUseCase(
+	tr repo.TagRepo,
+) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tr: tr,
+	}
+}
+
+type ListTagsUseCaseInput struct {
+	usecase.PaginationInput
+	repo.TagOptions
+}
+
+func (uc *ListTagsUseCase) Execute(
+	ctx context.Context,
+	in ListTagsUseCaseInput,
+) (*entity.PaginatedList[entity.Tag], error) {
+	g, gCtx := errgroup.WithContext(ctx)
+	var tags []entity.Tag
+	var count int64
+
+	g.Go(func() error {
+		var err error
+		count, err = uc.tr.CountTags(
+			gCtx,
+			in.TagOptions,
+		)
+		return err
+	})
+
+	in.Limit, in.Offset = usecase.PreparePaginationInput(
+		in.PaginationInput,
+	)
+
+	g.Go(func() error {
+		var err error
+		tags, err = uc.tr.ListTags(
+			gCtx,
+			in.TagOptions,
+		)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := entity.PaginatedList[entity.Tag]{
+		Items: tags,
+	}
+
+	usecase.PreparePaginationOutput(&out, in.PaginationInput, count)
+
+	return &out, nil
+}
@@ -0,0 +1,60 @@
+package tag
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// TagInput is the tag definition shared by the create and update use cases.
+type TagInput struct {
+	UserID uuid.UUID `json:"-"    validate:"required"`
+	Name   string    `json:"name" validate:"required"`
+}
+
+// validateTagName checks the user has no other tag with the same name,
+// ignoring case. id is the tag being updated, if any.
+func validateTagName(
+	ctx context.Context,
+	tr repo.TagRepo,
+	in TagInput,
+	id uuid.UUID,
+) error {
+	tags, err := tr.ListTags(ctx, repo.TagOptions{UserID: in.UserID})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	name := strings.TrimSpace(in.Name)
+	if slices.ContainsFunc(tags, func(t entity.Tag) bool {
+		return t.ID != id && strings.EqualFold(t.Name, name)
+	}) {
+		return errs.ErrTagAlreadyExists
+	}
+
+	return nil
+}
+
+// getOwnTag gets a tag of the user.
+func getOwnTag(
+	ctx context.Context,
+	tr repo.TagRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Tag, error) {
+	tag, err := tr.GetTagByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if tag == nil || tag.UserID != userID {
+		return nil, errs.ErrTagNotFound
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,57 @@
+package tag
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type UpdateTagUseCase struct {
+	v  *validator.Validator
+	tr repo.TagRepo
+}
+
+func NewUpdateTagUseCase(
+	v *validator.Validator,
+	tr repo.TagRepo,
+) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type UpdateTagUseCaseInput struct {
+	ID uuid.UUID `json:"-" validate:"required"`
+	TagInput
+}
+
+func (uc *UpdateTagUseCase) Execute(
+	ctx context.Context,
+	in UpdateTagUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	if _, err := getOwnTag(ctx, uc.tr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	if err := validateTagName(ctx, uc.tr, in.TagInput, in.ID); err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.tr.UpdateTag(ctx, repo.UpdateTagParams{
+		ID:   in.ID,
+		Name: strings.TrimSpace(in.Name),
+	}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package transaction
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type BulkUpdateTransactionsUseCase struct {
+	v   *validator.Validator
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	tcr repo.TransactionCategoryRepo
+	tgr repo.TagRepo
+}
+
+func NewBulkUpdateTransactionsUseCase(
+	v *validator.Validator,
+	tx tx.TX,
+	tr repo.TransactionRepo,
+	tcr repo.TransactionCategoryRepo,
+	tgr repo.TagRepo,
+) *BulkUpdateTransactionsUseCase {
+	return &BulkUpdateTransactionsUseCase{
+		v:   v,
+		tx:  tx,
+		tr:  tr,
+		tcr: tcr,
+		tgr: tgr,
+	}
+}
+
+type BulkUpdateTransactionsUseCaseInput struct {
+	UserID    uuid.UUID                       `json:"-"          validate:"required"`
+	Operation entity.BulkTransactionOperation `json:"operation"  validate:"required,oneof=RECATEGORIZE SET_IGNORED ADD_TAGS REMOVE_TAGS DELETE"`
+	DryRun    bool                            `json:"dry_run"`
+
+	// IDs selects the transactions, when empty they are selected by the
+	// filter.
+	IDs    []uuid.UUID             `json:"ids"`
+	Filter repo.TransactionOptions `json:"-"`
+
+	CategoryID uuid.UUID   `json:"category_id" validate:"required_if=Operation RECATEGORIZE"`
+	IsIgnored  *bool       `json:"is_ignored"  validate:"required_if=Operation SET_IGNORED"`
+	TagIDs     []uuid.UUID `json:"tag_ids"     validate:"required_if=Operation ADD_TAGS,required_if=Operation REMOVE_TAGS"`
+}
+
+type BulkUpdateTransactionsUseCaseOutput struct {
+	// Matched is the number of transactions selected.
+	Matched int `json:"matched"`
+	// Affected is the number of selected transactions the operation changes,
+	// the ones already in the requested state are left untouched.
+	Affected int  `json:"affected"`
+	DryRun   bool `json:"dry_run"`
+}
+
+// Execute applies the operation to all the selected transactions of the user
+// at once, or only counts them in dry-run mode. Deleting hides the synced
+// transactions, so they are not inserted again by the next sync.
+func (uc *BulkUpdateTransactionsUseCase) Execute(
+	ctx context.Context,
+	in BulkUpdateTransactionsUseCaseInput,
+) (*BulkUpdateTransactionsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	opts := in.Filter
+	if len(in.IDs) > 0 {
+		in.IDs = uniqueIDs(in.IDs)
+		opts = repo.TransactionOptions{IDs: in.IDs}
+	} else if reflect.ValueOf(opts).IsZero() {
+		return nil, errs.ErrBulkTransactionsSelector
+	}
+	opts.Limit, opts.Offset = 0, 0
+	opts.ShouldExpandSplits = false
+
+	if in.Operation == entity.BulkTransactionOperationRecategorize {
+		category, err := uc.tcr.GetTransactionCategoryByID(ctx, in.CategoryID)
+		if err != nil {
+			return nil, errs.New(err)
+		}
+		if category == nil ||
+			(category.UserID != nil && *category.UserID != in.UserID) {
+			return nil, errs.ErrCategoryNotFound
+		}
+	}
+
+	in.TagIDs = uniqueIDs(in.TagIDs)
+	if err := validateTags(ctx, uc.tgr, in.UserID, in.TagIDs); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := &BulkUpdateTransactionsUseCaseOutput{DryRun: in.DryRun}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		transactions, err := uc.tr.ListTransactions(ctx, in.UserID, opts)
+		if err != nil {
+			return errs.New(err)
+		}
+
+		// Ids of other users or of deleted transactions are not listed
+		if len(in.IDs) > 0 && len(transactions) != len(in.IDs) {
+			return errs.ErrTransactionNotFound
+		}
+
+		var tagsByTransactionID map[uuid.UUID][]entity.Tag
+		if len(in.TagIDs) > 0 && len(transactions) > 0 {
+			tagsByTransactionID, err = uc.tr.ListTransactionTags(
+				ctx,
+				transactionIDs(transactions),
+			)
+			if err != nil {
+				return errs.New(err)
+			}
+		}
+
+		affected := filterAffectedTransactions(
+			in,
+			transactions,
+			tagsByTransactionID,
+		)
+
+		out.Matched = len(transactions)
+		out.Affected = len(affected)
+
+		if in.DryRun || len(affected) == 0 {
+			return nil
+		}
+
+		return uc.apply(ctx, in, affected)
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return out, nil
+}
+
+// apply runs the operation on the affected transactions. Must run in a
+// transaction.
+func (uc *BulkUpdateTransactionsUseCase) apply(
+	ctx context.Context,
+	in BulkUpdateTransactionsUseCaseInput,
+	transactions []entity.Transaction,
+) error {
+	ids := transactionIDs(transactions)
+
+	switch in.Operation {
+	case entity.BulkTransactionOperationRecategorize:
+		return uc.tr.UpdateTransactionsCategory(
+			ctx,
+			repo.UpdateTransactionsCategoryParams{
+				CategoryID: in.CategoryID,
+				Ids:        ids,
+				UserID:     in.UserID,
+			},
+		)
+
+	case entity.BulkTransactionOperationSetIgnored:
+		return uc.tr.UpdateTransactionsIsIgnored(
+			ctx,
+			repo.UpdateTransactionsIsIgnoredParams{
+				IsIgnored: *in.IsIgnored,
+				Ids:       ids,
+				UserID:    in.UserID,
+			},
+		)
+
+	case entity.BulkTransactionOperationAddTags:
+		for _, tagID := range in.TagIDs {
+			if err := uc.tr.TagTransactions(ctx, repo.TagTransactionsParams{
+				TransactionIds: ids,
+				TagID:          tagID,
+			}); err != nil {
+				return errs.New(err)
+			}
+		}
+		return nil
+
+	case entity.BulkTransactionOperationRemoveTags:
+		for _, tagID := range in.TagIDs {
+			if err := uc.tr.UntagTransactions(ctx, repo.UntagTransactionsParams{
+				TransactionIds: ids,
+				TagID:          tagID,
+			}); err != nil {
+				return errs.New(err)
+			}
+		}
+		return nil
+
+	case entity.BulkTransactionOperationDelete:
+		manualIDs, syncedIDs := []uuid.UUID{}, []uuid.UUID{}
+		for _, t := range transactions {
+			if !isSynced(t.ExternalID) {
+				manualIDs = append(manualIDs, t.ID)
+			} else {
+				syncedIDs = append(syncedIDs, t.ID)
+			}
+		}
+
+		if len(manualIDs) > 0 {
+			if err := uc.tr.DeleteTransactions(ctx, manualIDs); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		if len(syncedIDs) > 0 {
+			if err := uc.tr.HideTransactions(ctx, syncedIDs); err != nil {
+				return errs.New(err)
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// filterAffectedTransactions returns the transactions the operation changes.
+func filterAffectedTransactions(
+	in BulkUpdateTransactionsUseCaseInput,
+	transactions []entity.Transaction,
+	tagsByTransactionID map[uuid.UUID][]entity.Tag,
+) []entity.Transaction {
+	hasTag := func(transactionID, tagID uuid.UUID) bool {
+		return slices.ContainsFunc(
+			tagsByTransactionID[transactionID],
+			func(tag entity.Tag) bool { return tag.ID == tagID },
+		)
+	}
+
+	return slices.DeleteFunc(
+		slices.Clone(transactions),
+		func(t entity.Transaction) bool {
+			switch in.Operation {
+			case entity.BulkTransactionOperationRecategorize:
+				return t.CategoryID == in.CategoryID
+
+			case entity.BulkTransactionOperationSetIgnored:
+				return t.IsIgnored == *in.IsIgnored
+
+			case entity.BulkTransactionOperationAddTags:
+				return !slices.ContainsFunc(in.TagIDs, func(tagID uuid.UUID) bool {
+					return !hasTag(t.ID, tagID)
+				})
+
+			case entity.BulkTransactionOperationRemoveTags:
+				return !slices.ContainsFunc(in.TagIDs, func(tagID uuid.UUID) bool {
+					return hasTag(t.ID, tagID)
+				})
+			}
+
+			return false
+		},
+	)
+}
+
+func transactionIDs(transactions []entity.Transaction) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(transactions))
+	for _, t := range transactions {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
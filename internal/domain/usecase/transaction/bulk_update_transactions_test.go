@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestFilterAffectedTransactions(t *testing.T) {
+	t.Parallel()
+
+	categoryID := uuid.New()
+	otherCategoryID := uuid.New()
+	tagID := uuid.New()
+	otherTagID := uuid.New()
+
+	transactions := []entity.Transaction{
+		{ID: uuid.New(), CategoryID: categoryID, IsIgnored: true},
+		{ID: uuid.New(), CategoryID: otherCategoryID},
+		{ID: uuid.New(), CategoryID: otherCategoryID},
+	}
+
+	tagsByTransactionID := map[uuid.UUID][]entity.Tag{
+		transactions[0].ID: {{ID: tagID}, {ID: otherTagID}},
+		transactions[1].ID: {{ID: tagID}},
+	}
+
+	tests := []struct {
+		description string
+		in          BulkUpdateTransactionsUseCaseInput
+		expected    []entity.Transaction
+	}{
+		{
+			description: "recategorizes transactions of other categories",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation:  entity.BulkTransactionOperationRecategorize,
+				CategoryID: categoryID,
+			},
+			expected: transactions[1:],
+		},
+		{
+			description: "ignores transactions not ignored yet",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+			},
+			expected: transactions[1:],
+		},
+		{
+			description: "unignores ignored transactions",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(false),
+			},
+			expected: transactions[:1],
+		},
+		{
+			description: "adds tags to transactions missing any of them",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationAddTags,
+				TagIDs:    []uuid.UUID{tagID, otherTagID},
+			},
+			expected: transactions[1:],
+		},
+		{
+			description: "removes tags from transactions with any of them",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationRemoveTags,
+				TagIDs:    []uuid.UUID{tagID},
+			},
+			expected: transactions[:2],
+		},
+		{
+			description: "deletes all transactions",
+			in: BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationDelete,
+			},
+			expected: transactions,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			got := filterAffectedTransactions(
+				test.in,
+				transactions,
+				tagsByTransactionID,
+			)
+
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
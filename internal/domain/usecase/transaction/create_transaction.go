@@ -4,8 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/google/uuid"
@@ -14,36 +17,56 @@ import (
 )
 
 type CreateTransactionUseCase struct {
+	e   *env.Env
 	v   *validator.Validator
+	tx  tx.TX
 	tr  repo.TransactionRepo
 	ur  repo.UserRepo
 	tcr repo.TransactionCategoryRepo
 	pmr repo.PaymentMethodRepo
+	rr  repo.RuleRepo
+	tgr repo.TagRepo
+	ar  repo.AccountRepo
 }
 
 func NewCreateTransactionUseCase(
+	e *env.Env,
 	v *validator.Validator,
+	tx tx.TX,
 	tr repo.TransactionRepo,
 	ur repo.UserRepo,
 	tcr repo.TransactionCategoryRepo,
 	pmr repo.PaymentMethodRepo,
+	rr repo.RuleRepo,
+	tgr repo.TagRepo,
+	ar repo.AccountRepo,
 ) *CreateTransactionUseCase {
 	return &CreateTransactionUseCase{
+		e:   e,
 		v:   v,
+		tx:  tx,
 		tr:  tr,
 		ur:  ur,
 		tcr: tcr,
 		pmr: pmr,
+		rr:  rr,
+		tgr: tgr,
+		ar:  ar,
 	}
 }
 
 type CreateTransactionUseCaseInput struct {
-	UserID          uuid.UUID  `json:"-"                 validate:"required"`
-	Name            string     `json:"name"              validate:"required"`
-	Amount          int64      `json:"amount"            validate:"required"`
-	PaymentMethodID uuid.UUID  `json:"payment_method_id" validate:"required"`
-	Date            time.Time  `json:"date"              validate:"required"`
-	CategoryID      *uuid.UUID `json:"category_id"       validate:"omitempty"`
+	UserID          uuid.UUID   `json:"-"                 validate:"required"`
+	Name            string      `json:"name"              validate:"required"`
+	Amount          int64       `json:"amount"            validate:"required"`
+	PaymentMethodID uuid.UUID   `json:"payment_method_id" validate:"required"`
+	Date            time.Time   `json:"date"              validate:"required"`
+	CategoryID      *uuid.UUID  `json:"category_id"       validate:"omitempty"`
+	Notes           *string     `json:"notes"`
+	TagIDs          []uuid.UUID `json:"tag_ids"`
+	// AccountID links the transaction to a manual account, adding it to
+	// the account balance.
+	AccountID *uuid.UUID `json:"account_id"`
 }
 
 func (uc *CreateTransactionUseCase) Execute(
@@ -54,6 +77,8 @@ func (uc *CreateTransactionUseCase) Execute(
 		return errs.New(err)
 	}
 
+	hasCategory := in.CategoryID != nil
+
 	g, gCtx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
@@ -94,13 +119,32 @@ func (uc *CreateTransactionUseCase) Execute(
 		if err != nil {
 			return errs.New(err)
 		}
-		if category == nil {
+		if category == nil ||
+			(category.UserID != nil && *category.UserID != in.UserID) {
 			return errs.ErrCategoryNotFound
 		}
 		in.CategoryID = &category.ID
 		return nil
 	})
 
+	g.Go(func() error {
+		if in.AccountID == nil {
+			return nil
+		}
+		return validateManualAccount(gCtx, uc.ar, in.UserID, *in.AccountID)
+	})
+
+	g.Go(func() error {
+		return validateTags(gCtx, uc.tgr, in.UserID, in.TagIDs)
+	})
+
+	var rules []entity.Rule
+	g.Go(func() error {
+		var err error
+		rules, err = uc.rr.ListRules(gCtx, repo.RuleOptions{UserID: in.UserID})
+		return err
+	})
+
 	if err := g.Wait(); err != nil {
 		return errs.New(err)
 	}
@@ -110,7 +154,35 @@ func (uc *CreateTransactionUseCase) Execute(
 		return errs.New(err)
 	}
 
-	if err := uc.tr.CreateTransaction(ctx, params); err != nil {
+	target := rule.Target{
+		Name:            params.Name,
+		Amount:          params.Amount,
+		PaymentMethodID: params.PaymentMethodID,
+		CategoryID:      params.CategoryID,
+	}
+	rule.NewEngine(rules, uc.e.MaxLevenshteinDistancePercentage).Apply(&target)
+
+	params.Name = target.Name
+	params.IsIgnored = target.IsIgnored
+	// A category picked by the user wins over the rules.
+	if !hasCategory {
+		params.CategoryID = target.CategoryID
+	}
+
+	tagIDs := in.TagIDs
+	if target.TagID != nil {
+		tagIDs = append(tagIDs, *target.TagID)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		transaction, err := uc.tr.CreateTransaction(ctx, params)
+		if err != nil {
+			return errs.New(err)
+		}
+
+		return setTransactionTags(ctx, uc.tr, transaction.ID, tagIDs)
+	})
+	if err != nil {
 		return errs.New(err)
 	}
 
@@ -0,0 +1,58 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type DeleteTransactionUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+}
+
+func NewDeleteTransactionUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+) *DeleteTransactionUseCase {
+	return &DeleteTransactionUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type DeleteTransactionUseCaseInput struct {
+	ID     uuid.UUID `json:"transaction_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id"        validate:"required"`
+}
+
+// Execute moves a manual transaction to the trash. Synced transactions
+// must be hidden instead, so the next sync does not insert them again.
+func (uc *DeleteTransactionUseCase) Execute(
+	ctx context.Context,
+	in DeleteTransactionUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	transaction, err := uc.tr.GetTransactionByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if transaction == nil || transaction.UserID != in.UserID {
+		return errs.ErrTransactionNotFound
+	}
+	if isSynced(transaction.ExternalID) {
+		return errs.ErrSyncedTransactionDelete
+	}
+
+	if err := uc.tr.DeleteTransactions(ctx, []uuid.UUID{in.ID}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+var exportContentTypes = map[entity.ExportFormat]string{
+	entity.ExportFormatCSV:  "text/csv; charset=utf-8",
+	entity.ExportFormatOFX:  "application/x-ofx",
+	entity.ExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type ExportTransactionsUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+	ur repo.UserRepo
+}
+
+func NewExportTransactionsUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+	ur repo.UserRepo,
+) *ExportTransactionsUseCase {
+	return &ExportTransactionsUseCase{
+		v:  v,
+		tr: tr,
+		ur: ur,
+	}
+}
+
+type ExportTransactionsUseCaseInput struct {
+	repo.TransactionOptions
+	UserID uuid.UUID           `json:"user_id" validate:"required"`
+	Format entity.ExportFormat `json:"format"  validate:"required,oneof=csv ofx xlsx"`
+}
+
+type ExportTransactionsUseCaseOutput struct {
+	FileName    string
+	ContentType string
+	Body        io.ReadCloser
+}
+
+// Execute returns a reader for the exported file, which must be closed by
+// the caller. The transactions are read from the database and written to the
+// file while the reader is consumed, so large exports are not held in
+// memory. Split transactions are exported as their parts.
+func (uc *ExportTransactionsUseCase) Execute(
+	ctx context.Context,
+	in ExportTransactionsUseCaseInput,
+) (*ExportTransactionsUseCaseOutput, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	user, err := uc.ur.GetUserByID(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if user == nil {
+		return nil, errs.ErrUserNotFound
+	}
+
+	locale := getExportLocale(entity.Language(user.Language))
+
+	opts := in.TransactionOptions
+	opts.Limit, opts.Offset = 0, 0
+	opts.ShouldExpandSplits = true
+	opts.ShouldOrderByDate = true
+
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(uc.export(ctx, pw, in.UserID, in.Format, locale, opts))
+	}()
+
+	return &ExportTransactionsUseCaseOutput{
+		FileName: fmt.Sprintf(
+			"transactions-%s.%s",
+			time.Now().Format("2006-01-02"),
+			in.Format,
+		),
+		ContentType: exportContentTypes[in.Format],
+		Body:        pr,
+	}, nil
+}
+
+func (uc *ExportTransactionsUseCase) export(
+	ctx context.Context,
+	w io.Writer,
+	userID uuid.UUID,
+	format entity.ExportFormat,
+	locale exportLocale,
+	opts repo.TransactionOptions,
+) error {
+	exporter, err := newTransactionExporter(w, format, locale)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.tr.EachFullTransaction(
+		ctx,
+		userID,
+		exporter.Write,
+		opts,
+	); err != nil {
+		return errs.New(err)
+	}
+
+	if err := exporter.Close(); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
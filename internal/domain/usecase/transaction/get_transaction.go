@@ -7,6 +7,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 )
 
 type GetTransactionUseCase struct {
@@ -38,5 +39,26 @@ func (uc *GetTransactionUseCase) Execute(
 		return nil, errs.ErrTransactionNotFound
 	}
 
+	g, gCtx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var err error
+		transaction.Splits, err = uc.tr.ListTransactionSplits(gCtx, in.ID)
+		return err
+	})
+
+	g.Go(func() error {
+		tags, err := uc.tr.ListTransactionTags(gCtx, []uuid.UUID{in.ID})
+		if err != nil {
+			return err
+		}
+		transaction.Tags = tags[in.ID]
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
 	return transaction, nil
 }
@@ -0,0 +1,58 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type HideTransactionUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+}
+
+func NewHideTransactionUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+) *HideTransactionUseCase {
+	return &HideTransactionUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type HideTransactionUseCaseInput struct {
+	ID     uuid.UUID `json:"transaction_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id"        validate:"required"`
+}
+
+// Execute moves a synced transaction to the trash and marks it as hidden,
+// so the sync skips it instead of inserting it again.
+func (uc *HideTransactionUseCase) Execute(
+	ctx context.Context,
+	in HideTransactionUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	transaction, err := uc.tr.GetTransactionByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if transaction == nil || transaction.UserID != in.UserID {
+		return errs.ErrTransactionNotFound
+	}
+	if !isSynced(transaction.ExternalID) {
+		return errs.ErrManualTransactionHide
+	}
+
+	if err := uc.tr.HideTransaction(ctx, in.ID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type ListDeletedTransactionsUseCase struct {
+	tr repo.TransactionRepo
+}
+
+func NewListDeletedTransactionsUseCase(
+	tr repo.TransactionRepo,
+) *ListDeletedTransactionsUseCase {
+	return &ListDeletedTransactionsUseCase{
+		tr: tr,
+	}
+}
+
+type ListDeletedTransactionsUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListDeletedTransactionsUseCaseOutput struct {
+	Items []entity.Transaction `json:"items"`
+}
+
+// Execute lists the trash of the user: the transactions they deleted or hid
+// in the last days, most recently deleted first. Transactions removed by the
+// provider are left out.
+func (uc *ListDeletedTransactionsUseCase) Execute(
+	ctx context.Context,
+	in ListDeletedTransactionsUseCaseInput,
+) (*ListDeletedTransactionsUseCaseOutput, error) {
+	startDate := trashStartDate(time.Now())
+
+	transactions, err := uc.tr.ListDeletedTransactions(
+		ctx,
+		repo.ListDeletedTransactionsParams{
+			UserID:    in.UserID,
+			DeletedAt: &startDate,
+		},
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &ListDeletedTransactionsUseCaseOutput{
+		Items: transactions,
+	}, nil
+}
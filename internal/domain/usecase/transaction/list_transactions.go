@@ -74,6 +74,22 @@ func (uc *ListTransactionsUseCase) Execute(
 		return nil, errs.New(err)
 	}
 
+	if len(transactions) > 0 {
+		ids := make([]uuid.UUID, 0, len(transactions))
+		for _, t := range transactions {
+			ids = append(ids, t.ID)
+		}
+
+		tags, err := uc.tr.ListTransactionTags(ctx, ids)
+		if err != nil {
+			return nil, errs.New(err)
+		}
+
+		for i := range transactions {
+			transactions[i].Tags = tags[transactions[i].ID]
+		}
+	}
+
 	out := entity.PaginatedList[entity.FullTransaction]{
 		Items: transactions,
 	}
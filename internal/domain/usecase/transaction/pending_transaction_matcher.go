@@ -0,0 +1,163 @@
+package transaction
+
+import (
+	"cmp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/strutil"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+const (
+	// pendingMatchMaxDays is how far apart, in days, a pending transaction and
+	// its posted replacement can be dated.
+	pendingMatchMaxDays = 5
+	// pendingMatchAmountTolerance is how much the posted amount can differ
+	// from the pending one, relative to the pending amount. Card holds on
+	// fuel, tips and foreign currency purchases are often adjusted on posting.
+	pendingMatchAmountTolerance = 0.1
+)
+
+// pendingTransactionMatcher pairs posted open finance transactions with the
+// stored pending transactions they replace. Each pending transaction is
+// matched at most once.
+type pendingTransactionMatcher struct {
+	maxNameDistancePercentage float64
+	pendingByAccountID        map[uuid.UUID][]entity.Transaction
+	matchedIDs                map[uuid.UUID]struct{}
+}
+
+// newPendingTransactionMatcher builds a matcher over the pending transactions
+// in transactions. Pending transactions whose external id is still returned
+// by open finance were not replaced yet, so they are never matched.
+func newPendingTransactionMatcher(
+	transactions []entity.Transaction,
+	ofTransactions []openfinance.Transaction,
+	maxNameDistancePercentage float64,
+) *pendingTransactionMatcher {
+	ofExternalIDs := make(map[string]struct{}, len(ofTransactions))
+	for _, ofTrans := range ofTransactions {
+		if ofTrans.ExternalID == nil {
+			continue
+		}
+		ofExternalIDs[*ofTrans.ExternalID] = struct{}{}
+	}
+
+	pendingByAccountID := make(map[uuid.UUID][]entity.Transaction)
+	for _, t := range transactions {
+		if t.Status != entity.TransactionStatusPending || t.AccountID == nil {
+			continue
+		}
+		if t.ExternalID != nil {
+			if _, ok := ofExternalIDs[*t.ExternalID]; ok {
+				continue
+			}
+		}
+		pendingByAccountID[*t.AccountID] = append(
+			pendingByAccountID[*t.AccountID],
+			t,
+		)
+	}
+
+	return &pendingTransactionMatcher{
+		maxNameDistancePercentage: maxNameDistancePercentage,
+		pendingByAccountID:        pendingByAccountID,
+		matchedIDs:                make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Match returns the pending transaction replaced by posted. Candidates must
+// belong to the same account, have an amount within the tolerance, be dated
+// close to it and have a similar name. The candidate with the most similar
+// name wins, ties are broken by the closest amount and then the closest date.
+func (m *pendingTransactionMatcher) Match(
+	posted openfinance.Transaction,
+) (*entity.Transaction, bool) {
+	if posted.Status == entity.TransactionStatusPending ||
+		posted.AccountID == nil {
+		return nil, false
+	}
+
+	var (
+		best             *entity.Transaction
+		bestNameDistance float64
+		bestAmountDiff   int64
+		bestDateDiff     time.Duration
+	)
+
+	postedName := normalizeTransactionName(posted.Name)
+	candidates := m.pendingByAccountID[*posted.AccountID]
+	for i := range candidates {
+		pending := &candidates[i]
+		if _, ok := m.matchedIDs[pending.ID]; ok {
+			continue
+		}
+
+		dateDiff := posted.Date.Sub(pending.Date).Abs()
+		if dateDiff > pendingMatchMaxDays*24*time.Hour {
+			continue
+		}
+
+		amountDiff, ok := pendingMatchAmountDiff(pending.Amount, posted.Amount)
+		if !ok {
+			continue
+		}
+
+		nameDistance := strutil.LevenshteinDistancePercentage(
+			normalizeTransactionName(pending.Name),
+			postedName,
+		)
+		if nameDistance > m.maxNameDistancePercentage {
+			continue
+		}
+
+		if best != nil && cmp.Or(
+			cmp.Compare(nameDistance, bestNameDistance),
+			cmp.Compare(amountDiff, bestAmountDiff),
+			cmp.Compare(dateDiff, bestDateDiff),
+		) >= 0 {
+			continue
+		}
+
+		best = pending
+		bestNameDistance = nameDistance
+		bestAmountDiff = amountDiff
+		bestDateDiff = dateDiff
+	}
+
+	if best == nil {
+		return nil, false
+	}
+
+	m.matchedIDs[best.ID] = struct{}{}
+	return best, true
+}
+
+// pendingMatchAmountDiff returns the absolute difference between the amounts
+// and whether it is within the tolerance. Amounts with different signs never
+// match, since a refund is not the posting of a purchase.
+func pendingMatchAmountDiff(pendingAmount, postedAmount int64) (int64, bool) {
+	if (pendingAmount < 0) != (postedAmount < 0) {
+		return 0, false
+	}
+
+	diff := abs(postedAmount - pendingAmount)
+	tolerance := float64(abs(pendingAmount)) * pendingMatchAmountTolerance
+
+	return diff, float64(diff) <= tolerance
+}
+
+func normalizeTransactionName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
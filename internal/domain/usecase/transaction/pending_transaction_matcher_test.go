@@ -0,0 +1,261 @@
+package transaction
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	root "github.com/danielmesquitta/api-finance-manager"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+)
+
+// pendingFixtureInternalAccountID is the accounts.id of the fixture account,
+// which differs from its provider id as in the seed data.
+var pendingFixtureInternalAccountID = uuid.MustParse(
+	"2c1f3a51-8a4e-4f7e-9c0b-6d2e8f1a4b73",
+)
+
+const (
+	pendingFixtureAccountID    = "968df837-0305-4381-8b16-98089af01e85"
+	maxNameDistancePercentage  = 0.3
+	pendingFixtureTransactions = "test/data/pluggy/transactions/" +
+		pendingFixtureAccountID + ".json"
+)
+
+func TestPendingTransactionMatcherMatch(t *testing.T) {
+	t.Parallel()
+
+	ofTransactions := toSyncedTransactions(
+		loadFixtureTransactions(t, pendingFixtureTransactions),
+	)
+
+	var pendingOFTransactions, postedOFTransactions []openfinance.Transaction
+	for _, ofTrans := range ofTransactions {
+		if ofTrans.Status == entity.TransactionStatusPending {
+			pendingOFTransactions = append(pendingOFTransactions, ofTrans)
+			continue
+		}
+		postedOFTransactions = append(postedOFTransactions, ofTrans)
+	}
+
+	if len(pendingOFTransactions) == 0 {
+		t.Fatal("fixture has no pending transactions")
+	}
+
+	t.Run("should match posted transactions to the pending ones they replace", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions)
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+
+		// Act & Assert
+		for i, pending := range stored {
+			posted := toPostedReplacement(pendingOFTransactions[i])
+
+			got, ok := m.Match(posted)
+			if asserts.True(ok, "pending %q was not matched", pending.Name) {
+				asserts.Equal(pending.ID, got.ID, "posted %q", posted.Name)
+			}
+		}
+	})
+
+	t.Run("should not match unrelated posted transactions", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions)
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+
+		// Act & Assert
+		for _, posted := range postedOFTransactions {
+			got, ok := m.Match(posted)
+			asserts.False(ok, "posted %q matched pending %v", posted.Name, got)
+		}
+	})
+
+	t.Run("should match amounts adjusted within the tolerance", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions[:1])
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+		posted := toPostedReplacement(pendingOFTransactions[0])
+		posted.Amount += posted.Amount / 20
+
+		// Act
+		got, ok := m.Match(posted)
+
+		// Assert
+		if asserts.True(ok) {
+			asserts.Equal(stored[0].ID, got.ID)
+		}
+	})
+
+	t.Run("should match each pending transaction only once", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions[:1])
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+		posted := toPostedReplacement(pendingOFTransactions[0])
+
+		// Act
+		_, firstOK := m.Match(posted)
+		_, secondOK := m.Match(posted)
+
+		// Assert
+		asserts.True(firstOK)
+		asserts.False(secondOK)
+	})
+
+	t.Run("should not match pending transactions still returned by open finance", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions)
+		m := newPendingTransactionMatcher(
+			stored,
+			pendingOFTransactions,
+			maxNameDistancePercentage,
+		)
+
+		// Act & Assert
+		for _, pending := range pendingOFTransactions {
+			_, ok := m.Match(toPostedReplacement(pending))
+			asserts.False(ok, "pending %q was matched", pending.Name)
+		}
+	})
+
+	t.Run("should not match posted transactions of other accounts", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions)
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+
+		// Act & Assert
+		for _, pending := range pendingOFTransactions {
+			posted := toPostedReplacement(pending)
+			posted.AccountID = new(uuid.UUID)
+			*posted.AccountID = uuid.New()
+
+			_, ok := m.Match(posted)
+			asserts.False(ok, "pending %q was matched", pending.Name)
+		}
+	})
+
+	t.Run("should not match posted transactions keyed by the provider account id", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Arrange
+		stored := toStoredTransactions(pendingOFTransactions)
+		m := newPendingTransactionMatcher(stored, nil, maxNameDistancePercentage)
+		providerAccountID := uuid.MustParse(pendingFixtureAccountID)
+
+		// Act & Assert
+		for _, pending := range pendingOFTransactions {
+			posted := toPostedReplacement(pending)
+			posted.AccountID = &providerAccountID
+
+			_, ok := m.Match(posted)
+			asserts.False(ok, "pending %q was matched", pending.Name)
+		}
+	})
+
+	t.Run("should not match amounts out of the tolerance", func(t *testing.T) {
+		t.Parallel()
+		asserts := assert.New(t)
+
+		// Act & Assert
+		for i, pending := range pendingOFTransactions {
+			stored := toStoredTransactions(pendingOFTransactions[i : i+1])
+			m := newPendingTransactionMatcher(
+				stored,
+				nil,
+				maxNameDistancePercentage,
+			)
+			posted := toPostedReplacement(pending)
+			posted.Amount = pending.Amount * 2
+
+			_, ok := m.Match(posted)
+			asserts.False(ok, "pending %q was matched", pending.Name)
+		}
+	})
+}
+
+func loadFixtureTransactions(
+	t *testing.T,
+	path string,
+) []openfinance.Transaction {
+	t.Helper()
+
+	data, err := root.TestData.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transRes := pluggy.TransactionsResponse{}
+	if err := json.Unmarshal(data, &transRes); err != nil {
+		t.Fatal(err)
+	}
+
+	return pluggy.ParseTransactionsResults(
+		pendingFixtureAccountID,
+		transRes.Results,
+	)
+}
+
+// toStoredTransactions mimics the pending transactions inserted by a
+// previous sync, which are keyed by the internal account id instead of the
+// provider one.
+func toStoredTransactions(
+	ofTransactions []openfinance.Transaction,
+) []entity.Transaction {
+	transactions := make([]entity.Transaction, len(ofTransactions))
+	for i, ofTrans := range ofTransactions {
+		transactions[i] = ofTrans.Transaction
+		transactions[i].ID = uuid.New()
+		transactions[i].AccountID = &pendingFixtureInternalAccountID
+	}
+	return transactions
+}
+
+// toSyncedTransactions mimics how the sync maps the open finance
+// transactions to the internal account id before matching them.
+func toSyncedTransactions(
+	ofTransactions []openfinance.Transaction,
+) []openfinance.Transaction {
+	synced := make([]openfinance.Transaction, len(ofTransactions))
+	for i, ofTrans := range ofTransactions {
+		synced[i] = ofTrans
+		synced[i].AccountID = &pendingFixtureInternalAccountID
+	}
+	return synced
+}
+
+// toPostedReplacement mimics how institutions post a pending transaction: a
+// new external id, a later date and a reformatted name.
+func toPostedReplacement(
+	pending openfinance.Transaction,
+) openfinance.Transaction {
+	posted := pending
+	posted.ExternalID = new(string)
+	*posted.ExternalID = uuid.NewString()
+	posted.Status = entity.TransactionStatusPosted
+	posted.Date = pending.Date.AddDate(0, 0, 1)
+	posted.Name = strings.ToUpper(pending.Name) + " "
+	return posted
+}
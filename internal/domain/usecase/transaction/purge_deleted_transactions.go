@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+)
+
+type PurgeDeletedTransactionsUseCase struct {
+	s  storage.Storage
+	tr repo.TransactionRepo
+	ar repo.AttachmentRepo
+}
+
+func NewPurgeDeletedTransactionsUseCase(
+	s storage.Storage,
+	tr repo.TransactionRepo,
+	ar repo.AttachmentRepo,
+) *PurgeDeletedTransactionsUseCase {
+	return &PurgeDeletedTransactionsUseCase{
+		s:  s,
+		tr: tr,
+		ar: ar,
+	}
+}
+
+// Execute hard-deletes the manual and imported transactions deleted before
+// the trash retention, along with their splits, tags, transfers and
+// attachments. Attachment files are removed from the blob storage first,
+// since their records are deleted in cascade with the transactions. Synced
+// transactions are kept, since their external ids stop the sync from
+// inserting them again.
+func (uc *PurgeDeletedTransactionsUseCase) Execute(
+	ctx context.Context,
+) error {
+	deletedBefore := trashStartDate(time.Now())
+
+	attachments, err := uc.ar.ListAttachmentsByTransactionDeletedBefore(
+		ctx,
+		deletedBefore,
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	for _, a := range attachments {
+		if err := uc.s.Delete(ctx, a.StorageKey); err != nil {
+			return errs.New(err)
+		}
+	}
+
+	if err := uc.tr.PurgeDeletedTransactions(ctx, deletedBefore); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
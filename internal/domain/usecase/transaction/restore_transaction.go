@@ -0,0 +1,63 @@
+package transaction
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+)
+
+type RestoreTransactionUseCase struct {
+	v  *validator.Validator
+	tr repo.TransactionRepo
+}
+
+func NewRestoreTransactionUseCase(
+	v *validator.Validator,
+	tr repo.TransactionRepo,
+) *RestoreTransactionUseCase {
+	return &RestoreTransactionUseCase{
+		v:  v,
+		tr: tr,
+	}
+}
+
+type RestoreTransactionUseCaseInput struct {
+	ID     uuid.UUID `json:"transaction_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id"        validate:"required"`
+}
+
+// Execute brings a transaction in the trash back. Restored synced
+// transactions are no longer hidden, so the next sync updates them again.
+func (uc *RestoreTransactionUseCase) Execute(
+	ctx context.Context,
+	in RestoreTransactionUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	transaction, err := uc.tr.GetDeletedTransactionByID(ctx, in.ID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if transaction == nil ||
+		transaction.UserID != in.UserID ||
+		!isInTrash(
+			transaction.ExternalID,
+			transaction.IsHidden,
+			transaction.DeletedAt,
+			time.Now(),
+		) {
+		return errs.ErrTransactionNotFound
+	}
+
+	if err := uc.tr.RestoreTransaction(ctx, in.ID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package transaction
+
+import (
+	"context"
+	"slices"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+)
+
+type SplitTransactionUseCase struct {
+	v   *validator.Validator
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	tcr repo.TransactionCategoryRepo
+}
+
+func NewSplitTransactionUseCase(
+	v *validator.Validator,
+	tx tx.TX,
+	tr repo.TransactionRepo,
+	tcr repo.TransactionCategoryRepo,
+) *SplitTransactionUseCase {
+	return &SplitTransactionUseCase{
+		v:   v,
+		tx:  tx,
+		tr:  tr,
+		tcr: tcr,
+	}
+}
+
+type SplitTransactionUseCaseSplitInput struct {
+	Amount     int64     `json:"amount"      validate:"required"`
+	CategoryID uuid.UUID `json:"category_id" validate:"required"`
+	Note       *string   `json:"note"`
+}
+
+type SplitTransactionUseCaseInput struct {
+	ID     uuid.UUID                           `json:"-"      validate:"required"`
+	UserID uuid.UUID                           `json:"-"      validate:"required"`
+	Splits []SplitTransactionUseCaseSplitInput `json:"splits" validate:"omitempty,min=2,dive"`
+}
+
+// Execute replaces the splits of a transaction, an empty list removes them.
+// The parts must add up to the transaction amount. Sync never touches the
+// splits, so they are kept when the transaction is updated by the provider.
+func (uc *SplitTransactionUseCase) Execute(
+	ctx context.Context,
+	in SplitTransactionUseCaseInput,
+) ([]entity.TransactionSplit, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	categoryIDs := []uuid.UUID{}
+	for _, s := range in.Splits {
+		if !slices.Contains(categoryIDs, s.CategoryID) {
+			categoryIDs = append(categoryIDs, s.CategoryID)
+		}
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	var (
+		transaction *entity.FullTransaction
+		categories  []entity.TransactionCategory
+	)
+
+	g.Go(func() error {
+		var err error
+		transaction, err = uc.tr.GetTransactionByID(gCtx, in.ID)
+		return err
+	})
+
+	if len(categoryIDs) > 0 {
+		g.Go(func() error {
+			var err error
+			categories, err = uc.tcr.ListTransactionCategories(
+				gCtx,
+				repo.TransactionCategoryOptions{
+					IDs:    categoryIDs,
+					UserID: in.UserID,
+				},
+			)
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if transaction == nil || transaction.UserID != in.UserID {
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	if len(categories) != len(categoryIDs) {
+		return nil, errs.ErrCategoriesNotFound
+	}
+
+	if err := validateSplits(transaction.Amount, in.Splits); err != nil {
+		return nil, errs.New(err)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.tr.DeleteTransactionSplits(ctx, in.ID); err != nil {
+			return errs.New(err)
+		}
+
+		if len(in.Splits) == 0 {
+			return nil
+		}
+
+		params := make([]repo.CreateTransactionSplitsParams, 0, len(in.Splits))
+		for _, s := range in.Splits {
+			params = append(params, repo.CreateTransactionSplitsParams{
+				Amount:        s.Amount,
+				Note:          s.Note,
+				TransactionID: in.ID,
+				CategoryID:    s.CategoryID,
+			})
+		}
+
+		if err := uc.tr.CreateTransactionSplits(ctx, params); err != nil {
+			return errs.New(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	splits, err := uc.tr.ListTransactionSplits(ctx, in.ID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return splits, nil
+}
+
+func validateSplits(
+	amount int64,
+	splits []SplitTransactionUseCaseSplitInput,
+) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	var total int64
+	for _, s := range splits {
+		if (s.Amount < 0) != (amount < 0) {
+			return errs.ErrInvalidTransactionSplitSign
+		}
+		total += s.Amount
+	}
+
+	if total != amount {
+		return errs.ErrInvalidTransactionSplitAmount
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"cmp"
 	"context"
 	"log/slog"
 	"strings"
@@ -12,6 +13,10 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/merchant"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/recurring"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transfer"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
@@ -31,6 +36,12 @@ type SyncTransactionsUseCase struct {
 	tr  repo.TransactionRepo
 	cr  repo.TransactionCategoryRepo
 	pmr repo.PaymentMethodRepo
+	srr repo.SyncRunRepo
+	cbr repo.CreditCardBillRepo
+	rr  repo.RuleRepo
+	mr  repo.MerchantRepo
+	drs *recurring.DetectRecurringSeriesUseCase
+	mt  *transfer.MatchTransfersUseCase
 }
 
 func NewSyncTransactionsUseCase(
@@ -43,6 +54,12 @@ func NewSyncTransactionsUseCase(
 	tr repo.TransactionRepo,
 	cr repo.TransactionCategoryRepo,
 	pmr repo.PaymentMethodRepo,
+	srr repo.SyncRunRepo,
+	cbr repo.CreditCardBillRepo,
+	rr repo.RuleRepo,
+	mr repo.MerchantRepo,
+	drs *recurring.DetectRecurringSeriesUseCase,
+	mt *transfer.MatchTransfersUseCase,
 ) *SyncTransactionsUseCase {
 	return &SyncTransactionsUseCase{
 		e:   e,
@@ -54,23 +71,35 @@ func NewSyncTransactionsUseCase(
 		tr:  tr,
 		cr:  cr,
 		pmr: pmr,
+		srr: srr,
+		cbr: cbr,
+		rr:  rr,
+		mr:  mr,
+		drs: drs,
+		mt:  mt,
 	}
 }
 
 type SyncTransactionsUseCaseInput struct {
-	UserIDs []uuid.UUID `json:"user_ids"`
+	UserIDs            []uuid.UUID `json:"user_ids"`
+	UserInstitutionIDs []uuid.UUID `json:"user_institution_ids"`
+}
+
+type SyncTransactionsUseCaseOutput struct {
+	AccountsProcessed int `json:"accounts_processed"`
 }
 
 func (uc *SyncTransactionsUseCase) Execute(
 	ctx context.Context,
 	in SyncTransactionsUseCaseInput,
-) error {
-	isSyncingAllUsers := len(in.UserIDs) == 0
+) (*SyncTransactionsUseCaseOutput, error) {
+	isSyncingAllUsers := len(in.UserIDs) == 0 && len(in.UserInstitutionIDs) == 0
 	cacheExp := time.Hour * 12
 	offset := 0
 
 	accountOpts := repo.AccountOptions{
 		IsSubscriptionActive: ptr.New(true),
+		IsManual:             ptr.New(false),
 	}
 
 	if isSyncingAllUsers {
@@ -80,12 +109,12 @@ func (uc *SyncTransactionsUseCase) Execute(
 			&offset,
 		)
 		if err != nil {
-			return errs.New(err)
+			return nil, errs.New(err)
 		}
 
 		if offset == -1 {
 			slog.Info("sync-transactions: already completed")
-			return nil
+			return &SyncTransactionsUseCaseOutput{}, nil
 		}
 
 		accountOpts.Limit = uint(uc.e.SyncTransactionsMaxAccounts)
@@ -96,6 +125,10 @@ func (uc *SyncTransactionsUseCase) Execute(
 		accountOpts.UserIDs = in.UserIDs
 	}
 
+	if len(in.UserInstitutionIDs) > 0 {
+		accountOpts.UserInstitutionIDs = in.UserInstitutionIDs
+	}
+
 	g, gCtx := errgroup.WithContext(ctx)
 
 	var (
@@ -123,12 +156,12 @@ func (uc *SyncTransactionsUseCase) Execute(
 	})
 
 	if err := g.Wait(); err != nil {
-		return errs.New(err)
+		return nil, errs.New(err)
 	}
 
 	if len(accounts) == 0 {
 		if !isSyncingAllUsers {
-			return nil
+			return &SyncTransactionsUseCaseOutput{}, nil
 		}
 
 		err := uc.c.Set(
@@ -138,26 +171,34 @@ func (uc *SyncTransactionsUseCase) Execute(
 			cacheExp,
 		)
 		if err != nil {
-			return errs.New(err)
+			return nil, errs.New(err)
 		}
 
 		slog.Info("sync-transactions: completed")
-		return nil
+		return &SyncTransactionsUseCaseOutput{}, nil
+	}
+
+	syncRun, err := uc.srr.CreateSyncRun(ctx)
+	if err != nil {
+		return nil, errs.New(err)
 	}
 
+	syncRunItemsByAccountID := make(
+		map[uuid.UUID]*repo.CreateSyncRunItemsParams,
+		len(accounts),
+	)
 	accountsByUserID := make(map[uuid.UUID][]entity.FullAccount)
 	accountsByID := make(map[uuid.UUID]entity.FullAccount)
 	for _, account := range accounts {
-		if account.UserID == nil {
-			slog.Error(
-				"sync-transactions: account user id is nil",
-				"account", account,
-			)
-			continue
+		syncRunItem := &repo.CreateSyncRunItemsParams{
+			SyncRunID: syncRun.ID,
+			AccountID: account.ID,
+			Status:    entity.SyncRunItemStatusSuccess,
 		}
+		syncRunItemsByAccountID[account.ID] = syncRunItem
 
-		accountsByUserID[*account.UserID] = append(
-			accountsByUserID[*account.UserID],
+		accountsByUserID[account.UserID] = append(
+			accountsByUserID[account.UserID],
 			account,
 		)
 		accountsByID[account.ID] = account
@@ -165,12 +206,16 @@ func (uc *SyncTransactionsUseCase) Execute(
 
 	categoriesByExternalID := make(map[string]entity.TransactionCategory)
 	for _, category := range categories {
-		categoriesByExternalID[category.ExternalID] = category
+		if category.ExternalID == nil {
+			continue
+		}
+		categoriesByExternalID[*category.ExternalID] = category
 	}
 
 	openFinanceTransactionsByAccountID := make(
 		map[uuid.UUID][]openfinance.Transaction,
 	)
+	billIDsByExternalID := make(map[string]uuid.UUID)
 	for userID, userAccounts := range accountsByUserID {
 		if len(userAccounts) == 0 {
 			continue
@@ -196,19 +241,53 @@ func (uc *SyncTransactionsUseCase) Execute(
 					"err",
 					err,
 				)
+				failSyncRunItem(
+					syncRunItemsByAccountID[account.ID],
+					"error fetching open finance transactions: "+err.Error(),
+				)
 				continue
 			}
+
+			// Open finance transactions carry the provider account id, stored
+			// transactions and the pending matcher use the internal one
+			for i := range ofTransactions {
+				ofTransactions[i].AccountID = &account.ID
+			}
 			openFinanceTransactionsByAccountID[account.ID] = ofTransactions
+			syncRunItemsByAccountID[account.ID].Fetched = int64(
+				len(ofTransactions),
+			)
+
+			if account.Type != entity.AccountTypeCredit {
+				continue
+			}
+
+			if err := uc.syncCreditCardBills(
+				ctx,
+				account,
+				ofTransactions,
+				billIDsByExternalID,
+			); err != nil {
+				slog.Error(
+					"sync-transactions: error syncing credit card bills",
+					"user",
+					userID,
+					"account",
+					account,
+					"err",
+					err,
+				)
+			}
 		}
 	}
 
-	paymentMethods, err := uc.syncPaymentMethods(
+	paymentMethods, err = uc.syncPaymentMethods(
 		ctx,
 		openFinanceTransactionsByAccountID,
 		paymentMethods,
 	)
 	if err != nil {
-		return errs.New(err)
+		return nil, errs.New(err)
 	}
 
 	paymentMethodsByExternalID := make(map[string]entity.PaymentMethod)
@@ -216,6 +295,11 @@ func (uc *SyncTransactionsUseCase) Execute(
 		paymentMethodsByExternalID[pm.ExternalID] = pm
 	}
 
+	// Syncing only some of the user institutions must not move the user
+	// synchronized at forward, otherwise the accounts of the other
+	// institutions would miss transactions on their next sync.
+	shouldUpdateSynchronizedAt := len(in.UserInstitutionIDs) == 0
+
 	for userID, userAccounts := range accountsByUserID {
 		if len(userAccounts) == 0 {
 			continue
@@ -229,10 +313,13 @@ func (uc *SyncTransactionsUseCase) Execute(
 			ctx,
 			userID,
 			lastSynchronizedAt,
+			shouldUpdateSynchronizedAt,
 			accountsByID,
 			categoriesByExternalID,
 			paymentMethodsByExternalID,
 			openFinanceTransactionsByAccountID,
+			billIDsByExternalID,
+			syncRunItemsByAccountID,
 		); err != nil {
 			slog.Error(
 				"sync-transactions: error syncing user transactions",
@@ -241,8 +328,48 @@ func (uc *SyncTransactionsUseCase) Execute(
 				"categories", categories,
 				"err", err,
 			)
+			for _, account := range userAccounts {
+				failSyncRunItem(
+					syncRunItemsByAccountID[account.ID],
+					"error saving transactions: "+err.Error(),
+				)
+			}
 			continue
 		}
+
+		if err := uc.mt.Execute(
+			ctx,
+			transfer.MatchTransfersUseCaseInput{UserID: userID},
+		); err != nil {
+			slog.Error(
+				"sync-transactions: error matching transfers",
+				"user_id", userID,
+				"err", err,
+			)
+		}
+
+		if err := uc.drs.Execute(
+			ctx,
+			recurring.DetectRecurringSeriesUseCaseInput{UserID: userID},
+		); err != nil {
+			slog.Error(
+				"sync-transactions: error detecting recurring series",
+				"user_id", userID,
+				"err", err,
+			)
+		}
+	}
+
+	if err := uc.finishSyncRun(
+		ctx,
+		syncRun.ID,
+		syncRunItemsByAccountID,
+	); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := &SyncTransactionsUseCaseOutput{
+		AccountsProcessed: len(accounts),
 	}
 
 	if isSyncingAllUsers {
@@ -254,11 +381,11 @@ func (uc *SyncTransactionsUseCase) Execute(
 				cacheExp,
 			)
 			if err != nil {
-				return errs.New(err)
+				return nil, errs.New(err)
 			}
 
 			slog.Info("sync-transactions: completed")
-			return nil
+			return out, nil
 		}
 
 		offset += uc.e.SyncTransactionsMaxAccounts
@@ -269,11 +396,11 @@ func (uc *SyncTransactionsUseCase) Execute(
 			cacheExp,
 		)
 		if err != nil {
-			return errs.New(err)
+			return nil, errs.New(err)
 		}
 	}
 
-	return nil
+	return out, nil
 }
 
 func (uc *SyncTransactionsUseCase) syncPaymentMethods(
@@ -326,20 +453,73 @@ func (uc *SyncTransactionsUseCase) syncUserTransactions(
 	ctx context.Context,
 	userID uuid.UUID,
 	lastSynchronizedAt time.Time,
+	shouldUpdateSynchronizedAt bool,
 	accountsByID map[uuid.UUID]entity.FullAccount,
 	categoriesByExternalID map[string]entity.TransactionCategory,
 	paymentMethodsByExternalID map[string]entity.PaymentMethod,
 	openFinanceTransactionsByAccountID map[uuid.UUID][]openfinance.Transaction,
+	billIDsByExternalID map[string]uuid.UUID,
+	syncRunItemsByAccountID map[uuid.UUID]*repo.CreateSyncRunItemsParams,
 ) error {
-	transactions, err := uc.listRepoTransactions(
-		ctx,
-		userID,
-		lastSynchronizedAt,
+	g, gCtx := errgroup.WithContext(ctx)
+
+	var (
+		transactions      []entity.Transaction
+		rules             []entity.Rule
+		hiddenCategories  []entity.HiddenCategory
+		hiddenExternalIDs []string
+		splitIDs          []uuid.UUID
 	)
-	if err != nil {
+
+	g.Go(func() error {
+		var err error
+		transactions, err = uc.listRepoTransactions(
+			gCtx,
+			userID,
+			lastSynchronizedAt,
+		)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		rules, err = uc.rr.ListRules(gCtx, repo.RuleOptions{UserID: userID})
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		hiddenCategories, err = uc.cr.ListHiddenCategories(gCtx, userID)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		hiddenExternalIDs, err = uc.tr.ListHiddenTransactionExternalIDs(
+			gCtx,
+			userID,
+		)
+		return err
+	})
+
+	g.Go(func() error {
+		var err error
+		splitIDs, err = uc.tr.ListSplitTransactionIDs(gCtx, userID)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
 		return errs.New(err)
 	}
 
+	mergedCategoryIDs := make(map[uuid.UUID]uuid.UUID)
+	for _, c := range hiddenCategories {
+		if c.MergedIntoID == nil {
+			continue
+		}
+		mergedCategoryIDs[c.CategoryID] = *c.MergedIntoID
+	}
+
 	transactionsByExternalID := make(
 		map[string]entity.Transaction,
 		len(transactions),
@@ -351,40 +531,176 @@ func (uc *SyncTransactionsUseCase) syncUserTransactions(
 		transactionsByExternalID[*t.ExternalID] = t
 	}
 
-	params := uc.buildCreateTransactionsParams(
+	hiddenExternalIDsSet := make(map[string]struct{}, len(hiddenExternalIDs))
+	for _, externalID := range hiddenExternalIDs {
+		hiddenExternalIDsSet[externalID] = struct{}{}
+	}
+
+	splitIDsSet := make(map[uuid.UUID]struct{}, len(splitIDs))
+	for _, id := range splitIDs {
+		splitIDsSet[id] = struct{}{}
+	}
+
+	var userOFTransactions []openfinance.Transaction
+	for accountID, ofTransactions := range openFinanceTransactionsByAccountID {
+		account, ok := accountsByID[accountID]
+		if !ok || account.UserID != userID {
+			continue
+		}
+		userOFTransactions = append(userOFTransactions, ofTransactions...)
+	}
+
+	pendingMatcher := newPendingTransactionMatcher(
+		transactions,
+		userOFTransactions,
+		uc.e.MaxLevenshteinDistancePercentage,
+	)
+
+	ruleEngine := rule.NewEngine(rules, uc.e.MaxLevenshteinDistancePercentage)
+
+	merchantsByExternalID := uc.syncMerchants(ctx, userID, userOFTransactions)
+
+	params, reconcileParams, externalIDsByTagID := uc.buildSyncTransactionsParams(
 		userID,
 		accountsByID,
 		categoriesByExternalID,
+		mergedCategoryIDs,
 		paymentMethodsByExternalID,
 		openFinanceTransactionsByAccountID,
 		transactionsByExternalID,
+		hiddenExternalIDsSet,
+		splitIDsSet,
+		billIDsByExternalID,
+		merchantsByExternalID,
+		pendingMatcher,
+		ruleEngine,
+		syncRunItemsByAccountID,
 	)
 
-	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+	deleteIDs := listRemovedTransactionIDs(
+		transactions,
+		openFinanceTransactionsByAccountID,
+		reconcileParams,
+		lastSynchronizedAt,
+	)
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		for _, p := range reconcileParams {
+			if err := uc.tr.ReconcileTransaction(ctx, p); err != nil {
+				return errs.New(err)
+			}
+		}
+		if len(deleteIDs) > 0 {
+			if err := uc.tr.DeleteTransactions(ctx, deleteIDs); err != nil {
+				return errs.New(err)
+			}
+		}
 		if err := uc.tr.CreateTransactions(ctx, params); err != nil {
 			return errs.New(err)
 		}
+		for tagID, externalIDs := range externalIDsByTagID {
+			if err := uc.tr.TagTransactionsByExternalIDs(
+				ctx,
+				repo.TagTransactionsByExternalIDsParams{
+					TagID:       tagID,
+					UserID:      userID,
+					ExternalIds: externalIDs,
+				},
+			); err != nil {
+				return errs.New(err)
+			}
+		}
+		if !shouldUpdateSynchronizedAt {
+			return nil
+		}
 		return uc.updateUserSynchronizedAt(ctx, userID)
 	})
 	if err != nil {
 		return errs.New(err)
 	}
 
+	accountIDsByTransactionID := make(
+		map[uuid.UUID]*uuid.UUID,
+		len(transactions),
+	)
+	for _, t := range transactions {
+		accountIDsByTransactionID[t.ID] = t.AccountID
+	}
+
+	for _, p := range params {
+		if syncRunItem := findSyncRunItem(
+			syncRunItemsByAccountID,
+			p.AccountID,
+		); syncRunItem != nil {
+			syncRunItem.Inserted++
+		}
+	}
+
+	for _, p := range reconcileParams {
+		if syncRunItem := findSyncRunItem(
+			syncRunItemsByAccountID,
+			accountIDsByTransactionID[p.ID],
+		); syncRunItem != nil {
+			syncRunItem.Updated++
+		}
+	}
+
+	for _, id := range deleteIDs {
+		if syncRunItem := findSyncRunItem(
+			syncRunItemsByAccountID,
+			accountIDsByTransactionID[id],
+		); syncRunItem != nil {
+			syncRunItem.Deleted++
+		}
+	}
+
 	return nil
 }
 
-func (uc *SyncTransactionsUseCase) buildCreateTransactionsParams(
+// buildSyncTransactionsParams splits the open finance transactions of the
+// user into the ones to insert and the stored transactions to update with
+// the provider data. Stored transactions are the ones with the same external
+// id or, for posted transactions, the pending ones they replace, so a
+// purchase is never listed twice. Transactions the user has hidden are
+// skipped, so they are not inserted again. Transactions the provider could
+// not categorise take the category of their merchant. Categories the user
+// has merged are replaced by the ones they were merged into, and the user
+// rules are applied before the stored transactions are compared. Unchanged
+// transactions are skipped. The tags set by the rules on new transactions
+// are returned by tag, as the external ids to tag once they are inserted.
+func (uc *SyncTransactionsUseCase) buildSyncTransactionsParams(
 	userID uuid.UUID,
 	accountsByID map[uuid.UUID]entity.FullAccount,
 	categoriesByExternalID map[string]entity.TransactionCategory,
+	mergedCategoryIDs map[uuid.UUID]uuid.UUID,
 	paymentMethodsByExternalID map[string]entity.PaymentMethod,
 	openFinanceTransactionsByAccountID map[uuid.UUID][]openfinance.Transaction,
 	transactionsByExternalID map[string]entity.Transaction,
-) []repo.CreateTransactionsParams {
-	var params []repo.CreateTransactionsParams
+	hiddenExternalIDs map[string]struct{},
+	splitIDs map[uuid.UUID]struct{},
+	billIDsByExternalID map[string]uuid.UUID,
+	merchantsByExternalID map[string]transactionMerchant,
+	pendingMatcher *pendingTransactionMatcher,
+	ruleEngine *rule.Engine,
+	syncRunItemsByAccountID map[uuid.UUID]*repo.CreateSyncRunItemsParams,
+) (
+	[]repo.CreateTransactionsParams,
+	[]repo.ReconcileTransactionParams,
+	map[uuid.UUID][]string,
+) {
+	var (
+		params             []repo.CreateTransactionsParams
+		reconcileParams    []repo.ReconcileTransactionParams
+		externalIDsByTagID = map[uuid.UUID][]string{}
+	)
 
 	for accountID, ofTransactions := range openFinanceTransactionsByAccountID {
-		account := accountsByID[accountID]
+		account, ok := accountsByID[accountID]
+		if !ok || account.UserID != userID {
+			continue
+		}
+
+		syncRunItem := syncRunItemsByAccountID[accountID]
 
 		for _, ofTrans := range ofTransactions {
 			if ofTrans.ExternalID == nil {
@@ -395,10 +711,12 @@ func (uc *SyncTransactionsUseCase) buildCreateTransactionsParams(
 					"user_id",
 					userID,
 				)
+				syncRunItem.Skipped++
 				continue
 			}
 
-			if _, ok := transactionsByExternalID[*ofTrans.ExternalID]; ok {
+			if _, ok := hiddenExternalIDs[*ofTrans.ExternalID]; ok {
+				syncRunItem.Skipped++
 				continue
 			}
 
@@ -407,16 +725,23 @@ func (uc *SyncTransactionsUseCase) buildCreateTransactionsParams(
 				categoriesByExternalID,
 			)
 
-			category, ok := categoriesByExternalID[categoryParentExternalID]
-			if !ok {
-				slog.Error(
-					"sync-transactions: category not found",
-					"category_external_id",
-					ofTrans.CategoryExternalID,
-					"parent_category_external_id",
-					categoryParentExternalID,
+			m, hasMerchant := merchantsByExternalID[*ofTrans.ExternalID]
+			if hasMerchant && m.CategoryExternalID != "" &&
+				shouldUseMerchantCategory(ofTrans, categoryParentExternalID) {
+				categoryParentExternalID = uc.o.GetCategoryParentExternalID(
+					m.CategoryExternalID,
+					categoriesByExternalID,
 				)
-				return nil
+			}
+
+			var merchantID *uuid.UUID
+			if hasMerchant {
+				merchantID = &m.ID
+			}
+
+			categoryID := categoriesByExternalID[categoryParentExternalID].ID
+			if mergedID, ok := mergedCategoryIDs[categoryID]; ok {
+				categoryID = mergedID
 			}
 
 			pm := paymentMethodsByExternalID[ofTrans.PaymentMethodExternalID]
@@ -426,22 +751,313 @@ func (uc *SyncTransactionsUseCase) buildCreateTransactionsParams(
 				categoryParentExternalID,
 			)
 
-			params = append(params, repo.CreateTransactionsParams{
-				ExternalID:      ofTrans.ExternalID,
+			target := rule.Target{
 				Name:            ofTrans.Name,
 				Amount:          ofTrans.Amount,
 				PaymentMethodID: pm.ID,
-				Date:            ofTrans.Date,
-				UserID:          userID,
-				AccountID:       &account.ID,
 				InstitutionID:   account.InstitutionID,
-				CategoryID:      category.ID,
+				CategoryID:      categoryID,
 				IsIgnored:       isIgnored,
+			}
+			ruleEngine.Apply(&target)
+
+			var billID *uuid.UUID
+			if ofTrans.BillExternalID != nil {
+				if id, ok := billIDsByExternalID[*ofTrans.BillExternalID]; ok {
+					billID = &id
+				}
+			}
+
+			stored, isStored := transactionsByExternalID[*ofTrans.ExternalID]
+			if !isStored {
+				// Stored pending transactions were renamed by the same rules.
+				posted := ofTrans
+				posted.Name = target.Name
+				if pending, ok := pendingMatcher.Match(posted); ok {
+					stored, isStored = *pending, true
+				}
+			}
+
+			if isStored {
+				p := repo.ReconcileTransactionParams{
+					ID:                stored.ID,
+					ExternalID:        ofTrans.ExternalID,
+					Name:              target.Name,
+					Amount:            ofTrans.Amount,
+					Date:              ofTrans.Date,
+					Status:            ofTrans.Status,
+					PurchaseDate:      ofTrans.PurchaseDate,
+					InstallmentNumber: ofTrans.InstallmentNumber,
+					TotalInstallments: ofTrans.TotalInstallments,
+					BillID:            ptr.First(billID, stored.BillID),
+					MerchantID:        ptr.First(merchantID, stored.MerchantID),
+					PaymentMethodID:   pm.ID,
+					CategoryID:        target.CategoryID,
+					IsIgnored:         target.IsIgnored,
+				}
+				if pm.ID == uuid.Nil {
+					p.PaymentMethodID = stored.PaymentMethodID
+				}
+				if target.CategoryID == uuid.Nil {
+					p.CategoryID = stored.CategoryID
+					p.IsIgnored = stored.IsIgnored
+				}
+				_, hasSplits := splitIDs[stored.ID]
+				applyTransactionOverrides(&p, stored, hasSplits)
+
+				if !hasTransactionChanges(stored, p) {
+					syncRunItem.Skipped++
+					continue
+				}
+
+				reconcileParams = append(reconcileParams, p)
+				continue
+			}
+
+			if target.CategoryID == uuid.Nil {
+				slog.Error(
+					"sync-transactions: category not found",
+					"category_external_id",
+					ofTrans.CategoryExternalID,
+					"parent_category_external_id",
+					categoryParentExternalID,
+				)
+				syncRunItem.Skipped++
+				continue
+			}
+
+			params = append(params, repo.CreateTransactionsParams{
+				ExternalID:        ofTrans.ExternalID,
+				Name:              target.Name,
+				Amount:            ofTrans.Amount,
+				PaymentMethodID:   pm.ID,
+				Date:              ofTrans.Date,
+				UserID:            userID,
+				AccountID:         &account.ID,
+				InstitutionID:     account.InstitutionID,
+				CategoryID:        target.CategoryID,
+				IsIgnored:         target.IsIgnored,
+				PurchaseDate:      ofTrans.PurchaseDate,
+				InstallmentNumber: ofTrans.InstallmentNumber,
+				TotalInstallments: ofTrans.TotalInstallments,
+				BillID:            billID,
+				MerchantID:        merchantID,
+				Status:            ofTrans.Status,
 			})
+
+			if target.TagID != nil {
+				externalIDsByTagID[*target.TagID] = append(
+					externalIDsByTagID[*target.TagID],
+					*ofTrans.ExternalID,
+				)
+			}
+		}
+	}
+
+	return params, reconcileParams, externalIDsByTagID
+}
+
+// applyTransactionOverrides keeps the stored value of every field the user
+// has edited, so user changes always win over the provider data. The amount
+// of a transaction with splits is kept too, since the splits must add up to
+// it, until the user splits it again.
+func applyTransactionOverrides(
+	p *repo.ReconcileTransactionParams,
+	stored entity.Transaction,
+	hasSplits bool,
+) {
+	if stored.IsNameOverridden {
+		p.Name = stored.Name
+	}
+	if stored.IsAmountOverridden || hasSplits {
+		p.Amount = stored.Amount
+	}
+	if stored.IsDateOverridden {
+		p.Date = stored.Date
+	}
+	if stored.IsCategoryOverridden {
+		p.CategoryID = stored.CategoryID
+		p.IsIgnored = stored.IsIgnored
+	}
+	if stored.IsPaymentMethodOverridden {
+		p.PaymentMethodID = stored.PaymentMethodID
+	}
+}
+
+func hasTransactionChanges(
+	stored entity.Transaction,
+	p repo.ReconcileTransactionParams,
+) bool {
+	return ptr.Deref(stored.ExternalID) != ptr.Deref(p.ExternalID) ||
+		stored.Name != p.Name ||
+		stored.Amount != p.Amount ||
+		!stored.Date.Equal(p.Date) ||
+		stored.Status != p.Status ||
+		!isSameTime(stored.PurchaseDate, p.PurchaseDate) ||
+		!isSameValue(stored.InstallmentNumber, p.InstallmentNumber) ||
+		!isSameValue(stored.TotalInstallments, p.TotalInstallments) ||
+		!isSameValue(stored.BillID, p.BillID) ||
+		!isSameValue(stored.MerchantID, p.MerchantID) ||
+		stored.PaymentMethodID != p.PaymentMethodID ||
+		stored.CategoryID != p.CategoryID ||
+		stored.IsIgnored != p.IsIgnored
+}
+
+func isSameValue[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func isSameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// listRemovedTransactionIDs returns the stored transactions dated inside the
+// sync window that open finance no longer returns for their account. Accounts
+// that failed to fetch or came back empty are left untouched, since that is
+// more likely a provider hiccup than every transaction being removed.
+func listRemovedTransactionIDs(
+	transactions []entity.Transaction,
+	openFinanceTransactionsByAccountID map[uuid.UUID][]openfinance.Transaction,
+	reconcileParams []repo.ReconcileTransactionParams,
+	lastSynchronizedAt time.Time,
+) []uuid.UUID {
+	returnedExternalIDs := make(map[string]struct{})
+	for _, ofTransactions := range openFinanceTransactionsByAccountID {
+		for _, ofTrans := range ofTransactions {
+			if ofTrans.ExternalID == nil {
+				continue
+			}
+			returnedExternalIDs[*ofTrans.ExternalID] = struct{}{}
+		}
+	}
+
+	reconciledIDs := make(map[uuid.UUID]struct{}, len(reconcileParams))
+	for _, p := range reconcileParams {
+		reconciledIDs[p.ID] = struct{}{}
+	}
+
+	var ids []uuid.UUID
+	for _, t := range transactions {
+		if t.ExternalID == nil || t.AccountID == nil ||
+			t.Date.Before(lastSynchronizedAt) {
+			continue
+		}
+
+		if len(openFinanceTransactionsByAccountID[*t.AccountID]) == 0 {
+			continue
+		}
+
+		if _, ok := returnedExternalIDs[*t.ExternalID]; ok {
+			continue
+		}
+
+		if _, ok := reconciledIDs[t.ID]; ok {
+			continue
+		}
+
+		ids = append(ids, t.ID)
+	}
+
+	return ids
+}
+
+func findSyncRunItem(
+	syncRunItemsByAccountID map[uuid.UUID]*repo.CreateSyncRunItemsParams,
+	accountID *uuid.UUID,
+) *repo.CreateSyncRunItemsParams {
+	if accountID == nil {
+		return nil
+	}
+	return syncRunItemsByAccountID[*accountID]
+}
+
+// syncCreditCardBills upserts the bills of a credit card account and adds
+// their ids to billIDsByExternalID. Open finance does not expose when a bill
+// closes, so the closing date is estimated as the date of the latest
+// transaction posted to it.
+func (uc *SyncTransactionsUseCase) syncCreditCardBills(
+	ctx context.Context,
+	account entity.FullAccount,
+	ofTransactions []openfinance.Transaction,
+	billIDsByExternalID map[string]uuid.UUID,
+) error {
+	bills, err := uc.o.ListBills(ctx, account.ExternalID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	closingDatesByBillExternalID := make(map[string]time.Time)
+	for _, ofTrans := range ofTransactions {
+		if ofTrans.BillExternalID == nil {
+			continue
+		}
+		closingDate := closingDatesByBillExternalID[*ofTrans.BillExternalID]
+		if ofTrans.Date.After(closingDate) {
+			closingDatesByBillExternalID[*ofTrans.BillExternalID] = ofTrans.Date
 		}
 	}
 
-	return params
+	for _, bill := range bills {
+		params := repo.UpsertCreditCardBillParams{
+			ExternalID:  bill.ExternalID,
+			DueDate:     bill.DueDate,
+			TotalAmount: bill.TotalAmount,
+			AccountID:   account.ID,
+		}
+		if closingDate, ok := closingDatesByBillExternalID[bill.ExternalID]; ok {
+			params.ClosingDate = &closingDate
+		}
+
+		creditCardBill, err := uc.cbr.UpsertCreditCardBill(ctx, params)
+		if err != nil {
+			return errs.New(err)
+		}
+
+		billIDsByExternalID[bill.ExternalID] = creditCardBill.ID
+	}
+
+	return nil
+}
+
+func (uc *SyncTransactionsUseCase) finishSyncRun(
+	ctx context.Context,
+	syncRunID uuid.UUID,
+	syncRunItemsByAccountID map[uuid.UUID]*repo.CreateSyncRunItemsParams,
+) error {
+	params := make(
+		[]repo.CreateSyncRunItemsParams,
+		0,
+		len(syncRunItemsByAccountID),
+	)
+	for _, syncRunItem := range syncRunItemsByAccountID {
+		params = append(params, *syncRunItem)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		if err := uc.srr.CreateSyncRunItems(ctx, params); err != nil {
+			return errs.New(err)
+		}
+		return uc.srr.FinishSyncRun(ctx, syncRunID)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func failSyncRunItem(
+	syncRunItem *repo.CreateSyncRunItemsParams,
+	reason string,
+) {
+	syncRunItem.Status = entity.SyncRunItemStatusFailed
+	syncRunItem.FailureReason = &reason
 }
 
 func (uc *SyncTransactionsUseCase) calculateLastSynchronizedAt(
@@ -480,7 +1096,9 @@ func (uc *SyncTransactionsUseCase) listRepoTransactions(
 ) ([]entity.Transaction, error) {
 	var opts repo.TransactionOptions
 	if !lastSynchronizedAt.IsZero() {
-		opts.StartDate = lastSynchronizedAt
+		// Pending transactions dated before the last sync can still be
+		// replaced by posted ones, so they must be available for matching.
+		opts.StartDate = lastSynchronizedAt.AddDate(0, 0, -pendingMatchMaxDays)
 	}
 
 	txs, err := uc.tr.ListTransactions(ctx, userID, opts)
@@ -511,6 +1129,111 @@ func (uc *SyncTransactionsUseCase) updateUserSynchronizedAt(
 	return nil
 }
 
+// transactionMerchant is the stored merchant of an open finance transaction
+// and the category of its MCC or brand.
+type transactionMerchant struct {
+	ID                 uuid.UUID
+	CategoryExternalID string
+}
+
+// syncMerchants normalises the merchant of each open finance transaction and
+// upserts it, returning the merchants by transaction external id. Merchants
+// only enrich the transactions, so failing to store one is logged and the
+// transactions are synced without it.
+func (uc *SyncTransactionsUseCase) syncMerchants(
+	ctx context.Context,
+	userID uuid.UUID,
+	ofTransactions []openfinance.Transaction,
+) map[string]transactionMerchant {
+	merchantsByKey := map[string]merchant.Merchant{}
+	keysByExternalID := map[string]string{}
+	for _, ofTrans := range ofTransactions {
+		if ofTrans.ExternalID == nil {
+			continue
+		}
+
+		m, ok := merchant.Normalize(merchant.Input{
+			Name:                 ofTrans.Name,
+			RawName:              ofTrans.RawName,
+			CounterpartyName:     ofTrans.CounterpartyName,
+			CounterpartyDocument: ofTrans.CounterpartyDocument,
+			MCC:                  ofTrans.MCC,
+		})
+		if !ok {
+			continue
+		}
+
+		// Keep the category of the first MCC seen for the merchant, since
+		// transactions from the same merchant may have different MCCs.
+		if stored, ok := merchantsByKey[m.Key]; ok {
+			m.CategoryExternalID = cmp.Or(
+				stored.CategoryExternalID,
+				m.CategoryExternalID,
+			)
+		}
+		merchantsByKey[m.Key] = m
+		keysByExternalID[*ofTrans.ExternalID] = m.Key
+	}
+
+	idsByKey := make(map[string]uuid.UUID, len(merchantsByKey))
+	for key, m := range merchantsByKey {
+		stored, err := uc.mr.UpsertMerchant(ctx, repo.UpsertMerchantParams{
+			Key:      m.Key,
+			Name:     m.Name,
+			Document: m.Document,
+			Mcc:      m.MCC,
+			Logo:     m.Logo,
+		})
+		if err != nil {
+			slog.Error(
+				"sync-transactions: failed to upsert merchant",
+				"merchant_key",
+				key,
+				"user_id",
+				userID,
+				"err",
+				err,
+			)
+			continue
+		}
+		idsByKey[key] = stored.ID
+	}
+
+	merchantsByExternalID := make(
+		map[string]transactionMerchant,
+		len(keysByExternalID),
+	)
+	for externalID, key := range keysByExternalID {
+		id, ok := idsByKey[key]
+		if !ok {
+			continue
+		}
+		merchantsByExternalID[externalID] = transactionMerchant{
+			ID:                 id,
+			CategoryExternalID: merchantsByKey[key].CategoryExternalID,
+		}
+	}
+
+	return merchantsByExternalID
+}
+
+// shouldUseMerchantCategory reports whether the merchant category is better
+// than the provider one: when the provider could not categorise the
+// transaction, or categorised a card purchase as a transfer.
+func shouldUseMerchantCategory(
+	ofTrans openfinance.Transaction,
+	categoryParentExternalID string,
+) bool {
+	const (
+		otherCategoryExternalID     = "99999999"
+		transfersCategoryExternalID = "05000000"
+	)
+
+	return categoryParentExternalID == otherCategoryExternalID ||
+		(ofTrans.MCC != nil &&
+			categoryParentExternalID[:2] == transfersCategoryExternalID[:2])
+}
+
 func (uc *SyncTransactionsUseCase) shouldIgnoreTransaction(
 	transactionName string,
 	categoryParentExternalID string,
@@ -0,0 +1,206 @@
+package transaction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+func TestApplyTransactionOverrides(t *testing.T) {
+	t.Parallel()
+	asserts := assert.New(t)
+
+	// Arrange
+	stored := entity.Transaction{
+		ID:                 uuid.New(),
+		ExternalID:         ptr.New("external-id"),
+		Name:               "Almoço com a equipe",
+		Amount:             -5000,
+		Date:               time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC),
+		Status:             entity.TransactionStatusPosted,
+		CategoryID:         uuid.New(),
+		PaymentMethodID:    uuid.New(),
+		IsNameOverridden:   true,
+		IsAmountOverridden: true,
+	}
+
+	p := repo.ReconcileTransactionParams{
+		ID:              stored.ID,
+		ExternalID:      stored.ExternalID,
+		Name:            "RESTAURANTE CENTRO",
+		Amount:          -4500,
+		Date:            stored.Date,
+		Status:          stored.Status,
+		CategoryID:      uuid.New(),
+		PaymentMethodID: stored.PaymentMethodID,
+	}
+	providerCategoryID := p.CategoryID
+
+	// Act
+	applyTransactionOverrides(&p, stored, false)
+
+	// Assert
+	asserts.Equal(stored.Name, p.Name)
+	asserts.Equal(stored.Amount, p.Amount)
+	asserts.Equal(providerCategoryID, p.CategoryID)
+	asserts.True(hasTransactionChanges(stored, p))
+
+	p.CategoryID = stored.CategoryID
+	asserts.False(hasTransactionChanges(stored, p))
+}
+
+func TestApplyTransactionOverridesWithSplits(t *testing.T) {
+	t.Parallel()
+	asserts := assert.New(t)
+
+	// Arrange
+	stored := entity.Transaction{
+		ID:              uuid.New(),
+		ExternalID:      ptr.New("external-id"),
+		Name:            "Mercado",
+		Amount:          -10000,
+		Date:            time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC),
+		Status:          entity.TransactionStatusPending,
+		CategoryID:      uuid.New(),
+		PaymentMethodID: uuid.New(),
+	}
+
+	p := repo.ReconcileTransactionParams{
+		ID:              stored.ID,
+		ExternalID:      stored.ExternalID,
+		Name:            "MERCADO CENTRAL",
+		Amount:          -10850,
+		Date:            stored.Date,
+		Status:          entity.TransactionStatusPosted,
+		CategoryID:      stored.CategoryID,
+		PaymentMethodID: stored.PaymentMethodID,
+	}
+
+	// Act
+	applyTransactionOverrides(&p, stored, true)
+
+	// Assert
+	asserts.Equal(stored.Amount, p.Amount, "splits must keep adding up")
+	asserts.Equal("MERCADO CENTRAL", p.Name)
+	asserts.Equal(entity.TransactionStatusPosted, p.Status)
+	asserts.True(hasTransactionChanges(stored, p))
+}
+
+func TestApplyTransactionOverridesKeepsIgnored(t *testing.T) {
+	t.Parallel()
+	asserts := assert.New(t)
+
+	// Arrange
+	stored := entity.Transaction{
+		ID:                   uuid.New(),
+		ExternalID:           ptr.New("external-id"),
+		Name:                 "Transferência",
+		Amount:               -20000,
+		Date:                 time.Date(2025, 4, 10, 12, 0, 0, 0, time.UTC),
+		Status:               entity.TransactionStatusPosted,
+		CategoryID:           uuid.New(),
+		PaymentMethodID:      uuid.New(),
+		IsIgnored:            true,
+		IsCategoryOverridden: true,
+	}
+
+	p := repo.ReconcileTransactionParams{
+		ID:              stored.ID,
+		ExternalID:      stored.ExternalID,
+		Name:            stored.Name,
+		Amount:          stored.Amount,
+		Date:            stored.Date,
+		Status:          stored.Status,
+		CategoryID:      uuid.New(),
+		PaymentMethodID: stored.PaymentMethodID,
+		IsIgnored:       false,
+	}
+
+	// Act
+	applyTransactionOverrides(&p, stored, false)
+
+	// Assert
+	asserts.True(p.IsIgnored)
+	asserts.Equal(stored.CategoryID, p.CategoryID)
+	asserts.False(hasTransactionChanges(stored, p))
+}
+
+func TestListRemovedTransactionIDs(t *testing.T) {
+	t.Parallel()
+	asserts := assert.New(t)
+
+	// Arrange
+	lastSynchronizedAt := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	accountID := uuid.New()
+	emptyAccountID := uuid.New()
+
+	newTransaction := func(
+		externalID string,
+		accountID uuid.UUID,
+		date time.Time,
+	) entity.Transaction {
+		return entity.Transaction{
+			ID:         uuid.New(),
+			ExternalID: ptr.New(externalID),
+			AccountID:  &accountID,
+			Date:       date,
+		}
+	}
+
+	returned := newTransaction("returned", accountID, lastSynchronizedAt)
+	removed := newTransaction("removed", accountID, lastSynchronizedAt)
+	reconciled := newTransaction("reconciled", accountID, lastSynchronizedAt)
+	beforeWindow := newTransaction(
+		"before-window",
+		accountID,
+		lastSynchronizedAt.AddDate(0, 0, -1),
+	)
+	emptyAccount := newTransaction(
+		"empty-account",
+		emptyAccountID,
+		lastSynchronizedAt,
+	)
+	manual := entity.Transaction{
+		ID:        uuid.New(),
+		AccountID: &accountID,
+		Date:      lastSynchronizedAt,
+	}
+
+	transactions := []entity.Transaction{
+		returned,
+		removed,
+		reconciled,
+		beforeWindow,
+		emptyAccount,
+		manual,
+	}
+
+	ofTransactionsByAccountID := map[uuid.UUID][]openfinance.Transaction{
+		accountID: {
+			{Transaction: entity.Transaction{ExternalID: ptr.New("returned")}},
+		},
+		emptyAccountID: {},
+	}
+
+	reconcileParams := []repo.ReconcileTransactionParams{
+		{ID: reconciled.ID},
+	}
+
+	// Act
+	ids := listRemovedTransactionIDs(
+		transactions,
+		ofTransactionsByAccountID,
+		reconcileParams,
+		lastSynchronizedAt,
+	)
+
+	// Assert
+	asserts.Equal([]uuid.UUID{removed.ID}, ids)
+}
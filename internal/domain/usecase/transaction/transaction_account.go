@@ -0,0 +1,35 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// validateManualAccount checks the account belongs to the user and is
+// manual, as accounts from open finance have their transactions synced.
+func validateManualAccount(
+	ctx context.Context,
+	ar repo.AccountRepo,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+) error {
+	accounts, err := ar.ListAccounts(ctx, repo.AccountOptions{
+		IDs:     []uuid.UUID{accountID},
+		UserIDs: []uuid.UUID{userID},
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+	if len(accounts) == 0 {
+		return errs.ErrAccountNotFound
+	}
+	if accounts[0].UserInstitutionID != nil {
+		return errs.ErrManualAccountRequired
+	}
+
+	return nil
+}
@@ -0,0 +1,330 @@
+package transaction
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/pt_BR"
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/money"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/xlsx"
+)
+
+// exportLocale formats the exported transactions in the language of the
+// user.
+type exportLocale struct {
+	translator locales.Translator
+	dateLayout string
+	// csvDelimiter is a semicolon where the comma is the decimal
+	// separator, as spreadsheets expect.
+	csvDelimiter rune
+	sheetName    string
+	headers      []string
+}
+
+var exportLocales = map[entity.Language]exportLocale{
+	entity.LanguagePortuguese: {
+		translator:   pt_BR.New(),
+		dateLayout:   "02/01/2006",
+		csvDelimiter: ';',
+		sheetName:    "Transações",
+		headers: []string{
+			"Data",
+			"Descrição",
+			"Valor",
+			"Categoria",
+			"Forma de pagamento",
+			"Instituição",
+		},
+	},
+	entity.LanguageEnglish: {
+		translator:   en.New(),
+		dateLayout:   "01/02/2006",
+		csvDelimiter: ',',
+		sheetName:    "Transactions",
+		headers: []string{
+			"Date",
+			"Description",
+			"Amount",
+			"Category",
+			"Payment method",
+			"Institution",
+		},
+	},
+	entity.LanguageSpanish: {
+		translator:   es.New(),
+		dateLayout:   "02/01/2006",
+		csvDelimiter: ';',
+		sheetName:    "Transacciones",
+		headers: []string{
+			"Fecha",
+			"Descripción",
+			"Importe",
+			"Categoría",
+			"Forma de pago",
+			"Institución",
+		},
+	},
+}
+
+func getExportLocale(language entity.Language) exportLocale {
+	locale, ok := exportLocales[language]
+	if !ok {
+		return exportLocales[entity.LanguagePortuguese]
+	}
+	return locale
+}
+
+func (l exportLocale) formatAmount(cents int64) string {
+	return l.translator.FmtNumber(money.FromCents(cents), 2)
+}
+
+// transactionExporter writes the transactions one at a time to a file.
+type transactionExporter interface {
+	Write(transaction entity.FullTransaction) error
+	// Close finishes the file, without closing the underlying writer.
+	Close() error
+}
+
+func newTransactionExporter(
+	w io.Writer,
+	format entity.ExportFormat,
+	locale exportLocale,
+) (transactionExporter, error) {
+	switch format {
+	case entity.ExportFormatCSV:
+		return newCSVTransactionExporter(w, locale)
+	case entity.ExportFormatOFX:
+		return newOFXTransactionExporter(w)
+	case entity.ExportFormatXLSX:
+		return newXLSXTransactionExporter(w, locale)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type csvTransactionExporter struct {
+	w      *csv.Writer
+	locale exportLocale
+}
+
+func newCSVTransactionExporter(
+	w io.Writer,
+	locale exportLocale,
+) (*csvTransactionExporter, error) {
+	// The BOM makes spreadsheets read the file as UTF-8
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = locale.csvDelimiter
+
+	if err := cw.Write(locale.headers); err != nil {
+		return nil, err
+	}
+
+	return &csvTransactionExporter{
+		w:      cw,
+		locale: locale,
+	}, nil
+}
+
+func (e *csvTransactionExporter) Write(t entity.FullTransaction) error {
+	return e.w.Write([]string{
+		t.Date.Format(e.locale.dateLayout),
+		t.Name,
+		e.locale.formatAmount(t.Amount),
+		t.CategoryName,
+		t.PaymentMethodName,
+		ptr.Deref(t.InstitutionName),
+	})
+}
+
+func (e *csvTransactionExporter) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type xlsxTransactionExporter struct {
+	w *xlsx.Writer
+}
+
+// newXLSXTransactionExporter writes dates and amounts as spreadsheet values,
+// which are displayed in the locale of the reader.
+func newXLSXTransactionExporter(
+	w io.Writer,
+	locale exportLocale,
+) (*xlsxTransactionExporter, error) {
+	xw, err := xlsx.NewWriter(w, locale.sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make([]any, 0, len(locale.headers))
+	for _, header := range locale.headers {
+		headers = append(headers, header)
+	}
+	if err := xw.WriteRow(headers...); err != nil {
+		return nil, err
+	}
+
+	return &xlsxTransactionExporter{w: xw}, nil
+}
+
+func (e *xlsxTransactionExporter) Write(t entity.FullTransaction) error {
+	var institutionName any
+	if t.InstitutionName != nil {
+		institutionName = *t.InstitutionName
+	}
+
+	return e.w.WriteRow(
+		t.Date,
+		t.Name,
+		xlsx.Number(money.FromCents(t.Amount)),
+		t.CategoryName,
+		t.PaymentMethodName,
+		institutionName,
+	)
+}
+
+func (e *xlsxTransactionExporter) Close() error {
+	return e.w.Close()
+}
+
+// ofxTransactionExporter writes an OFX 1.02 statement. Amounts follow the
+// OFX format, with a dot as the decimal separator, whatever the language.
+type ofxTransactionExporter struct {
+	w *bufio.Writer
+	// parts counts the rows written of each transaction, since the parts of
+	// a split transaction share its id but need a FITID of their own.
+	parts map[uuid.UUID]int
+}
+
+// ofxMaxNameLength is the length limit of the NAME tag, longer names are
+// written in full in the MEMO tag.
+const ofxMaxNameLength = 32
+
+var ofxEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	"\r", " ",
+	"\n", " ",
+)
+
+func newOFXTransactionExporter(
+	w io.Writer,
+) (*ofxTransactionExporter, error) {
+	bw := bufio.NewWriter(w)
+
+	now := time.Now().UTC().Format("20060102150405")
+
+	if _, err := fmt.Fprintf(bw, ofxHeader, now); err != nil {
+		return nil, err
+	}
+
+	return &ofxTransactionExporter{
+		w:     bw,
+		parts: map[uuid.UUID]int{},
+	}, nil
+}
+
+func (e *ofxTransactionExporter) Write(t entity.FullTransaction) error {
+	transactionType := "CREDIT"
+	if t.Amount < 0 {
+		transactionType = "DEBIT"
+	}
+
+	name := []rune(ofxEscaper.Replace(t.Name))
+	memo := ofxEscaper.Replace(t.CategoryName)
+	if len(name) > ofxMaxNameLength {
+		memo = string(name) + " - " + memo
+		name = name[:ofxMaxNameLength]
+	}
+
+	// Consumers dedupe by FITID, so each part of a split transaction after
+	// the first one is told apart by its position.
+	fitID := t.ID.String()
+	if part := e.parts[t.ID]; part > 0 {
+		fitID = fmt.Sprintf("%s-%d", t.ID, part+1)
+	}
+	e.parts[t.ID]++
+
+	_, err := fmt.Fprintf(
+		e.w,
+		"<STMTTRN>\n"+
+			"<TRNTYPE>%s\n"+
+			"<DTPOSTED>%s\n"+
+			"<TRNAMT>%s\n"+
+			"<FITID>%s\n"+
+			"<NAME>%s\n"+
+			"<MEMO>%s\n"+
+			"</STMTTRN>\n",
+		transactionType,
+		t.Date.Format("20060102"),
+		formatOFXAmount(t.Amount),
+		fitID,
+		string(name),
+		memo,
+	)
+	return err
+}
+
+func (e *ofxTransactionExporter) Close() error {
+	if _, err := e.w.WriteString(ofxFooter); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+func formatOFXAmount(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+const ofxHeader = "OFXHEADER:100\n" +
+	"DATA:OFXSGML\n" +
+	"VERSION:102\n" +
+	"SECURITY:NONE\n" +
+	"ENCODING:UTF-8\n" +
+	"CHARSET:NONE\n" +
+	"COMPRESSION:NONE\n" +
+	"OLDFILEUID:NONE\n" +
+	"NEWFILEUID:NONE\n" +
+	"\n" +
+	"<OFX>\n" +
+	"<SIGNONMSGSRSV1>\n" +
+	"<SONRS>\n" +
+	"<STATUS>\n<CODE>0\n<SEVERITY>INFO\n</STATUS>\n" +
+	"<DTSERVER>%s\n" +
+	"<LANGUAGE>POR\n" +
+	"</SONRS>\n" +
+	"</SIGNONMSGSRSV1>\n" +
+	"<BANKMSGSRSV1>\n" +
+	"<STMTTRNRS>\n" +
+	"<TRNUID>0\n" +
+	"<STATUS>\n<CODE>0\n<SEVERITY>INFO\n</STATUS>\n" +
+	"<STMTRS>\n" +
+	"<CURDEF>BRL\n" +
+	"<BANKTRANLIST>\n"
+
+const ofxFooter = "</BANKTRANLIST>\n" +
+	"</STMTRS>\n" +
+	"</STMTTRNRS>\n" +
+	"</BANKMSGSRSV1>\n" +
+	"</OFX>\n"
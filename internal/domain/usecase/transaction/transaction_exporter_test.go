@@ -0,0 +1,185 @@
+package transaction
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestTransactionExporter(t *testing.T) {
+	t.Parallel()
+
+	transactions := []entity.FullTransaction{
+		{
+			Transaction: entity.Transaction{
+				ID:     uuid.MustParse("0195b6a1-1e1c-7f05-8d8b-4b6c8f0c2a11"),
+				Name:   "Padaria & Café",
+				Amount: -123456,
+				Date:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+			},
+			CategoryName:      "Alimentação",
+			PaymentMethodName: "Pix",
+			InstitutionName:   ptr.New("Nubank"),
+		},
+		{
+			Transaction: entity.Transaction{
+				ID:     uuid.MustParse("0195b6a1-1e1c-7f05-8d8b-4b6c8f0c2a12"),
+				Name:   "Transferência recebida de uma pessoa com nome longo",
+				Amount: 5,
+				Date:   time.Date(2024, 1, 6, 0, 0, 0, 0, time.UTC),
+			},
+			CategoryName:      "Outros",
+			PaymentMethodName: "Pix",
+		},
+	}
+
+	tests := []struct {
+		description string
+		format      entity.ExportFormat
+		language    entity.Language
+		expected    []string
+	}{
+		{
+			description: "portuguese CSV",
+			format:      entity.ExportFormatCSV,
+			language:    entity.LanguagePortuguese,
+			expected: []string{
+				"\xef\xbb\xbfData;Descrição;Valor;Categoria;Forma de pagamento;Instituição\n",
+				"05/01/2024;Padaria & Café;-1.234,56;Alimentação;Pix;Nubank\n",
+				"06/01/2024;Transferência recebida de uma pessoa com nome longo;0,05;Outros;Pix;\n",
+			},
+		},
+		{
+			description: "english CSV",
+			format:      entity.ExportFormatCSV,
+			language:    entity.LanguageEnglish,
+			expected: []string{
+				"Date,Description,Amount,Category,Payment method,Institution\n",
+				`01/05/2024,Padaria & Café,"-1,234.56",Alimentação,Pix,Nubank` + "\n",
+			},
+		},
+		{
+			description: "unknown language falls back to portuguese",
+			format:      entity.ExportFormatCSV,
+			language:    "fr",
+			expected:    []string{"-1.234,56"},
+		},
+		{
+			description: "OFX",
+			format:      entity.ExportFormatOFX,
+			language:    entity.LanguageEnglish,
+			expected: []string{
+				"OFXHEADER:100\n",
+				"<STMTTRN>\n<TRNTYPE>DEBIT\n<DTPOSTED>20240105\n<TRNAMT>-1234.56\n" +
+					"<FITID>0195b6a1-1e1c-7f05-8d8b-4b6c8f0c2a11\n" +
+					"<NAME>Padaria &amp; Café\n<MEMO>Alimentação\n</STMTTRN>\n",
+				"<TRNTYPE>CREDIT\n<DTPOSTED>20240106\n<TRNAMT>0.05\n",
+				"<NAME>Transferência recebida de uma pe\n" +
+					"<MEMO>Transferência recebida de uma pessoa com nome longo - Outros\n",
+				"</BANKTRANLIST>\n</STMTRS>\n</STMTTRNRS>\n</BANKMSGSRSV1>\n</OFX>\n",
+			},
+		},
+		{
+			description: "XLSX",
+			format:      entity.ExportFormatXLSX,
+			language:    entity.LanguagePortuguese,
+			expected:    []string{"PK"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			exporter, err := newTransactionExporter(
+				buf,
+				test.format,
+				getExportLocale(test.language),
+			)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			for _, transaction := range transactions {
+				assert.NoError(t, exporter.Write(transaction))
+			}
+			assert.NoError(t, exporter.Close())
+
+			for _, expected := range test.expected {
+				assert.Contains(t, buf.String(), expected)
+			}
+		})
+	}
+}
+
+func TestOFXTransactionExporterSplitParts(t *testing.T) {
+	t.Parallel()
+
+	// A split transaction is exported as one row per part
+	split := entity.Transaction{
+		ID:   uuid.MustParse("0195b6a1-1e1c-7f05-8d8b-4b6c8f0c2a13"),
+		Name: "Mercado",
+		Date: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+	}
+	parts := []entity.FullTransaction{
+		{Transaction: split, CategoryName: "Alimentação"},
+		{Transaction: split, CategoryName: "Casa"},
+		{Transaction: split, CategoryName: "Higiene"},
+	}
+	parts[0].Amount, parts[1].Amount, parts[2].Amount = -3000, -2000, -500
+
+	buf := &bytes.Buffer{}
+	exporter, err := newTransactionExporter(
+		buf,
+		entity.ExportFormatOFX,
+		getExportLocale(entity.LanguageEnglish),
+	)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, part := range parts {
+		assert.NoError(t, exporter.Write(part))
+	}
+	assert.NoError(t, exporter.Close())
+
+	assert.Contains(t, buf.String(), "<FITID>"+split.ID.String()+"\n")
+	assert.Contains(t, buf.String(), "<FITID>"+split.ID.String()+"-2\n")
+	assert.Contains(t, buf.String(), "<FITID>"+split.ID.String()+"-3\n")
+	assert.Equal(t, 3, strings.Count(buf.String(), "<FITID>"))
+}
+
+func TestFormatOFXAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int64]string{
+		0:       "0.00",
+		5:       "0.05",
+		-5:      "-0.05",
+		123456:  "1234.56",
+		-100000: "-1000.00",
+	}
+
+	for cents, expected := range tests {
+		assert.Equal(t, expected, formatOFXAmount(cents))
+	}
+}
+
+func TestNewTransactionExporterUnsupportedFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := newTransactionExporter(
+		&strings.Builder{},
+		"pdf",
+		getExportLocale(entity.LanguagePortuguese),
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package transaction
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// validateTags checks all tags exist and belong to the user.
+func validateTags(
+	ctx context.Context,
+	tgr repo.TagRepo,
+	userID uuid.UUID,
+	tagIDs []uuid.UUID,
+) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	tagIDs = uniqueIDs(tagIDs)
+
+	tags, err := tgr.ListTags(ctx, repo.TagOptions{
+		IDs:    tagIDs,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if len(tags) != len(tagIDs) {
+		return errs.ErrTagsNotFound
+	}
+
+	return nil
+}
+
+// setTransactionTags replaces the tags of a transaction. Must run in a
+// transaction.
+func setTransactionTags(
+	ctx context.Context,
+	tr repo.TransactionRepo,
+	transactionID uuid.UUID,
+	tagIDs []uuid.UUID,
+) error {
+	if err := tr.DeleteTransactionTags(ctx, transactionID); err != nil {
+		return errs.New(err)
+	}
+
+	tagIDs = uniqueIDs(tagIDs)
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	params := make([]repo.CreateTransactionTagsParams, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		params = append(params, repo.CreateTransactionTagsParams{
+			TransactionID: transactionID,
+			TagID:         tagID,
+		})
+	}
+
+	if err := tr.CreateTransactionTags(ctx, params); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,38 @@
+package transaction
+
+import (
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+// trashRetentionDays is how long deleted and hidden transactions stay in the
+// trash, where they can be restored, before they are purged.
+const trashRetentionDays = 30
+
+func trashStartDate(now time.Time) time.Time {
+	return now.AddDate(0, 0, -trashRetentionDays)
+}
+
+// isInTrash reports whether the deleted transaction was removed by the user,
+// and not by the provider, recently enough to be restored.
+func isInTrash(
+	externalID *string,
+	isHidden bool,
+	deletedAt *time.Time,
+	now time.Time,
+) bool {
+	if deletedAt == nil || deletedAt.Before(trashStartDate(now)) {
+		return false
+	}
+	return !isSynced(externalID) || isHidden
+}
+
+// isSynced reports whether the transaction came from open finance. Imported
+// transactions also have an external id, but belong to the user as the
+// manual ones.
+func isSynced(externalID *string) bool {
+	return externalID != nil &&
+		!strings.HasPrefix(*externalID, entity.ImportExternalIDPrefix)
+}
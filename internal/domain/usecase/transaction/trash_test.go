@@ -0,0 +1,71 @@
+package transaction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestIsInTrash(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 14, 12, 0, 0, 0, time.UTC)
+	recently := now.AddDate(0, 0, -1)
+	longAgo := now.AddDate(0, 0, -trashRetentionDays-1)
+
+	tests := []struct {
+		description string
+		externalID  *string
+		isHidden    bool
+		deletedAt   *time.Time
+		expected    bool
+	}{
+		{
+			description: "manual transaction deleted recently",
+			deletedAt:   &recently,
+			expected:    true,
+		},
+		{
+			description: "imported transaction deleted recently",
+			externalID:  ptr.New("import:0123456789abcdef"),
+			deletedAt:   &recently,
+			expected:    true,
+		},
+		{
+			description: "synced transaction hidden recently",
+			externalID:  ptr.New("external-id"),
+			isHidden:    true,
+			deletedAt:   &recently,
+			expected:    true,
+		},
+		{
+			description: "synced transaction deleted by the sync",
+			externalID:  ptr.New("external-id"),
+			deletedAt:   &recently,
+			expected:    false,
+		},
+		{
+			description: "manual transaction deleted before the retention",
+			deletedAt:   &longAgo,
+			expected:    false,
+		},
+		{
+			description: "transaction not deleted",
+			expected:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(
+				t,
+				test.expected,
+				isInTrash(test.externalID, test.isHidden, test.deletedAt, now),
+			)
+		})
+	}
+}
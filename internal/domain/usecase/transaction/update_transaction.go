@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/google/uuid"
@@ -12,17 +13,26 @@ import (
 )
 
 type UpdateTransactionUseCase struct {
-	v  *validator.Validator
-	tr repo.TransactionRepo
+	v   *validator.Validator
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	tcr repo.TransactionCategoryRepo
+	tgr repo.TagRepo
 }
 
 func NewUpdateTransactionUseCase(
 	v *validator.Validator,
+	tx tx.TX,
 	tr repo.TransactionRepo,
+	tcr repo.TransactionCategoryRepo,
+	tgr repo.TagRepo,
 ) *UpdateTransactionUseCase {
 	return &UpdateTransactionUseCase{
-		v:  v,
-		tr: tr,
+		v:   v,
+		tx:  tx,
+		tr:  tr,
+		tcr: tcr,
+		tgr: tgr,
 	}
 }
 
@@ -36,6 +46,10 @@ type UpdateTransactionUseCaseInput struct {
 	AccountID       uuid.UUID `json:"account_id"`
 	InstitutionID   uuid.UUID `json:"institution_id"`
 	CategoryID      uuid.UUID `json:"category_id"`
+	Notes           *string   `json:"notes"`
+
+	// TagIDs replaces the tags of the transaction, nil keeps them.
+	TagIDs []uuid.UUID `json:"tag_ids"`
 }
 
 func (u *UpdateTransactionUseCase) Execute(
@@ -54,6 +68,31 @@ func (u *UpdateTransactionUseCase) Execute(
 		return errs.ErrTransactionNotFound
 	}
 
+	if in.Amount != 0 && in.Amount != transaction.Amount {
+		splits, err := u.tr.ListTransactionSplits(ctx, in.ID)
+		if err != nil {
+			return errs.New(err)
+		}
+		if len(splits) > 0 {
+			return errs.ErrSplitTransactionAmount
+		}
+	}
+
+	if in.CategoryID != uuid.Nil {
+		category, err := u.tcr.GetTransactionCategoryByID(ctx, in.CategoryID)
+		if err != nil {
+			return errs.New(err)
+		}
+		if category == nil ||
+			(category.UserID != nil && *category.UserID != in.UserID) {
+			return errs.ErrCategoryNotFound
+		}
+	}
+
+	if err := validateTags(ctx, u.tgr, in.UserID, in.TagIDs); err != nil {
+		return errs.New(err)
+	}
+
 	params := repo.UpdateTransactionParams{}
 	if err := copier.Copy(&params, transaction); err != nil {
 		return errs.New(err)
@@ -63,5 +102,20 @@ func (u *UpdateTransactionUseCase) Execute(
 		return errs.New(err)
 	}
 
-	return u.tr.UpdateTransaction(ctx, params)
+	err = u.tx.Do(ctx, func(ctx context.Context) error {
+		if err := u.tr.UpdateTransaction(ctx, params); err != nil {
+			return errs.New(err)
+		}
+
+		if in.TagIDs == nil {
+			return nil
+		}
+
+		return setTransactionTags(ctx, u.tr, in.ID, in.TagIDs)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
 }
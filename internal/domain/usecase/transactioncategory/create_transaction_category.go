@@ -0,0 +1,57 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/jinzhu/copier"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type CreateTransactionCategoryUseCase struct {
+	v  *validator.Validator
+	cr repo.TransactionCategoryRepo
+}
+
+func NewCreateTransactionCategoryUseCase(
+	v *validator.Validator,
+	cr repo.TransactionCategoryRepo,
+) *CreateTransactionCategoryUseCase {
+	return &CreateTransactionCategoryUseCase{
+		v:  v,
+		cr: cr,
+	}
+}
+
+type CreateTransactionCategoryUseCaseInput struct {
+	TransactionCategoryInput
+}
+
+func (uc *CreateTransactionCategoryUseCase) Execute(
+	ctx context.Context,
+	in CreateTransactionCategoryUseCaseInput,
+) (*entity.TransactionCategory, error) {
+	if err := uc.v.Validate(in); err != nil {
+		return nil, errs.New(err)
+	}
+
+	if err := validateParentCategory(ctx, uc.cr, in.ParentID); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var params repo.CreateTransactionCategoryParams
+	if err := copier.Copy(&params, in.TransactionCategoryInput); err != nil {
+		return nil, errs.New(err)
+	}
+	params.UserID = &in.UserID
+
+	category, err := uc.cr.CreateTransactionCategory(ctx, params)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return category, nil
+}
@@ -0,0 +1,74 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type DeleteTransactionCategoryUseCase struct {
+	tx tx.TX
+	m  categoryMerger
+}
+
+func NewDeleteTransactionCategoryUseCase(
+	tx tx.TX,
+	cr repo.TransactionCategoryRepo,
+	tr repo.TransactionRepo,
+	br repo.BudgetRepo,
+	rr repo.RuleRepo,
+) *DeleteTransactionCategoryUseCase {
+	return &DeleteTransactionCategoryUseCase{
+		tx: tx,
+		m: categoryMerger{
+			cr: cr,
+			tr: tr,
+			br: br,
+			rr: rr,
+		},
+	}
+}
+
+type DeleteTransactionCategoryUseCaseInput struct {
+	ID     uuid.UUID `json:"category_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute deletes a custom category, moving its transactions to the parent
+// category or, for top level categories, to the default one.
+func (uc *DeleteTransactionCategoryUseCase) Execute(
+	ctx context.Context,
+	in DeleteTransactionCategoryUseCaseInput,
+) error {
+	category, err := getOwnCategory(ctx, uc.m.cr, in.ID, in.UserID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	var target *entity.TransactionCategory
+	if category.ParentID != nil {
+		target, err = uc.m.cr.GetTransactionCategoryByID(ctx, *category.ParentID)
+	} else {
+		target, err = uc.m.cr.GetDefaultTransactionCategory(ctx)
+	}
+	if err != nil {
+		return errs.New(err)
+	}
+	if target == nil {
+		return errs.ErrCategoryNotFound
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		return uc.m.merge(ctx, in.UserID, *category, *target)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type HideTransactionCategoryUseCase struct {
+	cr repo.TransactionCategoryRepo
+}
+
+func NewHideTransactionCategoryUseCase(
+	cr repo.TransactionCategoryRepo,
+) *HideTransactionCategoryUseCase {
+	return &HideTransactionCategoryUseCase{
+		cr: cr,
+	}
+}
+
+type HideTransactionCategoryUseCaseInput struct {
+	ID       uuid.UUID `json:"-"`
+	UserID   uuid.UUID `json:"-"`
+	IsHidden bool      `json:"is_hidden"`
+}
+
+// Execute hides a category from the user category list, or shows it again.
+// Transactions already in the category are kept.
+func (uc *HideTransactionCategoryUseCase) Execute(
+	ctx context.Context,
+	in HideTransactionCategoryUseCaseInput,
+) error {
+	if _, err := getUserCategory(ctx, uc.cr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	if !in.IsHidden {
+		if err := uc.cr.UnhideCategory(ctx, repo.UnhideCategoryParams{
+			UserID:     in.UserID,
+			CategoryID: in.ID,
+		}); err != nil {
+			return errs.New(err)
+		}
+		return nil
+	}
+
+	if err := uc.cr.HideCategory(ctx, repo.HideCategoryParams{
+		UserID:     in.UserID,
+		CategoryID: in.ID,
+	}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type MergeTransactionCategoriesUseCase struct {
+	v  *validator.Validator
+	tx tx.TX
+	m  categoryMerger
+}
+
+func NewMergeTransactionCategoriesUseCase(
+	v *validator.Validator,
+	tx tx.TX,
+	cr repo.TransactionCategoryRepo,
+	tr repo.TransactionRepo,
+	br repo.BudgetRepo,
+	rr repo.RuleRepo,
+) *MergeTransactionCategoriesUseCase {
+	return &MergeTransactionCategoriesUseCase{
+		v:  v,
+		tx: tx,
+		m: categoryMerger{
+			cr: cr,
+			tr: tr,
+			br: br,
+			rr: rr,
+		},
+	}
+}
+
+type MergeTransactionCategoriesUseCaseInput struct {
+	ID       uuid.UUID `json:"-"         validate:"required"`
+	UserID   uuid.UUID `json:"-"         validate:"required"`
+	TargetID uuid.UUID `json:"target_id" validate:"required"`
+}
+
+// Execute merges a category into the target one. The user transactions,
+// budgets and rules are moved to the target, and the merged category is
+// deleted if it is a custom one or hidden if it is a provider one.
+func (uc *MergeTransactionCategoriesUseCase) Execute(
+	ctx context.Context,
+	in MergeTransactionCategoriesUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	if in.ID == in.TargetID {
+		return errs.ErrInvalidCategoryMerge
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	var source, target *entity.TransactionCategory
+
+	g.Go(func() (err error) {
+		source, err = getUserCategory(gCtx, uc.m.cr, in.ID, in.UserID)
+		return err
+	})
+
+	g.Go(func() (err error) {
+		target, err = getUserCategory(gCtx, uc.m.cr, in.TargetID, in.UserID)
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return errs.New(err)
+	}
+
+	err := uc.tx.Do(ctx, func(ctx context.Context) error {
+		return uc.m.merge(ctx, in.UserID, *source, *target)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
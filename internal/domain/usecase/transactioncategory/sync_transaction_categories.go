@@ -8,6 +8,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 )
@@ -50,12 +51,15 @@ func (uc *SyncTransactionCategoriesUseCase) Execute(ctx context.Context) error {
 
 	categoriesByExternalID := make(map[string]entity.TransactionCategory)
 	for _, i := range categories {
-		categoriesByExternalID[i.ExternalID] = i
+		if i.ExternalID == nil {
+			continue
+		}
+		categoriesByExternalID[*i.ExternalID] = i
 	}
 
 	params := []repo.CreateTransactionCategoriesParams{}
 	for _, i := range openFinanceCategories {
-		if _, ok := categoriesByExternalID[i.ExternalID]; ok {
+		if _, ok := categoriesByExternalID[ptr.Deref(i.ExternalID)]; ok {
 			continue
 		}
 		param := repo.CreateTransactionCategoriesParams{}
@@ -0,0 +1,162 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// TransactionCategoryInput is the custom category definition shared by the
+// create and update use cases. Custom categories can only be nested under
+// provider top level categories.
+type TransactionCategoryInput struct {
+	UserID   uuid.UUID  `json:"-"         validate:"required"`
+	Name     string     `json:"name"      validate:"required"`
+	Icon     *string    `json:"icon"`
+	Color    *string    `json:"color"     validate:"omitempty,hexcolor"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+func validateParentCategory(
+	ctx context.Context,
+	cr repo.TransactionCategoryRepo,
+	parentID *uuid.UUID,
+) error {
+	if parentID == nil {
+		return nil
+	}
+
+	parent, err := cr.GetTransactionCategoryByID(ctx, *parentID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if parent == nil || parent.UserID != nil || parent.ParentID != nil {
+		return errs.ErrInvalidCategoryParent
+	}
+
+	return nil
+}
+
+// getUserCategory gets a category the user can use, a provider category or
+// one of their own.
+func getUserCategory(
+	ctx context.Context,
+	cr repo.TransactionCategoryRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.TransactionCategory, error) {
+	category, err := cr.GetTransactionCategoryByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if category == nil ||
+		(category.UserID != nil && *category.UserID != userID) {
+		return nil, errs.ErrCategoryNotFound
+	}
+
+	return category, nil
+}
+
+// getOwnCategory gets a custom category of the user, provider categories
+// can not be changed.
+func getOwnCategory(
+	ctx context.Context,
+	cr repo.TransactionCategoryRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.TransactionCategory, error) {
+	category, err := getUserCategory(ctx, cr, id, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if category.UserID == nil {
+		return nil, errs.ErrCategoryNotEditable
+	}
+
+	return category, nil
+}
+
+type categoryMerger struct {
+	cr repo.TransactionCategoryRepo
+	tr repo.TransactionRepo
+	br repo.BudgetRepo
+	rr repo.RuleRepo
+}
+
+// merge moves the transactions, budgets and rules of the user from source to
+// target, then deletes source if it is a custom category. Provider
+// categories are hidden instead, remembering target so the synced
+// transactions of source keep landing in target. Must run in a transaction.
+func (m categoryMerger) merge(
+	ctx context.Context,
+	userID uuid.UUID,
+	source entity.TransactionCategory,
+	target entity.TransactionCategory,
+) error {
+	if err := m.tr.MergeTransactionCategories(
+		ctx,
+		repo.MergeTransactionCategoriesParams{
+			TargetCategoryID: target.ID,
+			UserID:           userID,
+			SourceCategoryID: source.ID,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	// Budgets only have top level categories.
+	if err := m.br.MergeBudgetCategories(
+		ctx,
+		repo.MergeBudgetCategoriesParams{
+			UserID:           userID,
+			SourceCategoryID: source.ID,
+			TargetCategoryID: ptr.Coalesce(target.ParentID, target.ID),
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	if err := m.rr.MergeRuleCategories(
+		ctx,
+		repo.MergeRuleCategoriesParams{
+			TargetCategoryID: target.ID,
+			UserID:           userID,
+			SourceCategoryID: source.ID,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	if err := m.cr.MergeHiddenCategories(
+		ctx,
+		repo.MergeHiddenCategoriesParams{
+			TargetCategoryID: target.ID,
+			UserID:           userID,
+			SourceCategoryID: source.ID,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	if source.UserID != nil {
+		if err := m.cr.DeleteTransactionCategory(ctx, source.ID); err != nil {
+			return errs.New(err)
+		}
+		return nil
+	}
+
+	if err := m.cr.HideCategory(ctx, repo.HideCategoryParams{
+		UserID:       userID,
+		CategoryID:   source.ID,
+		MergedIntoID: &target.ID,
+	}); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package transactioncategory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/validator"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type UpdateTransactionCategoryUseCase struct {
+	v  *validator.Validator
+	cr repo.TransactionCategoryRepo
+}
+
+func NewUpdateTransactionCategoryUseCase(
+	v *validator.Validator,
+	cr repo.TransactionCategoryRepo,
+) *UpdateTransactionCategoryUseCase {
+	return &UpdateTransactionCategoryUseCase{
+		v:  v,
+		cr: cr,
+	}
+}
+
+type UpdateTransactionCategoryUseCaseInput struct {
+	ID uuid.UUID `json:"-" validate:"required"`
+	TransactionCategoryInput
+}
+
+func (uc *UpdateTransactionCategoryUseCase) Execute(
+	ctx context.Context,
+	in UpdateTransactionCategoryUseCaseInput,
+) error {
+	if err := uc.v.Validate(in); err != nil {
+		return errs.New(err)
+	}
+
+	if _, err := getOwnCategory(ctx, uc.cr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	if err := validateParentCategory(ctx, uc.cr, in.ParentID); err != nil {
+		return errs.New(err)
+	}
+
+	var params repo.UpdateTransactionCategoryParams
+	if err := copier.Copy(&params, in.TransactionCategoryInput); err != nil {
+		return errs.New(err)
+	}
+	params.ID = in.ID
+
+	if err := uc.cr.UpdateTransactionCategory(ctx, params); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
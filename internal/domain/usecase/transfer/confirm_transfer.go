@@ -0,0 +1,50 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ConfirmTransferUseCase struct {
+	tfr repo.TransferRepo
+}
+
+func NewConfirmTransferUseCase(
+	tfr repo.TransferRepo,
+) *ConfirmTransferUseCase {
+	return &ConfirmTransferUseCase{
+		tfr: tfr,
+	}
+}
+
+type ConfirmTransferUseCaseInput struct {
+	ID     uuid.UUID `json:"transfer_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute marks a matched transfer as confirmed by the user.
+func (uc *ConfirmTransferUseCase) Execute(
+	ctx context.Context,
+	in ConfirmTransferUseCaseInput,
+) error {
+	if _, err := getOwnTransfer(ctx, uc.tfr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.tfr.UpdateTransferStatus(
+		ctx,
+		repo.UpdateTransferStatusParams{
+			ID:     in.ID,
+			Status: entity.TransferStatusConfirmed,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type ListTransfersUseCase struct {
+	tr  repo.TransactionRepo
+	tfr repo.TransferRepo
+}
+
+func NewListTransfersUseCase(
+	tr repo.TransactionRepo,
+	tfr repo.TransferRepo,
+) *ListTransfersUseCase {
+	return &ListTransfersUseCase{
+		tr:  tr,
+		tfr: tfr,
+	}
+}
+
+type ListTransfersUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListTransfersUseCaseItem struct {
+	entity.Transfer
+	OutgoingTransaction entity.FullTransaction `json:"outgoing_transaction"`
+	IncomingTransaction entity.FullTransaction `json:"incoming_transaction"`
+}
+
+type ListTransfersUseCaseOutput struct {
+	Items []ListTransfersUseCaseItem `json:"items"`
+}
+
+// Execute lists the linked transfers of the user with both transactions,
+// newest first. Transfers with a deleted transaction are left out.
+func (uc *ListTransfersUseCase) Execute(
+	ctx context.Context,
+	in ListTransfersUseCaseInput,
+) (*ListTransfersUseCaseOutput, error) {
+	transfers, err := uc.tfr.ListTransfersByUserID(ctx, in.UserID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	linked := []entity.Transfer{}
+	transactionIDs := []uuid.UUID{}
+	for _, t := range transfers {
+		if t.Status == entity.TransferStatusUnlinked {
+			continue
+		}
+		linked = append(linked, t)
+		transactionIDs = append(
+			transactionIDs,
+			t.OutgoingTransactionID,
+			t.IncomingTransactionID,
+		)
+	}
+
+	out := ListTransfersUseCaseOutput{
+		Items: make([]ListTransfersUseCaseItem, 0, len(linked)),
+	}
+	if len(linked) == 0 {
+		return &out, nil
+	}
+
+	transactions, err := uc.tr.ListFullTransactions(
+		ctx,
+		in.UserID,
+		repo.TransactionOptions{IDs: transactionIDs},
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	transactionsByID := make(
+		map[uuid.UUID]entity.FullTransaction,
+		len(transactions),
+	)
+	for _, t := range transactions {
+		transactionsByID[t.ID] = t
+	}
+
+	for _, t := range linked {
+		outgoing, ok := transactionsByID[t.OutgoingTransactionID]
+		if !ok {
+			continue
+		}
+		incoming, ok := transactionsByID[t.IncomingTransactionID]
+		if !ok {
+			continue
+		}
+		out.Items = append(out.Items, ListTransfersUseCaseItem{
+			Transfer:            t,
+			OutgoingTransaction: outgoing,
+			IncomingTransaction: incoming,
+		})
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,91 @@
+package transfer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/tx"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// historyMonths is how far back the transactions are matched, older ones
+// were already matched by previous syncs.
+const historyMonths = 3
+
+type MatchTransfersUseCase struct {
+	tx  tx.TX
+	tr  repo.TransactionRepo
+	tfr repo.TransferRepo
+}
+
+func NewMatchTransfersUseCase(
+	tx tx.TX,
+	tr repo.TransactionRepo,
+	tfr repo.TransferRepo,
+) *MatchTransfersUseCase {
+	return &MatchTransfersUseCase{
+		tx:  tx,
+		tr:  tr,
+		tfr: tfr,
+	}
+}
+
+type MatchTransfersUseCaseInput struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute links the recent transactions that move money between the user
+// accounts as transfers, so they are not counted as income and expense.
+func (uc *MatchTransfersUseCase) Execute(
+	ctx context.Context,
+	in MatchTransfersUseCaseInput,
+) error {
+	now := time.Now()
+
+	transactions, err := uc.tr.ListTransactions(
+		ctx,
+		in.UserID,
+		repo.TransactionOptions{
+			StartDate: now.AddDate(0, -historyMonths, 0),
+			EndDate:   now,
+			IsIgnored: ptr.New(false),
+		},
+	)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	transfers, err := uc.tfr.ListTransfersByUserID(ctx, in.UserID)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	pairs := MatchPairs(transactions, transfers)
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	params := make([]repo.CreateTransfersParams, 0, len(pairs))
+	for _, p := range pairs {
+		params = append(params, repo.CreateTransfersParams{
+			Status:                entity.TransferStatusMatched,
+			UserID:                in.UserID,
+			OutgoingTransactionID: p.OutgoingTransactionID,
+			IncomingTransactionID: p.IncomingTransactionID,
+		})
+	}
+
+	err = uc.tx.Do(ctx, func(ctx context.Context) error {
+		return uc.tfr.CreateTransfers(ctx, params)
+	})
+	if err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
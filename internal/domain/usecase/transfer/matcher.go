@@ -0,0 +1,128 @@
+package transfer
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+// maxDateDistance is how far apart the two sides of a transfer can be
+// posted, since banks may take a few days to settle it.
+const maxDateDistance = 3 * 24 * time.Hour
+
+// Pair is an outgoing transaction and the incoming transaction in another
+// account of the same user with the opposite amount.
+type Pair struct {
+	OutgoingTransactionID uuid.UUID
+	IncomingTransactionID uuid.UUID
+}
+
+// MatchPairs pairs the outgoing transactions with the incoming ones of the
+// opposite amount in another account, closest in date. Transactions already
+// linked by the existing transfers are skipped, and unlinked pairs are not
+// matched again.
+func MatchPairs(
+	transactions []entity.Transaction,
+	transfers []entity.Transfer,
+) []Pair {
+	linked := map[uuid.UUID]struct{}{}
+	unlinked := map[Pair]struct{}{}
+	for _, t := range transfers {
+		if t.Status == entity.TransferStatusUnlinked {
+			unlinked[Pair{
+				OutgoingTransactionID: t.OutgoingTransactionID,
+				IncomingTransactionID: t.IncomingTransactionID,
+			}] = struct{}{}
+			continue
+		}
+		linked[t.OutgoingTransactionID] = struct{}{}
+		linked[t.IncomingTransactionID] = struct{}{}
+	}
+
+	var outgoing []entity.Transaction
+	incomingByAmount := map[int64][]entity.Transaction{}
+	for _, t := range transactions {
+		if !isTransferCandidate(t) {
+			continue
+		}
+		if _, ok := linked[t.ID]; ok {
+			continue
+		}
+		if t.Amount < 0 {
+			outgoing = append(outgoing, t)
+		} else {
+			incomingByAmount[t.Amount] = append(incomingByAmount[t.Amount], t)
+		}
+	}
+
+	slices.SortFunc(outgoing, compareTransactions)
+
+	used := map[uuid.UUID]struct{}{}
+	pairs := []Pair{}
+	for _, out := range outgoing {
+		var (
+			match    *entity.Transaction
+			distance time.Duration
+		)
+
+		for _, in := range incomingByAmount[-out.Amount] {
+			if _, ok := used[in.ID]; ok {
+				continue
+			}
+			if *in.AccountID == *out.AccountID {
+				continue
+			}
+
+			pair := Pair{
+				OutgoingTransactionID: out.ID,
+				IncomingTransactionID: in.ID,
+			}
+			if _, ok := unlinked[pair]; ok {
+				continue
+			}
+
+			d := in.Date.Sub(out.Date).Abs()
+			if d > maxDateDistance {
+				continue
+			}
+			if match == nil || d < distance ||
+				(d == distance && compareTransactions(in, *match) < 0) {
+				match = &in
+				distance = d
+			}
+		}
+
+		if match == nil {
+			continue
+		}
+
+		used[match.ID] = struct{}{}
+		pairs = append(pairs, Pair{
+			OutgoingTransactionID: out.ID,
+			IncomingTransactionID: match.ID,
+		})
+	}
+
+	return pairs
+}
+
+// isTransferCandidate reports whether the transaction can be a side of a
+// transfer, which moves money between accounts at once.
+func isTransferCandidate(t entity.Transaction) bool {
+	return t.AccountID != nil &&
+		t.Amount != 0 &&
+		!t.IsIgnored &&
+		t.DeletedAt == nil &&
+		t.TotalInstallments == nil
+}
+
+func compareTransactions(a, b entity.Transaction) int {
+	return cmp.Or(
+		a.Date.Compare(b.Date),
+		cmp.Compare(a.ID.String(), b.ID.String()),
+	)
+}
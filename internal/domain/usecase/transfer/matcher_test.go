@@ -0,0 +1,137 @@
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+)
+
+func TestMatchPairs(t *testing.T) {
+	t.Parallel()
+
+	checkingAccountID := uuid.New()
+	savingsAccountID := uuid.New()
+	day := func(d int) time.Time {
+		return time.Date(2025, 4, d, 10, 0, 0, 0, time.UTC)
+	}
+
+	newTransaction := func(
+		accountID *uuid.UUID,
+		amount int64,
+		date time.Time,
+	) entity.Transaction {
+		return entity.Transaction{
+			ID:        uuid.New(),
+			Name:      "TED",
+			Amount:    amount,
+			Date:      date,
+			AccountID: accountID,
+		}
+	}
+
+	out := newTransaction(&checkingAccountID, -50000, day(10))
+	in := newTransaction(&savingsAccountID, 50000, day(11))
+	closerIn := newTransaction(&savingsAccountID, 50000, day(10))
+	sameAccountIn := newTransaction(&checkingAccountID, 50000, day(10))
+	lateIn := newTransaction(&savingsAccountID, 50000, day(20))
+	otherAmountIn := newTransaction(&savingsAccountID, 49999, day(10))
+	noAccountIn := newTransaction(nil, 50000, day(10))
+	installmentOut := newTransaction(&checkingAccountID, -50000, day(10))
+	installmentOut.TotalInstallments = ptr.New(int32(10))
+	secondOut := newTransaction(&checkingAccountID, -50000, day(11))
+
+	tests := []struct {
+		description   string
+		transactions  []entity.Transaction
+		transfers     []entity.Transfer
+		expectedPairs []Pair
+	}{
+		{
+			description:  "pairs opposite amounts across accounts",
+			transactions: []entity.Transaction{out, in},
+			expectedPairs: []Pair{
+				{OutgoingTransactionID: out.ID, IncomingTransactionID: in.ID},
+			},
+		},
+		{
+			description:  "prefers the closest date",
+			transactions: []entity.Transaction{out, in, closerIn},
+			expectedPairs: []Pair{
+				{
+					OutgoingTransactionID: out.ID,
+					IncomingTransactionID: closerIn.ID,
+				},
+			},
+		},
+		{
+			description:  "uses each incoming transaction once",
+			transactions: []entity.Transaction{out, secondOut, in, closerIn},
+			expectedPairs: []Pair{
+				{
+					OutgoingTransactionID: out.ID,
+					IncomingTransactionID: closerIn.ID,
+				},
+				{
+					OutgoingTransactionID: secondOut.ID,
+					IncomingTransactionID: in.ID,
+				},
+			},
+		},
+		{
+			description: "skips same account, far dates, other amounts and missing accounts",
+			transactions: []entity.Transaction{
+				out,
+				sameAccountIn,
+				lateIn,
+				otherAmountIn,
+				noAccountIn,
+			},
+			expectedPairs: []Pair{},
+		},
+		{
+			description:   "skips installments",
+			transactions:  []entity.Transaction{installmentOut, in},
+			expectedPairs: []Pair{},
+		},
+		{
+			description:  "skips already linked transactions",
+			transactions: []entity.Transaction{out, in, closerIn},
+			transfers: []entity.Transfer{
+				{
+					Status:                entity.TransferStatusConfirmed,
+					OutgoingTransactionID: uuid.New(),
+					IncomingTransactionID: closerIn.ID,
+				},
+			},
+			expectedPairs: []Pair{
+				{OutgoingTransactionID: out.ID, IncomingTransactionID: in.ID},
+			},
+		},
+		{
+			description:  "does not match unlinked pairs again",
+			transactions: []entity.Transaction{out, in},
+			transfers: []entity.Transfer{
+				{
+					Status:                entity.TransferStatusUnlinked,
+					OutgoingTransactionID: out.ID,
+					IncomingTransactionID: in.ID,
+				},
+			},
+			expectedPairs: []Pair{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			pairs := MatchPairs(tt.transactions, tt.transfers)
+			assert.Equal(t, tt.expectedPairs, pairs)
+		})
+	}
+}
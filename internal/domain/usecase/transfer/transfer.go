@@ -0,0 +1,31 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+// getOwnTransfer gets a linked transfer of the user, unlinked pairs are only
+// kept so they are not matched again.
+func getOwnTransfer(
+	ctx context.Context,
+	tfr repo.TransferRepo,
+	id uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Transfer, error) {
+	transfer, err := tfr.GetTransferByID(ctx, id)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	if transfer == nil || transfer.UserID != userID ||
+		transfer.Status == entity.TransferStatusUnlinked {
+		return nil, errs.ErrTransferNotFound
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+)
+
+type UnlinkTransferUseCase struct {
+	tfr repo.TransferRepo
+}
+
+func NewUnlinkTransferUseCase(
+	tfr repo.TransferRepo,
+) *UnlinkTransferUseCase {
+	return &UnlinkTransferUseCase{
+		tfr: tfr,
+	}
+}
+
+type UnlinkTransferUseCaseInput struct {
+	ID     uuid.UUID `json:"transfer_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// Execute unlinks a transfer, so both transactions count as income and
+// expense again. The pair is kept as unlinked so it is not matched again.
+func (uc *UnlinkTransferUseCase) Execute(
+	ctx context.Context,
+	in UnlinkTransferUseCaseInput,
+) error {
+	if _, err := getOwnTransfer(ctx, uc.tfr, in.ID, in.UserID); err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.tfr.UpdateTransferStatus(
+		ctx,
+		repo.UpdateTransferStatusParams{
+			ID:     in.ID,
+			Status: entity.TransferStatusUnlinked,
+		},
+	); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
@@ -6,21 +6,28 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/hash"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
 	"github.com/google/uuid"
 )
 
 type DeleteUserUseCase struct {
 	h  *hash.Hasher
+	s  storage.Storage
 	ur repo.UserRepo
+	ar repo.AttachmentRepo
 }
 
 func NewDeleteUserUseCase(
 	h *hash.Hasher,
+	s storage.Storage,
 	ur repo.UserRepo,
+	ar repo.AttachmentRepo,
 ) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
 		h:  h,
+		s:  s,
 		ur: ur,
+		ar: ar,
 	}
 }
 
@@ -43,6 +50,10 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, id uuid.UUID) error {
 		return errs.New(err)
 	}
 
+	if err := uc.deleteAttachments(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
 	params := repo.DeleteUserParams{
 		ID:    id,
 		Name:  hashedName,
@@ -55,3 +66,33 @@ func (uc *DeleteUserUseCase) Execute(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// deleteAttachments removes the user attachment files from the blob storage
+// and their records, as they may contain personal data.
+func (uc *DeleteUserUseCase) deleteAttachments(
+	ctx context.Context,
+	userID uuid.UUID,
+) error {
+	attachments, err := uc.ar.ListAttachmentsByUserID(ctx, userID)
+	if err != nil {
+		return errs.New(err)
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	keys := make([]storage.Key, 0, len(attachments))
+	for _, a := range attachments {
+		keys = append(keys, a.StorageKey)
+	}
+
+	if err := uc.s.Delete(ctx, keys...); err != nil {
+		return errs.New(err)
+	}
+
+	if err := uc.ar.DeleteAttachmentsByUserID(ctx, userID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
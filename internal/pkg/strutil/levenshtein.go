@@ -0,0 +1,47 @@
+package strutil
+
+// Levenshtein returns the minimum number of single character insertions,
+// deletions or substitutions needed to turn a into b.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(
+				prev[j]+1,
+				curr[j-1]+1,
+				prev[j-1]+cost,
+			)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// LevenshteinDistancePercentage returns the Levenshtein distance between a
+// and b relative to the length of the longest one, from 0 (equal) to 1.
+func LevenshteinDistancePercentage(a, b string) float64 {
+	maxLen := max(len([]rune(a)), len([]rune(b)))
+	if maxLen == 0 {
+		return 0
+	}
+	return float64(Levenshtein(a, b)) / float64(maxLen)
+}
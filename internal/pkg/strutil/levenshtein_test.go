@@ -0,0 +1,83 @@
+package strutil
+
+import "testing"
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "equal strings",
+			a:    "uber trip",
+			b:    "uber trip",
+			want: 0,
+		},
+		{
+			name: "empty string",
+			a:    "",
+			b:    "ifood",
+			want: 5,
+		},
+		{
+			name: "substitution, insertion and deletion",
+			a:    "kitten",
+			b:    "sitting",
+			want: 3,
+		},
+		{
+			name: "multibyte runes",
+			a:    "padaria são joão",
+			b:    "padaria sao joao",
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Levenshtein(tt.a, tt.b); got != tt.want {
+				t.Errorf("Levenshtein() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistancePercentage(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{
+			name: "empty strings",
+			a:    "",
+			b:    "",
+			want: 0,
+		},
+		{
+			name: "completely different strings",
+			a:    "abc",
+			b:    "xyz",
+			want: 1,
+		},
+		{
+			name: "relative to the longest string",
+			a:    "netflix",
+			b:    "netflix.com",
+			want: 4.0 / 11.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LevenshteinDistancePercentage(tt.a, tt.b); got != tt.want {
+				t.Errorf(
+					"LevenshteinDistancePercentage() = %v, want %v",
+					got,
+					tt.want,
+				)
+			}
+		})
+	}
+}
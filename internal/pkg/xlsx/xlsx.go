@@ -0,0 +1,225 @@
+// Package xlsx writes spreadsheets with a single sheet, streaming the rows
+// into the file instead of holding them in memory.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Number is written as a number with two decimal places and thousands
+// separators, displayed by the spreadsheet in the locale of the reader.
+type Number float64
+
+// Styles of the cells, indexes of the cellXfs in styles.xml.
+const (
+	styleDefault = 0
+	styleDate    = 1
+	styleNumber  = 2
+)
+
+// excelEpoch is the day 0 of spreadsheet dates.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+type Writer struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	row   int
+}
+
+// NewWriter starts a spreadsheet with a sheet named sheetName. Close must be
+// called to finish it.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	escapedSheetName, err := escape(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rels},
+		{"xl/workbook.xml", fmt.Sprintf(workbook, escapedSheetName)},
+		{"xl/_rels/workbook.xml.rels", workbookRels},
+		{"xl/styles.xml", styles},
+	}
+	for _, file := range files {
+		f, err := zw.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, file.content); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := bufio.NewWriter(f)
+	if _, err := sheet.WriteString(sheetHeader); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		zw:    zw,
+		sheet: sheet,
+	}, nil
+}
+
+// WriteRow appends a row to the sheet. Cells may be strings, Numbers, ints
+// and dates, nil leaves the cell empty.
+func (w *Writer) WriteRow(cells ...any) error {
+	row := w.row + 1
+
+	// The row is built apart so a cell of an unsupported type does not
+	// leave it half written
+	b := &strings.Builder{}
+	fmt.Fprintf(b, `<row r="%d">`, row)
+
+	for i, cell := range cells {
+		ref := columnName(i) + strconv.Itoa(row)
+
+		switch value := cell.(type) {
+		case nil:
+			continue
+
+		case string:
+			escaped, err := escape(value)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(
+				b,
+				`<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+				ref,
+				styleDefault,
+				escaped,
+			)
+
+		case Number:
+			fmt.Fprintf(
+				b,
+				`<c r="%s" s="%d"><v>%s</v></c>`,
+				ref,
+				styleNumber,
+				strconv.FormatFloat(float64(value), 'f', -1, 64),
+			)
+
+		case int, int64:
+			fmt.Fprintf(
+				b,
+				`<c r="%s" s="%d"><v>%d</v></c>`,
+				ref,
+				styleDefault,
+				value,
+			)
+
+		case time.Time:
+			date := time.Date(
+				value.Year(),
+				value.Month(),
+				value.Day(),
+				0, 0, 0, 0,
+				time.UTC,
+			)
+			fmt.Fprintf(
+				b,
+				`<c r="%s" s="%d"><v>%d</v></c>`,
+				ref,
+				styleDate,
+				int(date.Sub(excelEpoch).Hours()/24),
+			)
+
+		default:
+			return fmt.Errorf("unsupported cell type %T", cell)
+		}
+	}
+
+	b.WriteString(`</row>`)
+
+	if _, err := w.sheet.WriteString(b.String()); err != nil {
+		return err
+	}
+
+	w.row = row
+
+	return nil
+}
+
+// Close finishes the sheet and the file, it does not close the underlying
+// writer.
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(sheetFooter); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// columnName returns the letters of the column at index i, like "A" for 0
+// and "AA" for 26.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func escape(value string) (string, error) {
+	var b strings.Builder
+	if err := xml.EscapeText(&b, []byte(value)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+const contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const workbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// styles has the built-in formats 14, a short date, and 4, "#,##0.00".
+const styles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+const sheetHeader = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const sheetFooter = `</sheetData></worksheet>`
@@ -0,0 +1,82 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "Transações")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.WriteRow("Data", "Nome", "Valor"))
+	assert.NoError(t, w.WriteRow(
+		time.Date(2024, 1, 5, 15, 0, 0, 0, time.UTC),
+		"Padaria <Centro> & Café",
+		Number(-12.5),
+		nil,
+		int64(3),
+	))
+	assert.Error(t, w.WriteRow(struct{}{}))
+	assert.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		assert.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		assert.NoError(t, rc.Close())
+		files[f.Name] = string(content)
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if !assert.NoError(t, err, f.Name) {
+				break
+			}
+		}
+	}
+
+	assert.Contains(t, files, "[Content_Types].xml")
+	assert.Contains(t, files["xl/workbook.xml"], `name="Transações"`)
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c r="A2" s="1"><v>45296</v></c>`)
+	assert.Contains(t, sheet, `Padaria &lt;Centro&gt; &amp; Café`)
+	assert.Contains(t, sheet, `<c r="C2" s="2"><v>-12.5</v></c>`)
+	assert.Contains(t, sheet, `<c r="E2" s="0"><v>3</v></c>`)
+	assert.NotContains(t, sheet, `r="D2"`)
+}
+
+func TestColumnName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]string{
+		0:   "A",
+		25:  "Z",
+		26:  "AA",
+		27:  "AB",
+		701: "ZZ",
+		702: "AAA",
+	}
+
+	for i, expected := range tests {
+		assert.Equal(t, expected, columnName(i))
+	}
+}
@@ -15,15 +15,38 @@ type Cache interface {
 		expiration time.Duration,
 	) error
 
+	// SetNX sets the value only if the key does not exist yet,
+	// reporting whether it was set. It is used as a distributed lock.
+	SetNX(
+		ctx context.Context,
+		key Key,
+		value any,
+		expiration time.Duration,
+	) (ok bool, err error)
+
 	Delete(
 		ctx context.Context,
 		keys ...Key,
 	) error
+
+	// DeleteIfEqual deletes the key only if it still holds the value,
+	// reporting whether it was deleted. It releases a lock set with SetNX
+	// without removing one that expired and was acquired by someone else.
+	DeleteIfEqual(
+		ctx context.Context,
+		key Key,
+		value string,
+	) (ok bool, err error)
 }
 
 type Key = string
 
 const (
-	KeySyncTransactionsOffset Key = "sync_transactions_offset"
-	KeySyncBalancesOffset     Key = "sync_balances_offset"
+	KeySyncTransactionsOffset  Key = "sync_transactions_offset"
+	KeySyncBalancesOffset      Key = "sync_balances_offset"
+	KeySyncInvestmentsOffset   Key = "sync_investments_offset"
+	KeyJobLock                 Key = "job_lock"
+	KeyUserInstitutionSyncLock Key = "user_institution_sync_lock"
+	KeyWebhookEvent            Key = "webhook_event"
+	KeyAttachmentQuotaLock     Key = "attachment_quota_lock"
 )
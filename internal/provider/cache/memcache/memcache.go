@@ -0,0 +1,162 @@
+package memcache
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
+)
+
+type item struct {
+	value     any
+	expiresAt time.Time
+}
+
+func (i item) expired(now time.Time) bool {
+	return !i.expiresAt.IsZero() && !now.Before(i.expiresAt)
+}
+
+// MemCache is an in-memory cache.Cache for tests, it behaves like the redis
+// one for a single process.
+type MemCache struct {
+	mu    sync.Mutex
+	items map[cache.Key]item
+}
+
+func NewMemCache() *MemCache {
+	return &MemCache{
+		items: map[cache.Key]item{},
+	}
+}
+
+func (m *MemCache) Scan(
+	_ context.Context,
+	key cache.Key,
+	value any,
+) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i, ok := m.get(key)
+	if !ok {
+		return false, nil
+	}
+
+	dest := reflect.ValueOf(value)
+	if dest.Kind() != reflect.Pointer || dest.IsNil() {
+		return false, fmt.Errorf("memcache: scan into non-pointer %T", value)
+	}
+
+	src := reflect.ValueOf(i.value)
+	switch {
+	case !src.IsValid():
+		dest.Elem().SetZero()
+	case src.Type().AssignableTo(dest.Elem().Type()):
+		dest.Elem().Set(src)
+	case src.Type().ConvertibleTo(dest.Elem().Type()):
+		dest.Elem().Set(src.Convert(dest.Elem().Type()))
+	default:
+		return false, fmt.Errorf(
+			"memcache: cannot scan %T into %T",
+			i.value,
+			value,
+		)
+	}
+
+	return true, nil
+}
+
+func (m *MemCache) Set(
+	_ context.Context,
+	key cache.Key,
+	value any,
+	expiration time.Duration,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.set(key, value, expiration)
+	return nil
+}
+
+func (m *MemCache) SetNX(
+	_ context.Context,
+	key cache.Key,
+	value any,
+	expiration time.Duration,
+) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.get(key); ok {
+		return false, nil
+	}
+
+	m.set(key, value, expiration)
+	return true, nil
+}
+
+func (m *MemCache) Delete(
+	_ context.Context,
+	keys ...cache.Key,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.items, key)
+	}
+	return nil
+}
+
+func (m *MemCache) DeleteIfEqual(
+	_ context.Context,
+	key cache.Key,
+	value string,
+) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	i, ok := m.get(key)
+	if !ok || fmt.Sprint(i.value) != value {
+		return false, nil
+	}
+
+	delete(m.items, key)
+	return true, nil
+}
+
+// get returns the item of the key, removing it if it expired. The caller
+// must hold the lock.
+func (m *MemCache) get(key cache.Key) (item, bool) {
+	i, ok := m.items[key]
+	if !ok {
+		return item{}, false
+	}
+
+	if i.expired(time.Now()) {
+		delete(m.items, key)
+		return item{}, false
+	}
+
+	return i, true
+}
+
+// set stores the value, a zero expiration keeps it forever as in redis. The
+// caller must hold the lock.
+func (m *MemCache) set(
+	key cache.Key,
+	value any,
+	expiration time.Duration,
+) {
+	i := item{value: value}
+	if expiration > 0 {
+		i.expiresAt = time.Now().Add(expiration)
+	}
+	m.items[key] = i
+}
+
+var _ cache.Cache = (*MemCache)(nil)
@@ -0,0 +1,64 @@
+package memcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemCache(t *testing.T) {
+	t.Parallel()
+	asserts := assert.New(t)
+	ctx := context.Background()
+
+	// Arrange
+	c := NewMemCache()
+
+	// Act & Assert
+	ok, err := c.SetNX(ctx, "lock", "token", 0)
+	asserts.Nil(err)
+	asserts.True(ok)
+
+	ok, err = c.SetNX(ctx, "lock", "other-token", 0)
+	asserts.Nil(err)
+	asserts.False(ok)
+
+	ok, err = c.DeleteIfEqual(ctx, "lock", "other-token")
+	asserts.Nil(err)
+	asserts.False(ok)
+
+	var value string
+	ok, err = c.Scan(ctx, "lock", &value)
+	asserts.Nil(err)
+	asserts.True(ok)
+	asserts.Equal("token", value)
+
+	ok, err = c.DeleteIfEqual(ctx, "lock", "token")
+	asserts.Nil(err)
+	asserts.True(ok)
+
+	ok, err = c.Scan(ctx, "lock", &value)
+	asserts.Nil(err)
+	asserts.False(ok)
+
+	asserts.Nil(c.Set(ctx, "offset", 10, time.Millisecond))
+	var offset int
+	ok, err = c.Scan(ctx, "offset", &offset)
+	asserts.Nil(err)
+	asserts.True(ok)
+	asserts.Equal(10, offset)
+
+	time.Sleep(2 * time.Millisecond)
+	ok, err = c.Scan(ctx, "offset", &offset)
+	asserts.Nil(err)
+	asserts.False(ok)
+
+	asserts.Nil(c.Set(ctx, "body", "cached", 0))
+	var body []byte
+	ok, err = c.Scan(ctx, "body", &body)
+	asserts.Nil(err)
+	asserts.True(ok)
+	asserts.Equal([]byte("cached"), body)
+}
@@ -10,6 +10,14 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/cache"
 )
 
+// deleteIfEqualScript deletes the key atomically only if it holds the value.
+var deleteIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 type RedisCache struct {
 	c *redis.Client
 }
@@ -63,6 +71,15 @@ func (r *RedisCache) Set(
 	return r.c.Set(ctx, key, value, expiration).Err()
 }
 
+func (r *RedisCache) SetNX(
+	ctx context.Context,
+	key cache.Key,
+	value any,
+	expiration time.Duration,
+) (bool, error) {
+	return r.c.SetNX(ctx, key, value, expiration).Result()
+}
+
 func (r *RedisCache) Delete(
 	ctx context.Context,
 	keys ...cache.Key,
@@ -72,4 +89,22 @@ func (r *RedisCache) Delete(
 	return r.c.Del(ctx, ks...).Err()
 }
 
+func (r *RedisCache) DeleteIfEqual(
+	ctx context.Context,
+	key cache.Key,
+	value string,
+) (bool, error) {
+	deleted, err := deleteIfEqualScript.Run(
+		ctx,
+		r.c,
+		[]string{key},
+		value,
+	).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return deleted == 1, nil
+}
+
 var _ cache.Cache = (*RedisCache)(nil)
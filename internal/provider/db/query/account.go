@@ -6,6 +6,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/doug-martin/goqu/v9"
@@ -47,7 +48,6 @@ func (qb *QueryBuilder) ListFullAccounts(
 		From(schema.Account.String()).
 		Select(
 			schema.Account.All(),
-			goqu.I(schema.UserInstitution.UserID()),
 			goqu.I(schema.UserInstitution.InstitutionID()),
 			goqu.I(schema.UserInstitution.ExternalID()).
 				As("user_institution_external_id"),
@@ -69,6 +69,48 @@ func (qb *QueryBuilder) ListFullAccounts(
 	return accounts, nil
 }
 
+// ListManualAccounts lists the accounts created by the user with their
+// balance, the opening balance plus the transactions up to now.
+func (qb *QueryBuilder) ListManualAccounts(
+	ctx context.Context,
+	opts ...repo.AccountOptions,
+) ([]entity.ManualAccount, error) {
+	options := prepareOptions(opts...)
+	options.IsManual = ptr.New(true)
+
+	transactionsSubQuery := qb.buildManualAccountTransactionsSubQuery(
+		time.Now(),
+	)
+
+	query := goqu.
+		From(schema.Account.String()).
+		Select(
+			schema.Account.All(),
+			goqu.L(
+				"(? + COALESCE(mt.amount, 0))::bigint",
+				goqu.I(schema.Account.OpeningBalance()),
+			).As("balance"),
+		).
+		LeftJoin(
+			goqu.Lateral(transactionsSubQuery).As("mt"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		Where(goqu.I(schema.Account.DeletedAt()).IsNull())
+
+	joins := qb.buildAccountJoins(options)
+
+	whereExps, orderedExps := qb.buildAccountExpressions(options)
+
+	query = qb.buildAccountsQuery(query, options, whereExps, joins, orderedExps)
+
+	var accounts []entity.ManualAccount
+	if err := qb.Scan(ctx, query, &accounts); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return accounts, nil
+}
+
 func (qb *QueryBuilder) CountAccounts(
 	ctx context.Context,
 	opts ...repo.AccountOptions,
@@ -111,7 +153,7 @@ func (qb *QueryBuilder) buildAccountJoins(
 		Table: goqu.I(schema.User.String()),
 		Condition: goqu.
 			On(
-				goqu.I(schema.UserInstitution.UserID()).
+				goqu.I(schema.Account.UserID()).
 					Eq(goqu.I(schema.User.ID())),
 			),
 	}
@@ -120,9 +162,8 @@ func (qb *QueryBuilder) buildAccountJoins(
 		return []Join{userInstitutionJoin, userJoin}
 	}
 
-	if len(options.UserIDs) > 0 || len(options.UserTiers) > 0 ||
-		options.IsSubscriptionActive != nil {
-		return []Join{userJoin}
+	if len(options.UserTiers) > 0 || options.IsSubscriptionActive != nil {
+		return []Join{userInstitutionJoin, userJoin}
 	}
 
 	return []Join{}
@@ -131,8 +172,27 @@ func (qb *QueryBuilder) buildAccountJoins(
 func (qb *QueryBuilder) buildAccountExpressions(
 	options repo.AccountOptions,
 ) (whereExps []goqu.Expression, orderedExps []exp.OrderedExpression) {
+	if len(options.IDs) > 0 {
+		exp := goqu.I(schema.Account.ID()).In(options.IDs)
+		whereExps = append(whereExps, exp)
+	}
+
 	if len(options.UserIDs) > 0 {
-		exp := goqu.I(schema.User.ID()).In(options.UserIDs)
+		exp := goqu.I(schema.Account.UserID()).In(options.UserIDs)
+		whereExps = append(whereExps, exp)
+	}
+
+	if len(options.UserInstitutionIDs) > 0 {
+		exp := goqu.I(schema.Account.UserInstitutionID()).
+			In(options.UserInstitutionIDs)
+		whereExps = append(whereExps, exp)
+	}
+
+	if options.IsManual != nil {
+		exp := goqu.I(schema.Account.UserInstitutionID()).IsNotNull()
+		if *options.IsManual {
+			exp = goqu.I(schema.Account.UserInstitutionID()).IsNull()
+		}
 		whereExps = append(whereExps, exp)
 	}
 
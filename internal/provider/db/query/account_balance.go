@@ -32,14 +32,26 @@ func (qb *QueryBuilder) GetUserBalanceOnDate(
 		Order(goqu.I(schema.AccountBalance.CreatedAt()).Desc()).
 		Limit(1)
 
+	// Manual accounts have no synced balance, it is their opening balance
+	// plus their transactions.
+	manualSubQuery := qb.buildManualAccountTransactionsSubQuery(date)
+
 	query := goqu.
 		From(schema.Account.String()).
-		Select(goqu.L("COALESCE(SUM(ab.amount), 0)::bigint AS total_balance")).
+		Select(goqu.L(
+			"COALESCE(SUM(CASE WHEN ? IS NULL THEN ? + COALESCE(mt.amount, 0) ELSE ab.amount END), 0)::bigint AS total_balance",
+			goqu.I(schema.Account.UserInstitutionID()),
+			goqu.I(schema.Account.OpeningBalance()),
+		)).
 		LeftJoin(
 			goqu.Lateral(subQuery).As("ab"),
 			goqu.On(goqu.L("TRUE")),
 		).
-		Join(
+		LeftJoin(
+			goqu.Lateral(manualSubQuery).As("mt"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		LeftJoin(
 			goqu.I(schema.UserInstitution.String()),
 			goqu.On(
 				goqu.I(schema.Account.UserInstitutionID()).
@@ -47,19 +59,20 @@ func (qb *QueryBuilder) GetUserBalanceOnDate(
 			)).
 		Where(
 			goqu.Ex{
-				schema.Account.Type():           entity.AccountTypeBank,
-				schema.UserInstitution.UserID(): userID,
+				schema.Account.Type(): []entity.AccountType{
+					entity.AccountTypeBank,
+					entity.AccountTypeWallet,
+				},
+				schema.Account.UserID(): userID,
 			},
 			goqu.I(schema.Account.DeletedAt()).IsNull(),
-			goqu.I(schema.UserInstitution.DeletedAt()).IsNull(),
+			goqu.Or(
+				goqu.I(schema.Account.UserInstitutionID()).IsNull(),
+				goqu.I(schema.UserInstitution.DeletedAt()).IsNull(),
+			),
 		)
 
-	if len(opts.InstitutionIDs) > 0 {
-		query = query.Where(
-			goqu.I(schema.UserInstitution.InstitutionID()).
-				In(opts.InstitutionIDs),
-		)
-	}
+	query = qb.buildAccountBalanceQuery(query, opts)
 
 	var totalBalance int64
 	if err := qb.Scan(ctx, query, &totalBalance); err != nil {
@@ -68,3 +81,158 @@ func (qb *QueryBuilder) GetUserBalanceOnDate(
 
 	return totalBalance, nil
 }
+
+// ListUserBalanceHistory lists the balance of each account of the user at
+// the end of each interval from startDate to endDate. Intervals without a
+// synced balance carry the latest one forward and credit card balances are
+// negative, so the sum of the accounts of a point is the net worth.
+func (qb *QueryBuilder) ListUserBalanceHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate time.Time,
+	interval entity.BalanceInterval,
+	options ...repo.AccountBalanceOptions,
+) ([]entity.AccountBalancePoint, error) {
+	opts := prepareOptions(options...)
+
+	step := "1 " + string(interval)
+
+	// The balance of a point is the one at the end of its interval, or now
+	// for the interval in progress.
+	pointEnd := goqu.L(
+		"LEAST(p.date + ?::interval - INTERVAL '1 microsecond', ?::timestamptz)",
+		step,
+		time.Now(),
+	)
+
+	subQuery := goqu.
+		From(schema.AccountBalance.String()).
+		Select(schema.AccountBalance.Amount()).
+		Where(
+			goqu.I(schema.AccountBalance.AccountID()).
+				Eq(goqu.I(schema.Account.ID())),
+			goqu.I(schema.AccountBalance.CreatedAt()).Lte(pointEnd),
+			goqu.I(schema.AccountBalance.DeletedAt()).IsNull(),
+		).
+		Order(goqu.I(schema.AccountBalance.CreatedAt()).Desc()).
+		Limit(1)
+
+	manualSubQuery := qb.buildManualAccountTransactionsSubQuery(pointEnd)
+
+	query := goqu.
+		From(goqu.L(
+			"generate_series(?::timestamptz, ?::timestamptz, ?::interval) AS p(date)",
+			startDate,
+			endDate,
+			step,
+		)).
+		Select(
+			goqu.L("p.date").As("date"),
+			goqu.I(schema.Account.ID()).As("account_id"),
+			goqu.I(schema.Account.Name()).As("account_name"),
+			goqu.I(schema.Account.Type()).As("account_type"),
+			goqu.I(schema.Institution.ID()).As("institution_id"),
+			goqu.I(schema.Institution.Name()).As("institution_name"),
+			goqu.L(
+				"(CASE WHEN ? IS NULL THEN ? + COALESCE(mt.amount, 0) ELSE COALESCE(ab.amount, 0) END * CASE WHEN ? = ? THEN -1 ELSE 1 END)::bigint",
+				goqu.I(schema.Account.UserInstitutionID()),
+				goqu.I(schema.Account.OpeningBalance()),
+				goqu.I(schema.Account.Type()),
+				entity.AccountTypeCredit,
+			).As("balance"),
+		).
+		CrossJoin(goqu.I(schema.Account.String())).
+		LeftJoin(
+			goqu.Lateral(subQuery).As("ab"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		LeftJoin(
+			goqu.Lateral(manualSubQuery).As("mt"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		LeftJoin(
+			goqu.I(schema.UserInstitution.String()),
+			goqu.On(
+				goqu.I(schema.Account.UserInstitutionID()).
+					Eq(goqu.I(schema.UserInstitution.ID())),
+			)).
+		LeftJoin(
+			goqu.I(schema.Institution.String()),
+			goqu.On(
+				goqu.I(schema.UserInstitution.InstitutionID()).
+					Eq(goqu.I(schema.Institution.ID())),
+			)).
+		Where(
+			goqu.Ex{
+				schema.Account.Type(): []entity.AccountType{
+					entity.AccountTypeBank,
+					entity.AccountTypeCredit,
+					entity.AccountTypeWallet,
+				},
+				schema.Account.UserID(): userID,
+			},
+			goqu.I(schema.Account.DeletedAt()).IsNull(),
+			goqu.Or(
+				goqu.I(schema.Account.UserInstitutionID()).IsNull(),
+				goqu.I(schema.UserInstitution.DeletedAt()).IsNull(),
+			),
+		)
+
+	query = qb.buildAccountBalanceQuery(query, opts).
+		Order(
+			goqu.L("p.date").Asc(),
+			goqu.I(schema.Account.Name()).Asc(),
+			goqu.I(schema.Account.ID()).Asc(),
+		)
+
+	var points []entity.AccountBalancePoint
+	if err := qb.Scan(ctx, query, &points); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return points, nil
+}
+
+// buildManualAccountTransactionsSubQuery sums the transactions of the manual
+// account of the outer query up to the date, which added to its opening
+// balance is its balance. Hidden transactions are left out like in the
+// transaction listings.
+func (qb *QueryBuilder) buildManualAccountTransactionsSubQuery(
+	date any,
+) *goqu.SelectDataset {
+	return goqu.
+		From(schema.Transaction.String()).
+		Select(goqu.SUM(goqu.I(schema.Transaction.Amount())).As("amount")).
+		Where(
+			goqu.I(schema.Transaction.AccountID()).
+				Eq(goqu.I(schema.Account.ID())),
+			goqu.I(schema.Transaction.Date()).Lte(date),
+			goqu.I(schema.Transaction.DeletedAt()).IsNull(),
+			goqu.I(schema.Transaction.IsHidden()).IsFalse(),
+		)
+}
+
+func (qb *QueryBuilder) buildAccountBalanceQuery(
+	query *goqu.SelectDataset,
+	opts repo.AccountBalanceOptions,
+) *goqu.SelectDataset {
+	if len(opts.InstitutionIDs) > 0 || len(opts.AccountIDs) > 0 {
+		var exps []goqu.Expression
+		if len(opts.InstitutionIDs) > 0 {
+			exps = append(
+				exps,
+				goqu.I(schema.UserInstitution.InstitutionID()).
+					In(opts.InstitutionIDs),
+			)
+		}
+		if len(opts.AccountIDs) > 0 {
+			exps = append(
+				exps,
+				goqu.I(schema.Account.ID()).In(opts.AccountIDs),
+			)
+		}
+		query = query.Where(goqu.Or(exps...))
+	}
+
+	return query
+}
@@ -44,6 +44,67 @@ func (qb *QueryBuilder) ListInstitutions(
 	return institutions, nil
 }
 
+func (qb *QueryBuilder) ListFullInstitutions(
+	ctx context.Context,
+	opts ...repo.InstitutionOptions,
+) ([]entity.FullInstitution, error) {
+	options := prepareOptions(opts...)
+
+	var (
+		userInstitutionIDExp exp.Expression = goqu.L("NULL::uuid")
+		connectionStatusExp  exp.Expression = goqu.L("NULL::text")
+	)
+	if len(options.UserIDs) > 0 {
+		userInstitutionIDExp = goqu.I(schema.UserInstitution.ID())
+		connectionStatusExp = goqu.I(schema.UserInstitution.Status())
+	}
+
+	query := goqu.
+		From(schema.Institution.String()).
+		Select(
+			schema.Institution.All(),
+			goqu.L("?", userInstitutionIDExp).As("user_institution_id"),
+			goqu.L("?", connectionStatusExp).As("connection_status"),
+		).
+		Distinct(schema.Institution.ID()).
+		Where(goqu.I(schema.Institution.DeletedAt()).IsNull())
+
+	query = qb.buildInstitutionJoins(query, options)
+
+	orderedExps := []exp.OrderedExpression{
+		goqu.I(schema.Institution.ID()).Asc(),
+	}
+
+	// When a user has more than one connection to the same institution, the
+	// one needing attention is listed.
+	if len(options.UserIDs) > 0 {
+		orderedExps = append(
+			orderedExps,
+			goqu.Case().
+				When(
+					goqu.I(schema.UserInstitution.Status()).
+						Eq(entity.UserInstitutionStatusConnected),
+					1,
+				).
+				Else(0).
+				Asc(),
+		)
+	}
+
+	whereExps, auxOrderedExps := qb.buildInstitutionExpressions(options)
+
+	orderedExps = append(orderedExps, auxOrderedExps...)
+
+	query = qb.buildInstitutionQuery(query, options, whereExps, orderedExps)
+
+	var institutions []entity.FullInstitution
+	if err := qb.Scan(ctx, query, &institutions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return institutions, nil
+}
+
 func (qb *QueryBuilder) CountInstitutions(
 	ctx context.Context,
 	opts ...repo.InstitutionOptions,
@@ -107,6 +168,7 @@ func (qb *QueryBuilder) buildInstitutionExpressions(
 			whereExps,
 			goqu.I(schema.UserInstitution.UserID()).
 				In(options.UserIDs),
+			goqu.I(schema.UserInstitution.DeletedAt()).IsNull(),
 		)
 	}
 
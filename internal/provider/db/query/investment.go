@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/doug-martin/goqu/v9"
+)
+
+func (qb *QueryBuilder) ListInvestments(
+	ctx context.Context,
+	opts ...repo.InvestmentOptions,
+) ([]entity.Investment, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.Investment.String()).
+		Select(schema.Investment.All()).
+		Join(
+			goqu.I(schema.UserInstitution.String()),
+			goqu.On(
+				goqu.I(schema.Investment.UserInstitutionID()).
+					Eq(goqu.I(schema.UserInstitution.ID())),
+			),
+		).
+		Where(goqu.I(schema.Investment.DeletedAt()).IsNull())
+
+	query = qb.buildInvestmentQuery(query, options)
+
+	var investments []entity.Investment
+	if err := qb.Scan(ctx, query, &investments); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return investments, nil
+}
+
+func (qb *QueryBuilder) ListFullInvestments(
+	ctx context.Context,
+	opts ...repo.InvestmentOptions,
+) ([]entity.FullInvestment, error) {
+	options := prepareOptions(opts...)
+
+	positionQuery := goqu.
+		From(schema.InvestmentPosition.String()).
+		Select(schema.InvestmentPosition.Amount()).
+		Where(
+			goqu.I(schema.InvestmentPosition.InvestmentID()).
+				Eq(goqu.I(schema.Investment.ID())),
+			goqu.I(schema.InvestmentPosition.DeletedAt()).IsNull(),
+		).
+		Order(goqu.I(schema.InvestmentPosition.CreatedAt()).Desc()).
+		Limit(1)
+
+	query := goqu.
+		From(schema.Investment.String()).
+		Select(
+			schema.Investment.All(),
+			goqu.L("COALESCE(ip.amount, 0)::bigint").As("balance"),
+			goqu.I(schema.UserInstitution.UserID()),
+			goqu.I(schema.Institution.ID()).As("institution_id"),
+			goqu.I(schema.Institution.Name()).As("institution_name"),
+			goqu.I(schema.Institution.Logo()).As("institution_logo"),
+		).
+		LeftJoin(
+			goqu.Lateral(positionQuery).As("ip"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		Join(
+			goqu.I(schema.UserInstitution.String()),
+			goqu.On(
+				goqu.I(schema.Investment.UserInstitutionID()).
+					Eq(goqu.I(schema.UserInstitution.ID())),
+			),
+		).
+		Join(
+			goqu.I(schema.Institution.String()),
+			goqu.On(
+				goqu.I(schema.UserInstitution.InstitutionID()).
+					Eq(goqu.I(schema.Institution.ID())),
+			),
+		).
+		Where(goqu.I(schema.Investment.DeletedAt()).IsNull())
+
+	query = qb.buildInvestmentQuery(query, options).
+		Order(
+			goqu.I(schema.Institution.Name()).Asc(),
+			goqu.I(schema.Investment.Name()).Asc(),
+		)
+
+	var investments []entity.FullInvestment
+	if err := qb.Scan(ctx, query, &investments); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return investments, nil
+}
+
+func (qb *QueryBuilder) buildInvestmentQuery(
+	query *goqu.SelectDataset,
+	options repo.InvestmentOptions,
+) *goqu.SelectDataset {
+	query = query.Where(goqu.I(schema.UserInstitution.DeletedAt()).IsNull())
+
+	if len(options.UserIDs) > 0 {
+		query = query.Where(
+			goqu.I(schema.UserInstitution.UserID()).In(options.UserIDs),
+		)
+	}
+
+	if len(options.UserInstitutionIDs) > 0 {
+		query = query.Where(
+			goqu.I(schema.Investment.UserInstitutionID()).
+				In(options.UserInstitutionIDs),
+		)
+	}
+
+	if len(options.ExternalIDs) > 0 {
+		query = query.Where(
+			goqu.I(schema.Investment.ExternalID()).In(options.ExternalIDs),
+		)
+	}
+
+	return query
+}
@@ -164,6 +164,48 @@ func (qb *QueryBuilder) scan(
 	}
 }
 
+// each runs the query and calls fn with each row, scanning one row at a time
+// instead of loading the whole result into memory.
+func each[T any](
+	ctx context.Context,
+	qb *QueryBuilder,
+	query *goqu.SelectDataset,
+	fn func(T) error,
+) error {
+	sql, args, err := query.ToSQL()
+	if err != nil {
+		return errs.New(err)
+	}
+
+	rows, err := qb.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return errs.New(
+			fmt.Errorf(
+				"failed to execute sql query: sql query: %s: %w",
+				sql,
+				err,
+			),
+		)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dest T
+		if err := rows.StructScan(&dest); err != nil {
+			return errs.New(err)
+		}
+		if err := fn(dest); err != nil {
+			return errs.New(err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
 func prepareOptions[T any](
 	opts ...T,
 ) T {
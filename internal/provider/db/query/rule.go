@@ -0,0 +1,108 @@
+package query
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+)
+
+func (qb *QueryBuilder) ListRules(
+	ctx context.Context,
+	opts ...repo.RuleOptions,
+) ([]entity.Rule, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.Rule.String()).
+		Select(schema.Rule.All()).
+		Where(goqu.I(schema.Rule.DeletedAt()).IsNull())
+
+	whereExps, orderedExps := qb.buildRuleExpressions(options)
+
+	query = qb.buildRuleQuery(
+		query,
+		options,
+		whereExps,
+		orderedExps,
+	)
+
+	var rules []entity.Rule
+	if err := qb.Scan(ctx, query, &rules); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return rules, nil
+}
+
+func (qb *QueryBuilder) CountRules(
+	ctx context.Context,
+	opts ...repo.RuleOptions,
+) (int64, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.Rule.String()).
+		Select(goqu.COUNT(schema.Rule.All())).
+		Where(goqu.I(schema.Rule.DeletedAt()).IsNull())
+
+	whereExps, _ := qb.buildRuleExpressions(options)
+
+	query = qb.buildRuleQuery(query, options, whereExps, nil)
+
+	var count int64
+	if err := qb.Scan(ctx, query, &count); err != nil {
+		return 0, errs.New(err)
+	}
+
+	return count, nil
+}
+
+func (qb *QueryBuilder) buildRuleExpressions(
+	options repo.RuleOptions,
+) (whereExps []goqu.Expression, orderedExps []exp.OrderedExpression) {
+	if options.UserID != uuid.Nil {
+		whereExps = append(
+			whereExps,
+			goqu.I(schema.Rule.UserID()).Eq(options.UserID),
+		)
+	}
+
+	orderedExps = append(
+		orderedExps,
+		goqu.I(schema.Rule.Priority()).Asc(),
+		goqu.I(schema.Rule.CreatedAt()).Asc(),
+	)
+
+	return whereExps, orderedExps
+}
+
+func (qb *QueryBuilder) buildRuleQuery(
+	query *goqu.SelectDataset,
+	options repo.RuleOptions,
+	whereExps []goqu.Expression,
+	orderedExps []exp.OrderedExpression,
+) *goqu.SelectDataset {
+	if len(whereExps) > 0 {
+		query = query.Where(whereExps...)
+	}
+
+	if len(orderedExps) > 0 {
+		query = query.Order(orderedExps...)
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+
+	if options.Offset > 0 {
+		query = query.Offset(options.Offset)
+	}
+
+	return query
+}
@@ -0,0 +1,98 @@
+package query
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+)
+
+func (qb *QueryBuilder) ListAccountSyncStatuses(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.AccountSyncStatus, error) {
+	latestItemQuery := goqu.
+		From(schema.SyncRunItem.String()).
+		Select(
+			schema.SyncRunItem.Status(),
+			schema.SyncRunItem.Fetched(),
+			schema.SyncRunItem.Inserted(),
+			schema.SyncRunItem.Updated(),
+			schema.SyncRunItem.Skipped(),
+			schema.SyncRunItem.Deleted(),
+			schema.SyncRunItem.FailureReason(),
+			schema.SyncRunItem.CreatedAt(),
+		).
+		Where(
+			goqu.I(schema.SyncRunItem.AccountID()).
+				Eq(goqu.I(schema.Account.ID())),
+		).
+		Order(goqu.I(schema.SyncRunItem.CreatedAt()).Desc()).
+		Limit(1)
+
+	lastSuccessQuery := goqu.
+		From(schema.SyncRunItem.String()).
+		Select(goqu.MAX(schema.SyncRunItem.CreatedAt())).
+		Where(
+			goqu.I(schema.SyncRunItem.AccountID()).
+				Eq(goqu.I(schema.Account.ID())),
+			goqu.I(schema.SyncRunItem.Status()).
+				Eq(entity.SyncRunItemStatusSuccess),
+		)
+
+	query := goqu.
+		From(schema.Account.String()).
+		Select(
+			goqu.I(schema.Account.ID()).As("account_id"),
+			goqu.I(schema.Account.Name()).As("account_name"),
+			goqu.I(schema.Account.Type()).As("account_type"),
+			goqu.I(schema.Institution.Name()).As("institution_name"),
+			goqu.I(schema.Institution.Logo()).As("institution_logo"),
+			goqu.I("sri.status"),
+			goqu.I("sri.fetched"),
+			goqu.I("sri.inserted"),
+			goqu.I("sri.updated"),
+			goqu.I("sri.skipped"),
+			goqu.I("sri.deleted"),
+			goqu.I("sri.failure_reason"),
+			goqu.I("sri.created_at").As("last_sync_at"),
+			lastSuccessQuery.As("last_success_at"),
+		).
+		Join(
+			goqu.I(schema.UserInstitution.String()),
+			goqu.On(
+				goqu.I(schema.Account.UserInstitutionID()).
+					Eq(goqu.I(schema.UserInstitution.ID())),
+			),
+		).
+		Join(
+			goqu.I(schema.Institution.String()),
+			goqu.On(
+				goqu.I(schema.UserInstitution.InstitutionID()).
+					Eq(goqu.I(schema.Institution.ID())),
+			),
+		).
+		LeftJoin(
+			goqu.Lateral(latestItemQuery).As("sri"),
+			goqu.On(goqu.L("TRUE")),
+		).
+		Where(
+			goqu.I(schema.UserInstitution.UserID()).Eq(userID),
+			goqu.I(schema.Account.DeletedAt()).IsNull(),
+			goqu.I(schema.UserInstitution.DeletedAt()).IsNull(),
+		).
+		Order(
+			goqu.I(schema.Institution.Name()).Asc(),
+			goqu.I(schema.Account.Name()).Asc(),
+		)
+
+	var statuses []entity.AccountSyncStatus
+	if err := qb.Scan(ctx, query, &statuses); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,125 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+)
+
+func (qb *QueryBuilder) ListTags(
+	ctx context.Context,
+	opts ...repo.TagOptions,
+) ([]entity.Tag, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.Tag.String()).
+		Select(schema.Tag.All()).
+		Where(goqu.I(schema.Tag.DeletedAt()).IsNull())
+
+	whereExps, orderedExps := qb.buildTagExpressions(options)
+
+	query = qb.buildTagQuery(
+		query,
+		options,
+		whereExps,
+		orderedExps,
+	)
+
+	var tags []entity.Tag
+	if err := qb.Scan(ctx, query, &tags); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return tags, nil
+}
+
+func (qb *QueryBuilder) CountTags(
+	ctx context.Context,
+	opts ...repo.TagOptions,
+) (int64, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.Tag.String()).
+		Select(goqu.COUNT(schema.Tag.All())).
+		Where(goqu.I(schema.Tag.DeletedAt()).IsNull())
+
+	whereExps, _ := qb.buildTagExpressions(options)
+
+	query = qb.buildTagQuery(query, options, whereExps, nil)
+
+	var count int64
+	if err := qb.Scan(ctx, query, &count); err != nil {
+		return 0, errs.New(err)
+	}
+
+	return count, nil
+}
+
+func (qb *QueryBuilder) buildTagExpressions(
+	options repo.TagOptions,
+) (whereExps []goqu.Expression, orderedExps []exp.OrderedExpression) {
+	options.Search = strings.TrimSpace(options.Search)
+	if options.Search != "" {
+		searchExp, orderExp := qb.buildSearch(
+			options.Search,
+			schema.Tag.Name(),
+		)
+		whereExps = append(whereExps, searchExp)
+		orderedExps = append(orderedExps, orderExp.Desc())
+	}
+
+	if len(options.IDs) > 0 {
+		whereExps = append(
+			whereExps,
+			goqu.I(schema.Tag.ID()).In(options.IDs),
+		)
+	}
+
+	if options.UserID != uuid.Nil {
+		whereExps = append(
+			whereExps,
+			goqu.I(schema.Tag.UserID()).Eq(options.UserID),
+		)
+	}
+
+	orderedExps = append(
+		orderedExps,
+		goqu.I(schema.Tag.Name()).Asc(),
+	)
+
+	return whereExps, orderedExps
+}
+
+func (qb *QueryBuilder) buildTagQuery(
+	query *goqu.SelectDataset,
+	options repo.TagOptions,
+	whereExps []goqu.Expression,
+	orderedExps []exp.OrderedExpression,
+) *goqu.SelectDataset {
+	if len(whereExps) > 0 {
+		query = query.Where(whereExps...)
+	}
+
+	if len(orderedExps) > 0 {
+		query = query.Order(orderedExps...)
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+
+	if options.Offset > 0 {
+		query = query.Offset(options.Offset)
+	}
+
+	return query
+}
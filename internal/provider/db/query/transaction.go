@@ -21,7 +21,7 @@ func (qb *QueryBuilder) ListTransactions(
 	options := prepareOptions(opts...)
 
 	query := goqu.
-		From(schema.Transaction.String()).
+		From(qb.buildTransactionsTable(options)).
 		Select(schema.Transaction.All()).
 		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull())
 
@@ -50,10 +50,35 @@ func (qb *QueryBuilder) ListFullTransactions(
 	userID uuid.UUID,
 	opts ...repo.TransactionOptions,
 ) ([]entity.FullTransaction, error) {
-	options := prepareOptions(opts...)
+	query := qb.buildFullTransactionsQuery(userID, prepareOptions(opts...))
+
+	var transactions []entity.FullTransaction
+	if err := qb.Scan(ctx, query, &transactions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return transactions, nil
+}
 
+// EachFullTransaction calls fn with each transaction matching the options,
+// reading them from the database one at a time.
+func (qb *QueryBuilder) EachFullTransaction(
+	ctx context.Context,
+	userID uuid.UUID,
+	fn func(entity.FullTransaction) error,
+	opts ...repo.TransactionOptions,
+) error {
+	query := qb.buildFullTransactionsQuery(userID, prepareOptions(opts...))
+
+	return each(ctx, qb, query, fn)
+}
+
+func (qb *QueryBuilder) buildFullTransactionsQuery(
+	userID uuid.UUID,
+	options repo.TransactionOptions,
+) *goqu.SelectDataset {
 	query := goqu.
-		From(schema.Transaction.String()).
+		From(qb.buildTransactionsTable(options)).
 		Select(
 			schema.Transaction.All(),
 			goqu.I(schema.TransactionCategory.Name()).
@@ -62,6 +87,8 @@ func (qb *QueryBuilder) ListFullTransactions(
 			goqu.I(schema.Institution.Logo()).As("institution_logo"),
 			goqu.I(schema.PaymentMethod.Name()).
 				As("payment_method_name"),
+			goqu.I(schema.Merchant.Name()).As("merchant_name"),
+			goqu.I(schema.Merchant.Logo()).As("merchant_logo"),
 		).
 		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull())
 
@@ -69,20 +96,13 @@ func (qb *QueryBuilder) ListFullTransactions(
 
 	whereExps, orderedExps := qb.buildTransactionExpressions(userID, options)
 
-	query = qb.buildTransactionsQuery(
+	return qb.buildTransactionsQuery(
 		query,
 		options,
 		whereExps,
 		joins,
 		orderedExps,
 	)
-
-	var transactions []entity.FullTransaction
-	if err := qb.Scan(ctx, query, &transactions); err != nil {
-		return nil, errs.New(err)
-	}
-
-	return transactions, nil
 }
 
 func (qb *QueryBuilder) CountTransactions(
@@ -93,7 +113,7 @@ func (qb *QueryBuilder) CountTransactions(
 	options := prepareOptions(opts...)
 
 	query := goqu.
-		From(schema.Transaction.String()).
+		From(qb.buildTransactionsTable(options)).
 		Select(goqu.COUNT(schema.Transaction.All())).
 		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull())
 
@@ -111,15 +131,17 @@ func (qb *QueryBuilder) CountTransactions(
 	return count, nil
 }
 
+// SumTransactions sums the transactions using the parts of the split ones.
 func (qb *QueryBuilder) SumTransactions(
 	ctx context.Context,
 	userID uuid.UUID,
 	opts ...repo.TransactionOptions,
 ) (int64, error) {
 	options := prepareOptions(opts...)
+	options.ShouldExpandSplits = true
 
 	query := goqu.
-		From(schema.Transaction.String()).
+		From(qb.buildTransactionsTable(options)).
 		Select(goqu.SUM(schema.Transaction.Amount())).
 		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull())
 
@@ -137,23 +159,32 @@ func (qb *QueryBuilder) SumTransactions(
 	return count, nil
 }
 
+// SumTransactionsByCategory sums the transactions by their top level
+// category, so the transactions of a sub-category are summed in its parent.
+// Split transactions are summed by the category of each part.
 func (qb *QueryBuilder) SumTransactionsByCategory(
 	ctx context.Context,
 	userID uuid.UUID,
 	opts ...repo.TransactionOptions,
 ) (map[uuid.UUID]int64, error) {
 	options := prepareOptions(opts...)
+	options.ShouldExpandSplits = true
+
+	categoryID := goqu.COALESCE(
+		goqu.I(schema.TransactionCategory.ParentID()),
+		goqu.I(schema.Transaction.CategoryID()),
+	)
 
 	query := goqu.
-		From(schema.Transaction.String()).
+		From(qb.buildTransactionsTable(options)).
 		Select(
-			goqu.I(schema.Transaction.CategoryID()),
+			categoryID.As("category_id"),
 			goqu.SUM(schema.Transaction.Amount()).As("sum"),
 		).
 		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull()).
-		GroupBy(goqu.I(schema.Transaction.CategoryID()))
+		GroupBy(categoryID)
 
-	joins := qb.buildTransactionJoins(options)
+	joins := qb.buildTransactionJoins(options, true)
 
 	whereExps, _ := qb.buildTransactionExpressions(userID, options)
 
@@ -175,6 +206,168 @@ func (qb *QueryBuilder) SumTransactionsByCategory(
 	return out, nil
 }
 
+// SumTransactionsByMerchant sums the transactions by merchant, leaving out
+// the ones without a merchant.
+func (qb *QueryBuilder) SumTransactionsByMerchant(
+	ctx context.Context,
+	userID uuid.UUID,
+	opts ...repo.TransactionOptions,
+) (map[uuid.UUID]int64, error) {
+	options := prepareOptions(opts...)
+	options.ShouldExpandSplits = true
+
+	merchantID := goqu.I(schema.Transaction.MerchantID())
+
+	query := goqu.
+		From(qb.buildTransactionsTable(options)).
+		Select(
+			merchantID.As("merchant_id"),
+			goqu.SUM(schema.Transaction.Amount()).As("sum"),
+		).
+		Where(
+			goqu.I(schema.Transaction.DeletedAt()).IsNull(),
+			merchantID.IsNotNull(),
+		).
+		GroupBy(merchantID)
+
+	joins := qb.buildTransactionJoins(options)
+
+	whereExps, _ := qb.buildTransactionExpressions(userID, options)
+
+	query = qb.buildTransactionsQuery(query, options, whereExps, joins, nil)
+
+	rows := []struct {
+		MerchantID uuid.UUID `db:"merchant_id"`
+		Sum        int64     `db:"sum"`
+	}{}
+	if err := qb.Scan(ctx, query, &rows); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := map[uuid.UUID]int64{}
+	for _, row := range rows {
+		out[row.MerchantID] = row.Sum
+	}
+
+	return out, nil
+}
+
+// SumTransactionsByTag sums the transactions by tag. A transaction with many
+// tags is summed in each of them.
+func (qb *QueryBuilder) SumTransactionsByTag(
+	ctx context.Context,
+	userID uuid.UUID,
+	opts ...repo.TransactionOptions,
+) (map[uuid.UUID]int64, error) {
+	options := prepareOptions(opts...)
+	options.ShouldExpandSplits = true
+
+	tagID := goqu.I(schema.TransactionTag.TagID())
+
+	query := goqu.
+		From(qb.buildTransactionsTable(options)).
+		Select(
+			tagID.As("tag_id"),
+			goqu.SUM(schema.Transaction.Amount()).As("sum"),
+		).
+		Join(
+			goqu.T(schema.TransactionTag.String()),
+			goqu.On(
+				goqu.I(schema.TransactionTag.TransactionID()).
+					Eq(goqu.I(schema.Transaction.ID())),
+			),
+		).
+		Join(
+			goqu.T(schema.Tag.String()),
+			goqu.On(
+				goqu.I(schema.Tag.ID()).Eq(tagID),
+				goqu.I(schema.Tag.DeletedAt()).IsNull(),
+			),
+		).
+		Where(goqu.I(schema.Transaction.DeletedAt()).IsNull()).
+		GroupBy(tagID)
+
+	joins := qb.buildTransactionJoins(options)
+
+	whereExps, _ := qb.buildTransactionExpressions(userID, options)
+
+	query = qb.buildTransactionsQuery(query, options, whereExps, joins, nil)
+
+	rows := []struct {
+		TagID uuid.UUID `db:"tag_id"`
+		Sum   int64     `db:"sum"`
+	}{}
+	if err := qb.Scan(ctx, query, &rows); err != nil {
+		return nil, errs.New(err)
+	}
+
+	out := map[uuid.UUID]int64{}
+	for _, row := range rows {
+		out[row.TagID] = row.Sum
+	}
+
+	return out, nil
+}
+
+// buildTransactionsTable returns the table transactions are selected from.
+// With ShouldExpandSplits, each split transaction is replaced by its parts,
+// which keep the transaction columns but have their own amount and category,
+// so the transaction expressions and joins work on them unchanged.
+func (qb *QueryBuilder) buildTransactionsTable(
+	options repo.TransactionOptions,
+) exp.Expression {
+	if !options.ShouldExpandSplits {
+		return goqu.T(schema.Transaction.String())
+	}
+
+	return goqu.
+		From(schema.Transaction.String()).
+		Select(
+			goqu.I(schema.Transaction.ID()),
+			goqu.I(schema.Transaction.ExternalID()),
+			goqu.I(schema.Transaction.Name()),
+			goqu.COALESCE(
+				goqu.I(schema.TransactionSplit.Amount()),
+				goqu.I(schema.Transaction.Amount()),
+			).As("amount"),
+			goqu.I(schema.Transaction.IsIgnored()),
+			goqu.I(schema.Transaction.Date()),
+			goqu.I(schema.Transaction.CreatedAt()),
+			goqu.I(schema.Transaction.UpdatedAt()),
+			goqu.I(schema.Transaction.DeletedAt()),
+			goqu.I(schema.Transaction.PaymentMethodID()),
+			goqu.I(schema.Transaction.UserID()),
+			goqu.COALESCE(
+				goqu.I(schema.TransactionSplit.CategoryID()),
+				goqu.I(schema.Transaction.CategoryID()),
+			).As("category_id"),
+			goqu.I(schema.Transaction.AccountID()),
+			goqu.I(schema.Transaction.InstitutionID()),
+			goqu.I(schema.Transaction.PurchaseDate()),
+			goqu.I(schema.Transaction.InstallmentNumber()),
+			goqu.I(schema.Transaction.TotalInstallments()),
+			goqu.I(schema.Transaction.BillID()),
+			goqu.I(schema.Transaction.Status()),
+			goqu.I(schema.Transaction.IsNameOverridden()),
+			goqu.I(schema.Transaction.IsAmountOverridden()),
+			goqu.I(schema.Transaction.IsDateOverridden()),
+			goqu.I(schema.Transaction.IsCategoryOverridden()),
+			goqu.I(schema.Transaction.IsPaymentMethodOverridden()),
+			goqu.I(schema.Transaction.Notes()),
+			goqu.I(schema.Transaction.MerchantID()),
+			goqu.I(schema.Transaction.IsHidden()),
+		).
+		LeftJoin(
+			goqu.T(schema.TransactionSplit.String()),
+			goqu.On(
+				goqu.I(schema.TransactionSplit.TransactionID()).
+					Eq(goqu.I(schema.Transaction.ID())),
+				goqu.I(schema.TransactionSplit.DeletedAt()).IsNull(),
+			),
+		).
+		As(schema.Transaction.String())
+}
+
 func (qb *QueryBuilder) buildTransactionExpressions(
 	userID uuid.UUID,
 	options repo.TransactionOptions,
@@ -182,6 +375,7 @@ func (qb *QueryBuilder) buildTransactionExpressions(
 	whereExps = append(
 		whereExps,
 		goqu.I(schema.Transaction.UserID()).Eq(userID),
+		goqu.I(schema.Transaction.IsHidden()).IsFalse(),
 	)
 
 	options.Search = strings.TrimSpace(options.Search)
@@ -189,9 +383,11 @@ func (qb *QueryBuilder) buildTransactionExpressions(
 		searchExp, orderExp := qb.buildSearch(
 			options.Search,
 			schema.Transaction.Name(),
+			schema.Transaction.Notes(),
 			schema.TransactionCategory.Name(),
 			schema.Institution.Name(),
 			schema.PaymentMethod.Name(),
+			schema.Merchant.Name(),
 		)
 		whereExps = append(whereExps, searchExp)
 		orderedExps = append(orderedExps, orderExp.Desc())
@@ -200,27 +396,100 @@ func (qb *QueryBuilder) buildTransactionExpressions(
 	if len(options.CategoryIDs) > 0 {
 		whereExps = append(
 			whereExps,
-			goqu.I(schema.Transaction.CategoryID()).
-				In(options.CategoryIDs),
+			goqu.Or(
+				goqu.I(schema.Transaction.CategoryID()).
+					In(options.CategoryIDs),
+				goqu.I(schema.TransactionCategory.ParentID()).
+					In(options.CategoryIDs),
+			),
+		)
+	}
+
+	if len(options.InstitutionIDs) > 0 || len(options.AccountIDs) > 0 {
+		var exps []goqu.Expression
+		if len(options.InstitutionIDs) > 0 {
+			exps = append(
+				exps,
+				goqu.I(schema.Transaction.InstitutionID()).
+					In(options.InstitutionIDs),
+			)
+		}
+		if len(options.AccountIDs) > 0 {
+			exps = append(
+				exps,
+				goqu.I(schema.Transaction.AccountID()).
+					In(options.AccountIDs),
+			)
+		}
+		whereExps = append(whereExps, goqu.Or(exps...))
+	}
+
+	if len(options.PaymentMethodIDs) > 0 {
+		whereExps = append(
+			whereExps,
+			goqu.I(schema.Transaction.PaymentMethodID()).
+				In(options.PaymentMethodIDs),
 		)
 	}
 
-	if len(options.InstitutionIDs) > 0 {
+	if len(options.MerchantIDs) > 0 {
 		whereExps = append(
 			whereExps,
-			goqu.I(schema.Transaction.InstitutionID()).
-				In(options.InstitutionIDs),
+			goqu.I(schema.Transaction.MerchantID()).
+				In(options.MerchantIDs),
 		)
 	}
 
-	if len(options.PaymentMethodIDs) > 0 {
+	if len(options.TagIDs) > 0 {
+		taggedTransactionIDs := goqu.
+			From(schema.TransactionTag.String()).
+			Select(schema.TransactionTag.TransactionID()).
+			Where(goqu.I(schema.TransactionTag.TagID()).In(options.TagIDs))
+
 		whereExps = append(
 			whereExps,
-			goqu.I(schema.Transaction.PaymentMethodID()).
-				In(options.PaymentMethodIDs),
+			goqu.I(schema.Transaction.ID()).In(taggedTransactionIDs),
 		)
 	}
 
+	if len(options.IDs) > 0 {
+		whereExps = append(
+			whereExps,
+			goqu.I(schema.Transaction.ID()).In(options.IDs),
+		)
+	}
+
+	if options.IsTransfer != nil {
+		isLinked := goqu.I(schema.Transfer.Status()).
+			Neq(entity.TransferStatusUnlinked)
+
+		outgoingTransactionIDs := goqu.
+			From(schema.Transfer.String()).
+			Select(schema.Transfer.OutgoingTransactionID()).
+			Where(isLinked)
+
+		incomingTransactionIDs := goqu.
+			From(schema.Transfer.String()).
+			Select(schema.Transfer.IncomingTransactionID()).
+			Where(isLinked)
+
+		if *options.IsTransfer {
+			whereExps = append(
+				whereExps,
+				goqu.Or(
+					goqu.I(schema.Transaction.ID()).In(outgoingTransactionIDs),
+					goqu.I(schema.Transaction.ID()).In(incomingTransactionIDs),
+				),
+			)
+		} else {
+			whereExps = append(
+				whereExps,
+				goqu.I(schema.Transaction.ID()).NotIn(outgoingTransactionIDs),
+				goqu.I(schema.Transaction.ID()).NotIn(incomingTransactionIDs),
+			)
+		}
+	}
+
 	if !options.StartDate.IsZero() {
 		whereExps = append(
 			whereExps,
@@ -257,11 +526,29 @@ func (qb *QueryBuilder) buildTransactionExpressions(
 		)
 	}
 
+	if options.ShouldOrderByDate {
+		orderedExps = append(
+			orderedExps,
+			goqu.I(schema.Transaction.Date()).Asc(),
+		)
+	}
+
 	orderedExps = append(
 		orderedExps,
 		goqu.I(schema.Transaction.Name()).Asc(),
 	)
 
+	// The parts of a split transaction share its columns, so they are
+	// ordered by their own ones to always be listed in the same order.
+	if options.ShouldExpandSplits {
+		orderedExps = append(
+			orderedExps,
+			goqu.I(schema.Transaction.ID()).Asc(),
+			goqu.I(schema.Transaction.Amount()).Asc(),
+			goqu.I(schema.Transaction.CategoryID()).Asc(),
+		)
+	}
+
 	return whereExps, orderedExps
 }
 
@@ -296,11 +583,21 @@ func (qb *QueryBuilder) buildTransactionJoins(
 			),
 	}
 
+	merchantJoin := Join{
+		Table: goqu.I(schema.Merchant.String()),
+		Condition: goqu.
+			On(
+				goqu.I(schema.Transaction.MerchantID()).
+					Eq(goqu.I(schema.Merchant.ID())),
+			),
+	}
+
 	if (len(shouldJoinAll) > 0 && shouldJoinAll[0]) || options.Search != "" {
 		return []Join{
 			institutionJoin,
 			transactionCategoryJoin,
 			paymentMethodJoin,
+			merchantJoin,
 		}
 	}
 
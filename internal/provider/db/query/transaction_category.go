@@ -10,6 +10,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
 )
 
 func (qb *QueryBuilder) ListTransactionCategories(
@@ -82,6 +83,31 @@ func (qb *QueryBuilder) buildCategoryExpressions(
 		whereExps = append(whereExps, exp)
 	}
 
+	var userExp goqu.Expression = goqu.I(schema.TransactionCategory.UserID()).
+		IsNull()
+	if options.UserID != uuid.Nil {
+		userExp = goqu.Or(
+			userExp,
+			goqu.I(schema.TransactionCategory.UserID()).Eq(options.UserID),
+		)
+	}
+	whereExps = append(whereExps, userExp)
+
+	if options.IsHidden != nil {
+		hiddenCategoryIDs := goqu.
+			From(schema.HiddenCategory.String()).
+			Select(schema.HiddenCategory.CategoryID()).
+			Where(goqu.I(schema.HiddenCategory.UserID()).Eq(options.UserID))
+
+		exp := goqu.I(schema.TransactionCategory.ID()).
+			NotIn(hiddenCategoryIDs)
+		if *options.IsHidden {
+			exp = goqu.I(schema.TransactionCategory.ID()).
+				In(hiddenCategoryIDs)
+		}
+		whereExps = append(whereExps, exp)
+	}
+
 	orderedExps = append(
 		orderedExps,
 		goqu.I(schema.TransactionCategory.Name()).Asc(),
@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/doug-martin/goqu/v9"
+)
+
+func (qb *QueryBuilder) ListUserInstitutions(
+	ctx context.Context,
+	opts ...repo.UserInstitutionOptions,
+) ([]entity.UserInstitution, error) {
+	options := prepareOptions(opts...)
+
+	query := goqu.
+		From(schema.UserInstitution.String()).
+		Select(schema.UserInstitution.All()).
+		Where(goqu.I(schema.UserInstitution.DeletedAt()).IsNull()).
+		Order(goqu.I(schema.UserInstitution.CreatedAt()).Asc())
+
+	if options.IsSubscriptionActive != nil {
+		query = query.Join(
+			goqu.I(schema.User.String()),
+			goqu.On(
+				goqu.I(schema.UserInstitution.UserID()).
+					Eq(goqu.I(schema.User.ID())),
+			),
+		)
+
+		ident := goqu.I(schema.User.SubscriptionExpiresAt())
+		if *options.IsSubscriptionActive {
+			query = query.Where(ident.Gte(time.Now()))
+		} else {
+			query = query.Where(ident.Lt(time.Now()))
+		}
+	}
+
+	if len(options.IDs) > 0 {
+		query = query.Where(
+			goqu.I(schema.UserInstitution.ID()).In(options.IDs),
+		)
+	}
+
+	if options.Limit > 0 {
+		query = query.Limit(options.Limit)
+	}
+
+	if options.Offset > 0 {
+		query = query.Offset(options.Offset)
+	}
+
+	var userInstitutions []entity.UserInstitution
+	if err := qb.Scan(ctx, query, &userInstitutions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return userInstitutions, nil
+}
@@ -142,227 +142,891 @@ func (t tableAccount) Name() string {
 	return fmt.Sprintf("%s.name", t)
 }
 
+func (t tableAccount) OpeningBalance() string {
+	return fmt.Sprintf("%s.opening_balance", t)
+}
+
 func (t tableAccount) Type() string {
 	return fmt.Sprintf("%s.type", t)
 }
 
+func (t tableAccount) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
 func (t tableAccount) UserInstitutionID() string {
 	return fmt.Sprintf("%s.user_institution_id", t)
 }
 
-const Account = tableAccount("accounts")
+const Account = tableAccount("accounts")
+
+type tableAccountBalance string
+
+func (t tableAccountBalance) String() string {
+	return string(t)
+}
+
+func (t tableAccountBalance) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableAccountBalance) AccountID() string {
+	return fmt.Sprintf("%s.account_id", t)
+}
+
+func (t tableAccountBalance) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableAccountBalance) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableAccountBalance) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableAccountBalance) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+const AccountBalance = tableAccountBalance("account_balances")
+
+type tableAttachment string
+
+func (t tableAttachment) String() string {
+	return string(t)
+}
+
+func (t tableAttachment) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableAttachment) ContentType() string {
+	return fmt.Sprintf("%s.content_type", t)
+}
+
+func (t tableAttachment) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableAttachment) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableAttachment) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+func (t tableAttachment) Size() string {
+	return fmt.Sprintf("%s.size", t)
+}
+
+func (t tableAttachment) StorageKey() string {
+	return fmt.Sprintf("%s.storage_key", t)
+}
+
+func (t tableAttachment) TransactionID() string {
+	return fmt.Sprintf("%s.transaction_id", t)
+}
+
+func (t tableAttachment) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Attachment = tableAttachment("attachments")
+
+type tableBudget string
+
+func (t tableBudget) String() string {
+	return string(t)
+}
+
+func (t tableBudget) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableBudget) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableBudget) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableBudget) Date() string {
+	return fmt.Sprintf("%s.date", t)
+}
+
+func (t tableBudget) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableBudget) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableBudget) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+func (t tableBudget) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Budget = tableBudget("budgets")
+
+type tableBudgetCategory string
+
+func (t tableBudgetCategory) String() string {
+	return string(t)
+}
+
+func (t tableBudgetCategory) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableBudgetCategory) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableBudgetCategory) BudgetID() string {
+	return fmt.Sprintf("%s.budget_id", t)
+}
+
+func (t tableBudgetCategory) CategoryID() string {
+	return fmt.Sprintf("%s.category_id", t)
+}
+
+func (t tableBudgetCategory) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableBudgetCategory) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableBudgetCategory) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableBudgetCategory) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+const BudgetCategory = tableBudgetCategory("budget_categories")
+
+type tableCreditCardBill string
+
+func (t tableCreditCardBill) String() string {
+	return string(t)
+}
+
+func (t tableCreditCardBill) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableCreditCardBill) AccountID() string {
+	return fmt.Sprintf("%s.account_id", t)
+}
+
+func (t tableCreditCardBill) ClosingDate() string {
+	return fmt.Sprintf("%s.closing_date", t)
+}
+
+func (t tableCreditCardBill) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableCreditCardBill) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableCreditCardBill) DueDate() string {
+	return fmt.Sprintf("%s.due_date", t)
+}
+
+func (t tableCreditCardBill) ExternalID() string {
+	return fmt.Sprintf("%s.external_id", t)
+}
+
+func (t tableCreditCardBill) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableCreditCardBill) TotalAmount() string {
+	return fmt.Sprintf("%s.total_amount", t)
+}
+
+func (t tableCreditCardBill) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+const CreditCardBill = tableCreditCardBill("credit_card_bills")
+
+type tableFeedback string
+
+func (t tableFeedback) String() string {
+	return string(t)
+}
+
+func (t tableFeedback) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableFeedback) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableFeedback) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableFeedback) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableFeedback) Message() string {
+	return fmt.Sprintf("%s.message", t)
+}
+
+func (t tableFeedback) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Feedback = tableFeedback("feedbacks")
+
+type tableGoal string
+
+func (t tableGoal) String() string {
+	return string(t)
+}
+
+func (t tableGoal) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableGoal) AccountID() string {
+	return fmt.Sprintf("%s.account_id", t)
+}
+
+func (t tableGoal) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableGoal) Deadline() string {
+	return fmt.Sprintf("%s.deadline", t)
+}
+
+func (t tableGoal) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableGoal) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableGoal) Interest() string {
+	return fmt.Sprintf("%s.interest", t)
+}
+
+func (t tableGoal) MonthlyContribution() string {
+	return fmt.Sprintf("%s.monthly_contribution", t)
+}
+
+func (t tableGoal) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+func (t tableGoal) TargetAmount() string {
+	return fmt.Sprintf("%s.target_amount", t)
+}
+
+func (t tableGoal) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+func (t tableGoal) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Goal = tableGoal("goals")
+
+type tableGoalContribution string
+
+func (t tableGoalContribution) String() string {
+	return string(t)
+}
+
+func (t tableGoalContribution) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableGoalContribution) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableGoalContribution) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableGoalContribution) Date() string {
+	return fmt.Sprintf("%s.date", t)
+}
+
+func (t tableGoalContribution) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableGoalContribution) GoalID() string {
+	return fmt.Sprintf("%s.goal_id", t)
+}
+
+func (t tableGoalContribution) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+const GoalContribution = tableGoalContribution("goal_contributions")
+
+type tableHiddenCategory string
+
+func (t tableHiddenCategory) String() string {
+	return string(t)
+}
+
+func (t tableHiddenCategory) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableHiddenCategory) CategoryID() string {
+	return fmt.Sprintf("%s.category_id", t)
+}
+
+func (t tableHiddenCategory) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableHiddenCategory) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableHiddenCategory) MergedIntoID() string {
+	return fmt.Sprintf("%s.merged_into_id", t)
+}
+
+func (t tableHiddenCategory) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const HiddenCategory = tableHiddenCategory("hidden_categories")
+
+type tableInstitution string
+
+func (t tableInstitution) String() string {
+	return string(t)
+}
+
+func (t tableInstitution) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableInstitution) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableInstitution) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableInstitution) ExternalID() string {
+	return fmt.Sprintf("%s.external_id", t)
+}
+
+func (t tableInstitution) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableInstitution) Logo() string {
+	return fmt.Sprintf("%s.logo", t)
+}
+
+func (t tableInstitution) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+const Institution = tableInstitution("institutions")
+
+type tableInvestment string
+
+func (t tableInvestment) String() string {
+	return string(t)
+}
+
+func (t tableInvestment) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableInvestment) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableInvestment) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableInvestment) ExternalID() string {
+	return fmt.Sprintf("%s.external_id", t)
+}
+
+func (t tableInvestment) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableInvestment) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+func (t tableInvestment) Subtype() string {
+	return fmt.Sprintf("%s.subtype", t)
+}
+
+func (t tableInvestment) Type() string {
+	return fmt.Sprintf("%s.type", t)
+}
+
+func (t tableInvestment) UserInstitutionID() string {
+	return fmt.Sprintf("%s.user_institution_id", t)
+}
+
+const Investment = tableInvestment("investments")
+
+type tableInvestmentPosition string
+
+func (t tableInvestmentPosition) String() string {
+	return string(t)
+}
+
+func (t tableInvestmentPosition) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableInvestmentPosition) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableInvestmentPosition) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableInvestmentPosition) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableInvestmentPosition) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableInvestmentPosition) InvestmentID() string {
+	return fmt.Sprintf("%s.investment_id", t)
+}
+
+const InvestmentPosition = tableInvestmentPosition("investment_positions")
+
+type tableJobRun string
+
+func (t tableJobRun) String() string {
+	return string(t)
+}
+
+func (t tableJobRun) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableJobRun) AccountsProcessed() string {
+	return fmt.Sprintf("%s.accounts_processed", t)
+}
+
+func (t tableJobRun) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableJobRun) ErrorMessage() string {
+	return fmt.Sprintf("%s.error_message", t)
+}
+
+func (t tableJobRun) FinishedAt() string {
+	return fmt.Sprintf("%s.finished_at", t)
+}
+
+func (t tableJobRun) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableJobRun) Job() string {
+	return fmt.Sprintf("%s.job", t)
+}
+
+func (t tableJobRun) StartedAt() string {
+	return fmt.Sprintf("%s.started_at", t)
+}
+
+const JobRun = tableJobRun("job_runs")
+
+type tableMerchant string
+
+func (t tableMerchant) String() string {
+	return string(t)
+}
+
+func (t tableMerchant) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableMerchant) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableMerchant) Document() string {
+	return fmt.Sprintf("%s.document", t)
+}
+
+func (t tableMerchant) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableMerchant) Key() string {
+	return fmt.Sprintf("%s.key", t)
+}
+
+func (t tableMerchant) Logo() string {
+	return fmt.Sprintf("%s.logo", t)
+}
+
+func (t tableMerchant) Mcc() string {
+	return fmt.Sprintf("%s.mcc", t)
+}
+
+func (t tableMerchant) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+func (t tableMerchant) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+const Merchant = tableMerchant("merchants")
+
+type tablePaymentMethod string
+
+func (t tablePaymentMethod) String() string {
+	return string(t)
+}
+
+func (t tablePaymentMethod) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tablePaymentMethod) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tablePaymentMethod) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tablePaymentMethod) ExternalID() string {
+	return fmt.Sprintf("%s.external_id", t)
+}
+
+func (t tablePaymentMethod) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tablePaymentMethod) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
+
+const PaymentMethod = tablePaymentMethod("payment_methods")
 
-type tableAccountBalance string
+type tableRecurringSeries string
 
-func (t tableAccountBalance) String() string {
+func (t tableRecurringSeries) String() string {
 	return string(t)
 }
 
-func (t tableAccountBalance) All() string {
+func (t tableRecurringSeries) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
-func (t tableAccountBalance) AccountID() string {
-	return fmt.Sprintf("%s.account_id", t)
+func (t tableRecurringSeries) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
 }
 
-func (t tableAccountBalance) Amount() string {
-	return fmt.Sprintf("%s.amount", t)
+func (t tableRecurringSeries) AverageAmount() string {
+	return fmt.Sprintf("%s.average_amount", t)
 }
 
-func (t tableAccountBalance) CreatedAt() string {
+func (t tableRecurringSeries) CategoryID() string {
+	return fmt.Sprintf("%s.category_id", t)
+}
+
+func (t tableRecurringSeries) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
 
-func (t tableAccountBalance) DeletedAt() string {
-	return fmt.Sprintf("%s.deleted_at", t)
+func (t tableRecurringSeries) FirstDate() string {
+	return fmt.Sprintf("%s.first_date", t)
 }
 
-func (t tableAccountBalance) ID() string {
+func (t tableRecurringSeries) Frequency() string {
+	return fmt.Sprintf("%s.frequency", t)
+}
+
+func (t tableRecurringSeries) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
-const AccountBalance = tableAccountBalance("account_balances")
+func (t tableRecurringSeries) LastDate() string {
+	return fmt.Sprintf("%s.last_date", t)
+}
 
-type tableBudget string
+func (t tableRecurringSeries) Name() string {
+	return fmt.Sprintf("%s.name", t)
+}
 
-func (t tableBudget) String() string {
+func (t tableRecurringSeries) NextDate() string {
+	return fmt.Sprintf("%s.next_date", t)
+}
+
+func (t tableRecurringSeries) NormalizedName() string {
+	return fmt.Sprintf("%s.normalized_name", t)
+}
+
+func (t tableRecurringSeries) Occurrences() string {
+	return fmt.Sprintf("%s.occurrences", t)
+}
+
+func (t tableRecurringSeries) PaymentMethodID() string {
+	return fmt.Sprintf("%s.payment_method_id", t)
+}
+
+func (t tableRecurringSeries) PreviousAmount() string {
+	return fmt.Sprintf("%s.previous_amount", t)
+}
+
+func (t tableRecurringSeries) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+func (t tableRecurringSeries) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const RecurringSeries = tableRecurringSeries("recurring_series")
+
+type tableRule string
+
+func (t tableRule) String() string {
 	return string(t)
 }
 
-func (t tableBudget) All() string {
+func (t tableRule) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
-func (t tableBudget) Amount() string {
-	return fmt.Sprintf("%s.amount", t)
+func (t tableRule) CategoryID() string {
+	return fmt.Sprintf("%s.category_id", t)
 }
 
-func (t tableBudget) CreatedAt() string {
+func (t tableRule) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
 
-func (t tableBudget) Date() string {
-	return fmt.Sprintf("%s.date", t)
+func (t tableRule) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
 }
 
-func (t tableBudget) DeletedAt() string {
-	return fmt.Sprintf("%s.deleted_at", t)
+func (t tableRule) Direction() string {
+	return fmt.Sprintf("%s.direction", t)
 }
 
-func (t tableBudget) ID() string {
+func (t tableRule) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
-func (t tableBudget) UpdatedAt() string {
-	return fmt.Sprintf("%s.updated_at", t)
+func (t tableRule) InstitutionID() string {
+	return fmt.Sprintf("%s.institution_id", t)
 }
 
-func (t tableBudget) UserID() string {
-	return fmt.Sprintf("%s.user_id", t)
+func (t tableRule) IsIgnored() string {
+	return fmt.Sprintf("%s.is_ignored", t)
 }
 
-const Budget = tableBudget("budgets")
-
-type tableBudgetCategory string
+func (t tableRule) MaxAmount() string {
+	return fmt.Sprintf("%s.max_amount", t)
+}
 
-func (t tableBudgetCategory) String() string {
-	return string(t)
+func (t tableRule) MinAmount() string {
+	return fmt.Sprintf("%s.min_amount", t)
 }
 
-func (t tableBudgetCategory) All() string {
-	return fmt.Sprintf("%s.*", t)
+func (t tableRule) Name() string {
+	return fmt.Sprintf("%s.name", t)
 }
 
-func (t tableBudgetCategory) Amount() string {
-	return fmt.Sprintf("%s.amount", t)
+func (t tableRule) NameMatchType() string {
+	return fmt.Sprintf("%s.name_match_type", t)
 }
 
-func (t tableBudgetCategory) BudgetID() string {
-	return fmt.Sprintf("%s.budget_id", t)
+func (t tableRule) NamePattern() string {
+	return fmt.Sprintf("%s.name_pattern", t)
 }
 
-func (t tableBudgetCategory) CategoryID() string {
-	return fmt.Sprintf("%s.category_id", t)
+func (t tableRule) PaymentMethodID() string {
+	return fmt.Sprintf("%s.payment_method_id", t)
 }
 
-func (t tableBudgetCategory) CreatedAt() string {
-	return fmt.Sprintf("%s.created_at", t)
+func (t tableRule) Priority() string {
+	return fmt.Sprintf("%s.priority", t)
 }
 
-func (t tableBudgetCategory) DeletedAt() string {
-	return fmt.Sprintf("%s.deleted_at", t)
+func (t tableRule) RenameTo() string {
+	return fmt.Sprintf("%s.rename_to", t)
 }
 
-func (t tableBudgetCategory) ID() string {
-	return fmt.Sprintf("%s.id", t)
+func (t tableRule) TagID() string {
+	return fmt.Sprintf("%s.tag_id", t)
 }
 
-func (t tableBudgetCategory) UpdatedAt() string {
+func (t tableRule) UpdatedAt() string {
 	return fmt.Sprintf("%s.updated_at", t)
 }
 
-const BudgetCategory = tableBudgetCategory("budget_categories")
+func (t tableRule) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
 
-type tableFeedback string
+const Rule = tableRule("rules")
 
-func (t tableFeedback) String() string {
+type tableSyncRun string
+
+func (t tableSyncRun) String() string {
 	return string(t)
 }
 
-func (t tableFeedback) All() string {
+func (t tableSyncRun) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
-func (t tableFeedback) CreatedAt() string {
+func (t tableSyncRun) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
 
-func (t tableFeedback) DeletedAt() string {
-	return fmt.Sprintf("%s.deleted_at", t)
+func (t tableSyncRun) FinishedAt() string {
+	return fmt.Sprintf("%s.finished_at", t)
 }
 
-func (t tableFeedback) ID() string {
+func (t tableSyncRun) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
-func (t tableFeedback) Message() string {
-	return fmt.Sprintf("%s.message", t)
-}
-
-func (t tableFeedback) UserID() string {
-	return fmt.Sprintf("%s.user_id", t)
+func (t tableSyncRun) StartedAt() string {
+	return fmt.Sprintf("%s.started_at", t)
 }
 
-const Feedback = tableFeedback("feedbacks")
+const SyncRun = tableSyncRun("sync_runs")
 
-type tableInstitution string
+type tableSyncRunItem string
 
-func (t tableInstitution) String() string {
+func (t tableSyncRunItem) String() string {
 	return string(t)
 }
 
-func (t tableInstitution) All() string {
+func (t tableSyncRunItem) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
-func (t tableInstitution) CreatedAt() string {
+func (t tableSyncRunItem) AccountID() string {
+	return fmt.Sprintf("%s.account_id", t)
+}
+
+func (t tableSyncRunItem) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
 
-func (t tableInstitution) DeletedAt() string {
-	return fmt.Sprintf("%s.deleted_at", t)
+func (t tableSyncRunItem) Deleted() string {
+	return fmt.Sprintf("%s.deleted", t)
 }
 
-func (t tableInstitution) ExternalID() string {
-	return fmt.Sprintf("%s.external_id", t)
+func (t tableSyncRunItem) FailureReason() string {
+	return fmt.Sprintf("%s.failure_reason", t)
 }
 
-func (t tableInstitution) ID() string {
+func (t tableSyncRunItem) Fetched() string {
+	return fmt.Sprintf("%s.fetched", t)
+}
+
+func (t tableSyncRunItem) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
-func (t tableInstitution) Logo() string {
-	return fmt.Sprintf("%s.logo", t)
+func (t tableSyncRunItem) Inserted() string {
+	return fmt.Sprintf("%s.inserted", t)
 }
 
-func (t tableInstitution) Name() string {
-	return fmt.Sprintf("%s.name", t)
+func (t tableSyncRunItem) Skipped() string {
+	return fmt.Sprintf("%s.skipped", t)
 }
 
-const Institution = tableInstitution("institutions")
+func (t tableSyncRunItem) Status() string {
+	return fmt.Sprintf("%s.status", t)
+}
 
-type tablePaymentMethod string
+func (t tableSyncRunItem) SyncRunID() string {
+	return fmt.Sprintf("%s.sync_run_id", t)
+}
 
-func (t tablePaymentMethod) String() string {
+func (t tableSyncRunItem) Updated() string {
+	return fmt.Sprintf("%s.updated", t)
+}
+
+const SyncRunItem = tableSyncRunItem("sync_run_items")
+
+type tableTag string
+
+func (t tableTag) String() string {
 	return string(t)
 }
 
-func (t tablePaymentMethod) All() string {
+func (t tableTag) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
-func (t tablePaymentMethod) CreatedAt() string {
+func (t tableTag) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
 
-func (t tablePaymentMethod) DeletedAt() string {
+func (t tableTag) DeletedAt() string {
 	return fmt.Sprintf("%s.deleted_at", t)
 }
 
-func (t tablePaymentMethod) ExternalID() string {
-	return fmt.Sprintf("%s.external_id", t)
-}
-
-func (t tablePaymentMethod) ID() string {
+func (t tableTag) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
-func (t tablePaymentMethod) Name() string {
+func (t tableTag) Name() string {
 	return fmt.Sprintf("%s.name", t)
 }
 
-const PaymentMethod = tablePaymentMethod("payment_methods")
+func (t tableTag) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+func (t tableTag) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Tag = tableTag("tags")
 
 type tableTransaction string
 
@@ -382,6 +1046,10 @@ func (t tableTransaction) Amount() string {
 	return fmt.Sprintf("%s.amount", t)
 }
 
+func (t tableTransaction) BillID() string {
+	return fmt.Sprintf("%s.bill_id", t)
+}
+
 func (t tableTransaction) CategoryID() string {
 	return fmt.Sprintf("%s.category_id", t)
 }
@@ -406,22 +1074,70 @@ func (t tableTransaction) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
+func (t tableTransaction) InstallmentNumber() string {
+	return fmt.Sprintf("%s.installment_number", t)
+}
+
 func (t tableTransaction) InstitutionID() string {
 	return fmt.Sprintf("%s.institution_id", t)
 }
 
+func (t tableTransaction) IsAmountOverridden() string {
+	return fmt.Sprintf("%s.is_amount_overridden", t)
+}
+
+func (t tableTransaction) IsCategoryOverridden() string {
+	return fmt.Sprintf("%s.is_category_overridden", t)
+}
+
+func (t tableTransaction) IsDateOverridden() string {
+	return fmt.Sprintf("%s.is_date_overridden", t)
+}
+
+func (t tableTransaction) IsHidden() string {
+	return fmt.Sprintf("%s.is_hidden", t)
+}
+
 func (t tableTransaction) IsIgnored() string {
 	return fmt.Sprintf("%s.is_ignored", t)
 }
 
+func (t tableTransaction) IsNameOverridden() string {
+	return fmt.Sprintf("%s.is_name_overridden", t)
+}
+
+func (t tableTransaction) IsPaymentMethodOverridden() string {
+	return fmt.Sprintf("%s.is_payment_method_overridden", t)
+}
+
+func (t tableTransaction) MerchantID() string {
+	return fmt.Sprintf("%s.merchant_id", t)
+}
+
 func (t tableTransaction) Name() string {
 	return fmt.Sprintf("%s.name", t)
 }
 
+func (t tableTransaction) Notes() string {
+	return fmt.Sprintf("%s.notes", t)
+}
+
 func (t tableTransaction) PaymentMethodID() string {
 	return fmt.Sprintf("%s.payment_method_id", t)
 }
 
+func (t tableTransaction) PurchaseDate() string {
+	return fmt.Sprintf("%s.purchase_date", t)
+}
+
+func (t tableTransaction) Status() string {
+	return fmt.Sprintf("%s.status", t)
+}
+
+func (t tableTransaction) TotalInstallments() string {
+	return fmt.Sprintf("%s.total_installments", t)
+}
+
 func (t tableTransaction) UpdatedAt() string {
 	return fmt.Sprintf("%s.updated_at", t)
 }
@@ -442,6 +1158,10 @@ func (t tableTransactionCategory) All() string {
 	return fmt.Sprintf("%s.*", t)
 }
 
+func (t tableTransactionCategory) Color() string {
+	return fmt.Sprintf("%s.color", t)
+}
+
 func (t tableTransactionCategory) CreatedAt() string {
 	return fmt.Sprintf("%s.created_at", t)
 }
@@ -458,16 +1178,136 @@ func (t tableTransactionCategory) ID() string {
 	return fmt.Sprintf("%s.id", t)
 }
 
+func (t tableTransactionCategory) Icon() string {
+	return fmt.Sprintf("%s.icon", t)
+}
+
 func (t tableTransactionCategory) Name() string {
 	return fmt.Sprintf("%s.name", t)
 }
 
+func (t tableTransactionCategory) ParentID() string {
+	return fmt.Sprintf("%s.parent_id", t)
+}
+
 func (t tableTransactionCategory) UpdatedAt() string {
 	return fmt.Sprintf("%s.updated_at", t)
 }
 
+func (t tableTransactionCategory) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
 const TransactionCategory = tableTransactionCategory("transaction_categories")
 
+type tableTransactionSplit string
+
+func (t tableTransactionSplit) String() string {
+	return string(t)
+}
+
+func (t tableTransactionSplit) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableTransactionSplit) Amount() string {
+	return fmt.Sprintf("%s.amount", t)
+}
+
+func (t tableTransactionSplit) CategoryID() string {
+	return fmt.Sprintf("%s.category_id", t)
+}
+
+func (t tableTransactionSplit) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableTransactionSplit) DeletedAt() string {
+	return fmt.Sprintf("%s.deleted_at", t)
+}
+
+func (t tableTransactionSplit) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableTransactionSplit) Note() string {
+	return fmt.Sprintf("%s.note", t)
+}
+
+func (t tableTransactionSplit) TransactionID() string {
+	return fmt.Sprintf("%s.transaction_id", t)
+}
+
+func (t tableTransactionSplit) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+const TransactionSplit = tableTransactionSplit("transaction_splits")
+
+type tableTransactionTag string
+
+func (t tableTransactionTag) String() string {
+	return string(t)
+}
+
+func (t tableTransactionTag) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableTransactionTag) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableTransactionTag) TagID() string {
+	return fmt.Sprintf("%s.tag_id", t)
+}
+
+func (t tableTransactionTag) TransactionID() string {
+	return fmt.Sprintf("%s.transaction_id", t)
+}
+
+const TransactionTag = tableTransactionTag("transaction_tags")
+
+type tableTransfer string
+
+func (t tableTransfer) String() string {
+	return string(t)
+}
+
+func (t tableTransfer) All() string {
+	return fmt.Sprintf("%s.*", t)
+}
+
+func (t tableTransfer) CreatedAt() string {
+	return fmt.Sprintf("%s.created_at", t)
+}
+
+func (t tableTransfer) ID() string {
+	return fmt.Sprintf("%s.id", t)
+}
+
+func (t tableTransfer) IncomingTransactionID() string {
+	return fmt.Sprintf("%s.incoming_transaction_id", t)
+}
+
+func (t tableTransfer) OutgoingTransactionID() string {
+	return fmt.Sprintf("%s.outgoing_transaction_id", t)
+}
+
+func (t tableTransfer) Status() string {
+	return fmt.Sprintf("%s.status", t)
+}
+
+func (t tableTransfer) UpdatedAt() string {
+	return fmt.Sprintf("%s.updated_at", t)
+}
+
+func (t tableTransfer) UserID() string {
+	return fmt.Sprintf("%s.user_id", t)
+}
+
+const Transfer = tableTransfer("transfers")
+
 type tableUser string
 
 func (t tableUser) String() string {
@@ -598,6 +1438,10 @@ func (t tableUserInstitution) InstitutionID() string {
 	return fmt.Sprintf("%s.institution_id", t)
 }
 
+func (t tableUserInstitution) Status() string {
+	return fmt.Sprintf("%s.status", t)
+}
+
 func (t tableUserInstitution) UserID() string {
 	return fmt.Sprintf("%s.user_id", t)
 }
@@ -6,13 +6,105 @@
 package sqlc
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 )
 
+const createAccount = `-- name: CreateAccount :one
+INSERT INTO accounts (
+    id,
+    external_id,
+    name,
+    type,
+    user_id,
+    opening_balance
+  )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, external_id, name, type, created_at, deleted_at, user_institution_id, user_id, opening_balance
+`
+
+type CreateAccountParams struct {
+	ID             uuid.UUID `json:"id"`
+	ExternalID     string    `json:"external_id"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	UserID         uuid.UUID `json:"user_id"`
+	OpeningBalance int64     `json:"opening_balance"`
+}
+
+func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.ID,
+		arg.ExternalID,
+		arg.Name,
+		arg.Type,
+		arg.UserID,
+		arg.OpeningBalance,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Name,
+		&i.Type,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UserInstitutionID,
+		&i.UserID,
+		&i.OpeningBalance,
+	)
+	return i, err
+}
+
 type CreateAccountsParams struct {
-	ID                uuid.UUID `json:"id"`
-	ExternalID        string    `json:"external_id"`
-	Name              string    `json:"name"`
-	Type              string    `json:"type"`
-	UserInstitutionID uuid.UUID `json:"user_institution_id"`
+	ID                uuid.UUID  `json:"id"`
+	ExternalID        string     `json:"external_id"`
+	Name              string     `json:"name"`
+	Type              string     `json:"type"`
+	UserInstitutionID *uuid.UUID `json:"user_institution_id"`
+	UserID            uuid.UUID  `json:"user_id"`
+}
+
+const deleteAccount = `-- name: DeleteAccount :exec
+UPDATE accounts
+SET deleted_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccount, id)
+	return err
+}
+
+const deleteAccountsByUserInstitutionID = `-- name: DeleteAccountsByUserInstitutionID :exec
+UPDATE accounts
+SET deleted_at = NOW()
+WHERE user_institution_id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteAccountsByUserInstitutionID(ctx context.Context, userInstitutionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccountsByUserInstitutionID, userInstitutionID)
+	return err
+}
+
+const updateAccount = `-- name: UpdateAccount :exec
+UPDATE accounts
+SET name = $2,
+  opening_balance = $3
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type UpdateAccountParams struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	OpeningBalance int64     `json:"opening_balance"`
+}
+
+func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) error {
+	_, err := q.db.Exec(ctx, updateAccount, arg.ID, arg.Name, arg.OpeningBalance)
+	return err
 }
@@ -32,7 +32,7 @@ func (q *Queries) DeleteBudgetCategories(ctx context.Context, budgetID uuid.UUID
 
 const getBudgetCategory = `-- name: GetBudgetCategory :one
 SELECT bc.id, bc.amount, bc.created_at, bc.updated_at, bc.deleted_at, bc.budget_id, bc.category_id,
-  tc.id, tc.external_id, tc.name, tc.created_at, tc.updated_at, tc.deleted_at
+  tc.id, tc.external_id, tc.name, tc.created_at, tc.updated_at, tc.deleted_at, tc.icon, tc.color, tc.user_id, tc.parent_id
 FROM budget_categories bc
   JOIN transaction_categories tc ON bc.category_id = tc.id
   JOIN budgets b ON bc.budget_id = b.id
@@ -69,13 +69,17 @@ func (q *Queries) GetBudgetCategory(ctx context.Context, arg GetBudgetCategoryPa
 		&i.TransactionCategory.CreatedAt,
 		&i.TransactionCategory.UpdatedAt,
 		&i.TransactionCategory.DeletedAt,
+		&i.TransactionCategory.Icon,
+		&i.TransactionCategory.Color,
+		&i.TransactionCategory.UserID,
+		&i.TransactionCategory.ParentID,
 	)
 	return i, err
 }
 
 const listBudgetCategories = `-- name: ListBudgetCategories :many
 SELECT bc.id, bc.amount, bc.created_at, bc.updated_at, bc.deleted_at, bc.budget_id, bc.category_id,
-  tc.id, tc.external_id, tc.name, tc.created_at, tc.updated_at, tc.deleted_at
+  tc.id, tc.external_id, tc.name, tc.created_at, tc.updated_at, tc.deleted_at, tc.icon, tc.color, tc.user_id, tc.parent_id
 FROM budget_categories bc
   JOIN transaction_categories tc ON bc.category_id = tc.id
 WHERE budget_id = $1
@@ -111,6 +115,10 @@ func (q *Queries) ListBudgetCategories(ctx context.Context, budgetID uuid.UUID)
 			&i.TransactionCategory.CreatedAt,
 			&i.TransactionCategory.UpdatedAt,
 			&i.TransactionCategory.DeletedAt,
+			&i.TransactionCategory.Icon,
+			&i.TransactionCategory.Color,
+			&i.TransactionCategory.UserID,
+			&i.TransactionCategory.ParentID,
 		); err != nil {
 			return nil, err
 		}
@@ -121,3 +129,59 @@ func (q *Queries) ListBudgetCategories(ctx context.Context, budgetID uuid.UUID)
 	}
 	return items, nil
 }
+
+const mergeBudgetCategories = `-- name: MergeBudgetCategories :exec
+WITH merged AS (
+  UPDATE budget_categories t
+  SET amount = t.amount + s.amount
+  FROM budget_categories s
+    JOIN budgets b ON s.budget_id = b.id
+  WHERE b.user_id = $1
+    AND s.category_id = $2
+    AND t.category_id = $3
+    AND t.budget_id = s.budget_id
+    AND s.deleted_at IS NULL
+    AND t.deleted_at IS NULL
+  RETURNING s.id
+)
+UPDATE budget_categories
+SET deleted_at = NOW()
+WHERE id IN (
+    SELECT id
+    FROM merged
+  )
+`
+
+type MergeBudgetCategoriesParams struct {
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+}
+
+func (q *Queries) MergeBudgetCategories(ctx context.Context, arg MergeBudgetCategoriesParams) error {
+	_, err := q.db.Exec(ctx, mergeBudgetCategories, arg.UserID, arg.SourceCategoryID, arg.TargetCategoryID)
+	return err
+}
+
+const moveBudgetCategories = `-- name: MoveBudgetCategories :exec
+UPDATE budget_categories
+SET category_id = $1
+WHERE category_id = $2
+  AND deleted_at IS NULL
+  AND budget_id IN (
+    SELECT id
+    FROM budgets
+    WHERE user_id = $3
+  )
+`
+
+type MoveBudgetCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) MoveBudgetCategories(ctx context.Context, arg MoveBudgetCategoriesParams) error {
+	_, err := q.db.Exec(ctx, moveBudgetCategories, arg.TargetCategoryID, arg.SourceCategoryID, arg.UserID)
+	return err
+}
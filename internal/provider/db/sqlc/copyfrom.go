@@ -67,6 +67,7 @@ func (r iteratorForCreateAccounts) Values() ([]interface{}, error) {
 		r.rows[0].Name,
 		r.rows[0].Type,
 		r.rows[0].UserInstitutionID,
+		r.rows[0].UserID,
 	}, nil
 }
 
@@ -75,7 +76,7 @@ func (r iteratorForCreateAccounts) Err() error {
 }
 
 func (q *Queries) CreateAccounts(ctx context.Context, arg []CreateAccountsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"accounts"}, []string{"id", "external_id", "name", "type", "user_institution_id"}, &iteratorForCreateAccounts{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"accounts"}, []string{"id", "external_id", "name", "type", "user_institution_id", "user_id"}, &iteratorForCreateAccounts{rows: arg})
 }
 
 // iteratorForCreateBudgetCategories implements pgx.CopyFromSource.
@@ -146,6 +147,39 @@ func (q *Queries) CreateInstitutions(ctx context.Context, arg []CreateInstitutio
 	return q.db.CopyFrom(ctx, []string{"institutions"}, []string{"external_id", "name", "logo"}, &iteratorForCreateInstitutions{rows: arg})
 }
 
+// iteratorForCreateInvestmentPositions implements pgx.CopyFromSource.
+type iteratorForCreateInvestmentPositions struct {
+	rows                 []CreateInvestmentPositionsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateInvestmentPositions) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateInvestmentPositions) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].Amount,
+		r.rows[0].InvestmentID,
+	}, nil
+}
+
+func (r iteratorForCreateInvestmentPositions) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateInvestmentPositions(ctx context.Context, arg []CreateInvestmentPositionsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"investment_positions"}, []string{"amount", "investment_id"}, &iteratorForCreateInvestmentPositions{rows: arg})
+}
+
 // iteratorForCreatePaymentMethods implements pgx.CopyFromSource.
 type iteratorForCreatePaymentMethods struct {
 	rows                 []CreatePaymentMethodsParams
@@ -179,6 +213,46 @@ func (q *Queries) CreatePaymentMethods(ctx context.Context, arg []CreatePaymentM
 	return q.db.CopyFrom(ctx, []string{"payment_methods"}, []string{"external_id", "name"}, &iteratorForCreatePaymentMethods{rows: arg})
 }
 
+// iteratorForCreateSyncRunItems implements pgx.CopyFromSource.
+type iteratorForCreateSyncRunItems struct {
+	rows                 []CreateSyncRunItemsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateSyncRunItems) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateSyncRunItems) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].SyncRunID,
+		r.rows[0].AccountID,
+		r.rows[0].Status,
+		r.rows[0].Fetched,
+		r.rows[0].Inserted,
+		r.rows[0].Updated,
+		r.rows[0].Skipped,
+		r.rows[0].Deleted,
+		r.rows[0].FailureReason,
+	}, nil
+}
+
+func (r iteratorForCreateSyncRunItems) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateSyncRunItems(ctx context.Context, arg []CreateSyncRunItemsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"sync_run_items"}, []string{"sync_run_id", "account_id", "status", "fetched", "inserted", "updated", "skipped", "deleted", "failure_reason"}, &iteratorForCreateSyncRunItems{rows: arg})
+}
+
 // iteratorForCreateTransactionCategories implements pgx.CopyFromSource.
 type iteratorForCreateTransactionCategories struct {
 	rows                 []CreateTransactionCategoriesParams
@@ -212,6 +286,74 @@ func (q *Queries) CreateTransactionCategories(ctx context.Context, arg []CreateT
 	return q.db.CopyFrom(ctx, []string{"transaction_categories"}, []string{"external_id", "name"}, &iteratorForCreateTransactionCategories{rows: arg})
 }
 
+// iteratorForCreateTransactionSplits implements pgx.CopyFromSource.
+type iteratorForCreateTransactionSplits struct {
+	rows                 []CreateTransactionSplitsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateTransactionSplits) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateTransactionSplits) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].Amount,
+		r.rows[0].Note,
+		r.rows[0].TransactionID,
+		r.rows[0].CategoryID,
+	}, nil
+}
+
+func (r iteratorForCreateTransactionSplits) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateTransactionSplits(ctx context.Context, arg []CreateTransactionSplitsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"transaction_splits"}, []string{"amount", "note", "transaction_id", "category_id"}, &iteratorForCreateTransactionSplits{rows: arg})
+}
+
+// iteratorForCreateTransactionTags implements pgx.CopyFromSource.
+type iteratorForCreateTransactionTags struct {
+	rows                 []CreateTransactionTagsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateTransactionTags) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateTransactionTags) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TransactionID,
+		r.rows[0].TagID,
+	}, nil
+}
+
+func (r iteratorForCreateTransactionTags) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateTransactionTags(ctx context.Context, arg []CreateTransactionTagsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"transaction_tags"}, []string{"transaction_id", "tag_id"}, &iteratorForCreateTransactionTags{rows: arg})
+}
+
 // iteratorForCreateTransactions implements pgx.CopyFromSource.
 type iteratorForCreateTransactions struct {
 	rows                 []CreateTransactionsParams
@@ -242,6 +384,12 @@ func (r iteratorForCreateTransactions) Values() ([]interface{}, error) {
 		r.rows[0].InstitutionID,
 		r.rows[0].CategoryID,
 		r.rows[0].IsIgnored,
+		r.rows[0].PurchaseDate,
+		r.rows[0].InstallmentNumber,
+		r.rows[0].TotalInstallments,
+		r.rows[0].BillID,
+		r.rows[0].Status,
+		r.rows[0].MerchantID,
 	}, nil
 }
 
@@ -250,5 +398,40 @@ func (r iteratorForCreateTransactions) Err() error {
 }
 
 func (q *Queries) CreateTransactions(ctx context.Context, arg []CreateTransactionsParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"transactions"}, []string{"external_id", "name", "amount", "payment_method_id", "date", "user_id", "account_id", "institution_id", "category_id", "is_ignored"}, &iteratorForCreateTransactions{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"transactions"}, []string{"external_id", "name", "amount", "payment_method_id", "date", "user_id", "account_id", "institution_id", "category_id", "is_ignored", "purchase_date", "installment_number", "total_installments", "bill_id", "status", "merchant_id"}, &iteratorForCreateTransactions{rows: arg})
+}
+
+// iteratorForCreateTransfers implements pgx.CopyFromSource.
+type iteratorForCreateTransfers struct {
+	rows                 []CreateTransfersParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCreateTransfers) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCreateTransfers) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].Status,
+		r.rows[0].UserID,
+		r.rows[0].OutgoingTransactionID,
+		r.rows[0].IncomingTransactionID,
+	}, nil
+}
+
+func (r iteratorForCreateTransfers) Err() error {
+	return nil
+}
+
+func (q *Queries) CreateTransfers(ctx context.Context, arg []CreateTransfersParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"transfers"}, []string{"status", "user_id", "outgoing_transaction_id", "incoming_transaction_id"}, &iteratorForCreateTransfers{rows: arg})
 }
@@ -17,7 +17,9 @@ type Account struct {
 	Type              string     `json:"type"`
 	CreatedAt         time.Time  `json:"created_at"`
 	DeletedAt         *time.Time `json:"deleted_at"`
-	UserInstitutionID uuid.UUID  `json:"user_institution_id"`
+	UserInstitutionID *uuid.UUID `json:"user_institution_id"`
+	UserID            uuid.UUID  `json:"user_id"`
+	OpeningBalance    int64      `json:"opening_balance"`
 }
 
 type AccountBalance struct {
@@ -55,6 +57,17 @@ type AiChatMessage struct {
 	AiChatID  uuid.UUID  `json:"ai_chat_id"`
 }
 
+type Attachment struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"storage_key"`
+	CreatedAt     time.Time `json:"created_at"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
 type Budget struct {
 	ID        uuid.UUID  `json:"id"`
 	Amount    int64      `json:"amount"`
@@ -75,6 +88,18 @@ type BudgetCategory struct {
 	CategoryID uuid.UUID  `json:"category_id"`
 }
 
+type CreditCardBill struct {
+	ID          uuid.UUID  `json:"id"`
+	ExternalID  string     `json:"external_id"`
+	ClosingDate *time.Time `json:"closing_date"`
+	DueDate     time.Time  `json:"due_date"`
+	TotalAmount int64      `json:"total_amount"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	AccountID   uuid.UUID  `json:"account_id"`
+}
+
 type Feedback struct {
 	ID        uuid.UUID  `json:"id"`
 	Message   string     `json:"message"`
@@ -83,6 +108,37 @@ type Feedback struct {
 	UserID    *uuid.UUID `json:"user_id"`
 }
 
+type Goal struct {
+	ID                  uuid.UUID  `json:"id"`
+	Name                string     `json:"name"`
+	TargetAmount        int64      `json:"target_amount"`
+	Deadline            time.Time  `json:"deadline"`
+	Interest            int64      `json:"interest"`
+	MonthlyContribution int64      `json:"monthly_contribution"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	DeletedAt           *time.Time `json:"deleted_at"`
+	AccountID           *uuid.UUID `json:"account_id"`
+	UserID              uuid.UUID  `json:"user_id"`
+}
+
+type GoalContribution struct {
+	ID        uuid.UUID  `json:"id"`
+	Amount    int64      `json:"amount"`
+	Date      time.Time  `json:"date"`
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	GoalID    uuid.UUID  `json:"goal_id"`
+}
+
+type HiddenCategory struct {
+	ID           uuid.UUID  `json:"id"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UserID       uuid.UUID  `json:"user_id"`
+	CategoryID   uuid.UUID  `json:"category_id"`
+	MergedIntoID *uuid.UUID `json:"merged_into_id"`
+}
+
 type Institution struct {
 	ID         uuid.UUID  `json:"id"`
 	ExternalID string     `json:"external_id"`
@@ -92,6 +148,46 @@ type Institution struct {
 	DeletedAt  *time.Time `json:"deleted_at"`
 }
 
+type Investment struct {
+	ID                uuid.UUID  `json:"id"`
+	ExternalID        string     `json:"external_id"`
+	Name              string     `json:"name"`
+	Type              string     `json:"type"`
+	Subtype           *string    `json:"subtype"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	UserInstitutionID uuid.UUID  `json:"user_institution_id"`
+}
+
+type InvestmentPosition struct {
+	ID           uuid.UUID  `json:"id"`
+	Amount       int64      `json:"amount"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	InvestmentID uuid.UUID  `json:"investment_id"`
+}
+
+type JobRun struct {
+	ID                uuid.UUID  `json:"id"`
+	Job               string     `json:"job"`
+	StartedAt         time.Time  `json:"started_at"`
+	FinishedAt        *time.Time `json:"finished_at"`
+	AccountsProcessed int64      `json:"accounts_processed"`
+	ErrorMessage      *string    `json:"error_message"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+type Merchant struct {
+	ID        uuid.UUID `json:"id"`
+	Key       string    `json:"key"`
+	Name      string    `json:"name"`
+	Document  *string   `json:"document"`
+	Mcc       *int32    `json:"mcc"`
+	Logo      *string   `json:"logo"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type PaymentMethod struct {
 	ID         uuid.UUID  `json:"id"`
 	ExternalID string     `json:"external_id"`
@@ -100,30 +196,144 @@ type PaymentMethod struct {
 	DeletedAt  *time.Time `json:"deleted_at"`
 }
 
-type Transaction struct {
+type RecurringSeries struct {
+	ID              uuid.UUID `json:"id"`
+	Name            string    `json:"name"`
+	NormalizedName  string    `json:"normalized_name"`
+	Frequency       string    `json:"frequency"`
+	Amount          int64     `json:"amount"`
+	PreviousAmount  *int64    `json:"previous_amount"`
+	AverageAmount   int64     `json:"average_amount"`
+	Occurrences     int32     `json:"occurrences"`
+	FirstDate       time.Time `json:"first_date"`
+	LastDate        time.Time `json:"last_date"`
+	NextDate        time.Time `json:"next_date"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	UserID          uuid.UUID `json:"user_id"`
+	PaymentMethodID uuid.UUID `json:"payment_method_id"`
+	CategoryID      uuid.UUID `json:"category_id"`
+}
+
+type Rule struct {
 	ID              uuid.UUID  `json:"id"`
-	ExternalID      *string    `json:"external_id"`
 	Name            string     `json:"name"`
-	Amount          int64      `json:"amount"`
-	IsIgnored       bool       `json:"is_ignored"`
-	Date            time.Time  `json:"date"`
+	Priority        int32      `json:"priority"`
+	NameMatchType   *string    `json:"name_match_type"`
+	NamePattern     *string    `json:"name_pattern"`
+	MinAmount       *int64     `json:"min_amount"`
+	MaxAmount       *int64     `json:"max_amount"`
+	Direction       *string    `json:"direction"`
+	RenameTo        *string    `json:"rename_to"`
+	IsIgnored       *bool      `json:"is_ignored"`
 	CreatedAt       time.Time  `json:"created_at"`
 	UpdatedAt       time.Time  `json:"updated_at"`
 	DeletedAt       *time.Time `json:"deleted_at"`
-	PaymentMethodID uuid.UUID  `json:"payment_method_id"`
 	UserID          uuid.UUID  `json:"user_id"`
-	CategoryID      uuid.UUID  `json:"category_id"`
-	AccountID       *uuid.UUID `json:"account_id"`
+	PaymentMethodID *uuid.UUID `json:"payment_method_id"`
 	InstitutionID   *uuid.UUID `json:"institution_id"`
+	CategoryID      *uuid.UUID `json:"category_id"`
+	TagID           *uuid.UUID `json:"tag_id"`
+}
+
+type SyncRun struct {
+	ID         uuid.UUID  `json:"id"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type SyncRunItem struct {
+	ID            uuid.UUID `json:"id"`
+	Status        string    `json:"status"`
+	Fetched       int64     `json:"fetched"`
+	Inserted      int64     `json:"inserted"`
+	Skipped       int64     `json:"skipped"`
+	FailureReason *string   `json:"failure_reason"`
+	CreatedAt     time.Time `json:"created_at"`
+	SyncRunID     uuid.UUID `json:"sync_run_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+	Updated       int64     `json:"updated"`
+	Deleted       int64     `json:"deleted"`
+}
+
+type Tag struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	UserID    uuid.UUID  `json:"user_id"`
+}
+
+type Transaction struct {
+	ID                        uuid.UUID  `json:"id"`
+	ExternalID                *string    `json:"external_id"`
+	Name                      string     `json:"name"`
+	Amount                    int64      `json:"amount"`
+	IsIgnored                 bool       `json:"is_ignored"`
+	Date                      time.Time  `json:"date"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+	DeletedAt                 *time.Time `json:"deleted_at"`
+	PaymentMethodID           uuid.UUID  `json:"payment_method_id"`
+	UserID                    uuid.UUID  `json:"user_id"`
+	CategoryID                uuid.UUID  `json:"category_id"`
+	AccountID                 *uuid.UUID `json:"account_id"`
+	InstitutionID             *uuid.UUID `json:"institution_id"`
+	PurchaseDate              *time.Time `json:"purchase_date"`
+	InstallmentNumber         *int32     `json:"installment_number"`
+	TotalInstallments         *int32     `json:"total_installments"`
+	BillID                    *uuid.UUID `json:"bill_id"`
+	Status                    string     `json:"status"`
+	IsNameOverridden          bool       `json:"is_name_overridden"`
+	IsAmountOverridden        bool       `json:"is_amount_overridden"`
+	IsDateOverridden          bool       `json:"is_date_overridden"`
+	IsCategoryOverridden      bool       `json:"is_category_overridden"`
+	IsPaymentMethodOverridden bool       `json:"is_payment_method_overridden"`
+	Notes                     *string    `json:"notes"`
+	MerchantID                *uuid.UUID `json:"merchant_id"`
+	IsHidden                  bool       `json:"is_hidden"`
 }
 
 type TransactionCategory struct {
 	ID         uuid.UUID  `json:"id"`
-	ExternalID string     `json:"external_id"`
+	ExternalID *string    `json:"external_id"`
 	Name       string     `json:"name"`
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 	DeletedAt  *time.Time `json:"deleted_at"`
+	Icon       *string    `json:"icon"`
+	Color      *string    `json:"color"`
+	UserID     *uuid.UUID `json:"user_id"`
+	ParentID   *uuid.UUID `json:"parent_id"`
+}
+
+type TransactionSplit struct {
+	ID            uuid.UUID  `json:"id"`
+	Amount        int64      `json:"amount"`
+	Note          *string    `json:"note"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+	TransactionID uuid.UUID  `json:"transaction_id"`
+	CategoryID    uuid.UUID  `json:"category_id"`
+}
+
+type TransactionTag struct {
+	CreatedAt     time.Time `json:"created_at"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	TagID         uuid.UUID `json:"tag_id"`
+}
+
+type Transfer struct {
+	ID                    uuid.UUID `json:"id"`
+	Status                string    `json:"status"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	UserID                uuid.UUID `json:"user_id"`
+	OutgoingTransactionID uuid.UUID `json:"outgoing_transaction_id"`
+	IncomingTransactionID uuid.UUID `json:"incoming_transaction_id"`
 }
 
 type User struct {
@@ -158,4 +368,5 @@ type UserInstitution struct {
 	DeletedAt     *time.Time `json:"deleted_at"`
 	UserID        uuid.UUID  `json:"user_id"`
 	InstitutionID uuid.UUID  `json:"institution_id"`
+	Status        string     `json:"status"`
 }
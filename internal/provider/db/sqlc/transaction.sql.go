@@ -12,85 +12,242 @@ import (
 	"github.com/google/uuid"
 )
 
-const createTransaction = `-- name: CreateTransaction :exec
+const applyTransactionRules = `-- name: ApplyTransactionRules :exec
+UPDATE transactions
+SET name = $2,
+  category_id = $3,
+  is_ignored = $4
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type ApplyTransactionRulesParams struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	CategoryID uuid.UUID `json:"category_id"`
+	IsIgnored  bool      `json:"is_ignored"`
+}
+
+func (q *Queries) ApplyTransactionRules(ctx context.Context, arg ApplyTransactionRulesParams) error {
+	_, err := q.db.Exec(ctx, applyTransactionRules,
+		arg.ID,
+		arg.Name,
+		arg.CategoryID,
+		arg.IsIgnored,
+	)
+	return err
+}
+
+const createTransaction = `-- name: CreateTransaction :one
 INSERT INTO transactions (
     name,
     amount,
     payment_method_id,
     date,
     user_id,
-    category_id
+    category_id,
+    is_ignored,
+    notes,
+    account_id
   )
-VALUES ($1, $2, $3, $4, $5, $6)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, external_id, name, amount, is_ignored, date, created_at, updated_at, deleted_at, payment_method_id, user_id, category_id, account_id, institution_id, purchase_date, installment_number, total_installments, bill_id, status, is_name_overridden, is_amount_overridden, is_date_overridden, is_category_overridden, is_payment_method_overridden, notes, merchant_id, is_hidden
 `
 
 type CreateTransactionParams struct {
-	Name            string    `json:"name"`
-	Amount          int64     `json:"amount"`
-	PaymentMethodID uuid.UUID `json:"payment_method_id"`
-	Date            time.Time `json:"date"`
-	UserID          uuid.UUID `json:"user_id"`
-	CategoryID      uuid.UUID `json:"category_id"`
+	Name            string     `json:"name"`
+	Amount          int64      `json:"amount"`
+	PaymentMethodID uuid.UUID  `json:"payment_method_id"`
+	Date            time.Time  `json:"date"`
+	UserID          uuid.UUID  `json:"user_id"`
+	CategoryID      uuid.UUID  `json:"category_id"`
+	IsIgnored       bool       `json:"is_ignored"`
+	Notes           *string    `json:"notes"`
+	AccountID       *uuid.UUID `json:"account_id"`
 }
 
-func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) error {
-	_, err := q.db.Exec(ctx, createTransaction,
+func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transaction, error) {
+	row := q.db.QueryRow(ctx, createTransaction,
 		arg.Name,
 		arg.Amount,
 		arg.PaymentMethodID,
 		arg.Date,
 		arg.UserID,
 		arg.CategoryID,
+		arg.IsIgnored,
+		arg.Notes,
+		arg.AccountID,
 	)
-	return err
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Name,
+		&i.Amount,
+		&i.IsIgnored,
+		&i.Date,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.PaymentMethodID,
+		&i.UserID,
+		&i.CategoryID,
+		&i.AccountID,
+		&i.InstitutionID,
+		&i.PurchaseDate,
+		&i.InstallmentNumber,
+		&i.TotalInstallments,
+		&i.BillID,
+		&i.Status,
+		&i.IsNameOverridden,
+		&i.IsAmountOverridden,
+		&i.IsDateOverridden,
+		&i.IsCategoryOverridden,
+		&i.IsPaymentMethodOverridden,
+		&i.Notes,
+		&i.MerchantID,
+		&i.IsHidden,
+	)
+	return i, err
 }
 
 type CreateTransactionsParams struct {
-	ExternalID      *string    `json:"external_id"`
-	Name            string     `json:"name"`
-	Amount          int64      `json:"amount"`
-	PaymentMethodID uuid.UUID  `json:"payment_method_id"`
-	Date            time.Time  `json:"date"`
-	UserID          uuid.UUID  `json:"user_id"`
-	AccountID       *uuid.UUID `json:"account_id"`
-	InstitutionID   *uuid.UUID `json:"institution_id"`
-	CategoryID      uuid.UUID  `json:"category_id"`
-	IsIgnored       bool       `json:"is_ignored"`
+	ExternalID        *string    `json:"external_id"`
+	Name              string     `json:"name"`
+	Amount            int64      `json:"amount"`
+	PaymentMethodID   uuid.UUID  `json:"payment_method_id"`
+	Date              time.Time  `json:"date"`
+	UserID            uuid.UUID  `json:"user_id"`
+	AccountID         *uuid.UUID `json:"account_id"`
+	InstitutionID     *uuid.UUID `json:"institution_id"`
+	CategoryID        uuid.UUID  `json:"category_id"`
+	IsIgnored         bool       `json:"is_ignored"`
+	PurchaseDate      *time.Time `json:"purchase_date"`
+	InstallmentNumber *int32     `json:"installment_number"`
+	TotalInstallments *int32     `json:"total_installments"`
+	BillID            *uuid.UUID `json:"bill_id"`
+	Status            string     `json:"status"`
+	MerchantID        *uuid.UUID `json:"merchant_id"`
+}
+
+const deleteTransactions = `-- name: DeleteTransactions :exec
+UPDATE transactions
+SET deleted_at = NOW()
+WHERE id = ANY($1::uuid[])
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteTransactions(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransactions, ids)
+	return err
+}
+
+const deleteTransactionsByAccountID = `-- name: DeleteTransactionsByAccountID :exec
+UPDATE transactions
+SET deleted_at = NOW()
+WHERE account_id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteTransactionsByAccountID(ctx context.Context, accountID *uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransactionsByAccountID, accountID)
+	return err
+}
+
+const getDeletedTransactionByID = `-- name: GetDeletedTransactionByID :one
+SELECT id, external_id, name, amount, is_ignored, date, created_at, updated_at, deleted_at, payment_method_id, user_id, category_id, account_id, institution_id, purchase_date, installment_number, total_installments, bill_id, status, is_name_overridden, is_amount_overridden, is_date_overridden, is_category_overridden, is_payment_method_overridden, notes, merchant_id, is_hidden
+FROM transactions
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetDeletedTransactionByID(ctx context.Context, id uuid.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getDeletedTransactionByID, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Name,
+		&i.Amount,
+		&i.IsIgnored,
+		&i.Date,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.PaymentMethodID,
+		&i.UserID,
+		&i.CategoryID,
+		&i.AccountID,
+		&i.InstitutionID,
+		&i.PurchaseDate,
+		&i.InstallmentNumber,
+		&i.TotalInstallments,
+		&i.BillID,
+		&i.Status,
+		&i.IsNameOverridden,
+		&i.IsAmountOverridden,
+		&i.IsDateOverridden,
+		&i.IsCategoryOverridden,
+		&i.IsPaymentMethodOverridden,
+		&i.Notes,
+		&i.MerchantID,
+		&i.IsHidden,
+	)
+	return i, err
 }
 
 const getTransactionByID = `-- name: GetTransactionByID :one
-SELECT transactions.id, transactions.external_id, transactions.name, transactions.amount, transactions.is_ignored, transactions.date, transactions.created_at, transactions.updated_at, transactions.deleted_at, transactions.payment_method_id, transactions.user_id, transactions.category_id, transactions.account_id, transactions.institution_id,
+SELECT transactions.id, transactions.external_id, transactions.name, transactions.amount, transactions.is_ignored, transactions.date, transactions.created_at, transactions.updated_at, transactions.deleted_at, transactions.payment_method_id, transactions.user_id, transactions.category_id, transactions.account_id, transactions.institution_id, transactions.purchase_date, transactions.installment_number, transactions.total_installments, transactions.bill_id, transactions.status, transactions.is_name_overridden, transactions.is_amount_overridden, transactions.is_date_overridden, transactions.is_category_overridden, transactions.is_payment_method_overridden, transactions.notes, transactions.merchant_id, transactions.is_hidden,
   transaction_categories.name as category_name,
   institutions.name as institution_name,
   institutions.logo as institution_logo,
-  payment_methods.name as payment_method_name
+  payment_methods.name as payment_method_name,
+  merchants.name as merchant_name,
+  merchants.logo as merchant_logo
 FROM transactions
   LEFT JOIN transaction_categories ON transactions.category_id = transaction_categories.id
   LEFT JOIN institutions ON transactions.institution_id = institutions.id
   LEFT JOIN payment_methods ON transactions.payment_method_id = payment_methods.id
+  LEFT JOIN merchants ON transactions.merchant_id = merchants.id
 WHERE transactions.id = $1
   AND transactions.deleted_at IS NULL
+  AND NOT transactions.is_hidden
 `
 
 type GetTransactionByIDRow struct {
-	ID                uuid.UUID  `json:"id"`
-	ExternalID        *string    `json:"external_id"`
-	Name              string     `json:"name"`
-	Amount            int64      `json:"amount"`
-	IsIgnored         bool       `json:"is_ignored"`
-	Date              time.Time  `json:"date"`
-	CreatedAt         time.Time  `json:"created_at"`
-	UpdatedAt         time.Time  `json:"updated_at"`
-	DeletedAt         *time.Time `json:"deleted_at"`
-	PaymentMethodID   uuid.UUID  `json:"payment_method_id"`
-	UserID            uuid.UUID  `json:"user_id"`
-	CategoryID        uuid.UUID  `json:"category_id"`
-	AccountID         *uuid.UUID `json:"account_id"`
-	InstitutionID     *uuid.UUID `json:"institution_id"`
-	CategoryName      *string    `json:"category_name"`
-	InstitutionName   *string    `json:"institution_name"`
-	InstitutionLogo   *string    `json:"institution_logo"`
-	PaymentMethodName *string    `json:"payment_method_name"`
+	ID                        uuid.UUID  `json:"id"`
+	ExternalID                *string    `json:"external_id"`
+	Name                      string     `json:"name"`
+	Amount                    int64      `json:"amount"`
+	IsIgnored                 bool       `json:"is_ignored"`
+	Date                      time.Time  `json:"date"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+	DeletedAt                 *time.Time `json:"deleted_at"`
+	PaymentMethodID           uuid.UUID  `json:"payment_method_id"`
+	UserID                    uuid.UUID  `json:"user_id"`
+	CategoryID                uuid.UUID  `json:"category_id"`
+	AccountID                 *uuid.UUID `json:"account_id"`
+	InstitutionID             *uuid.UUID `json:"institution_id"`
+	PurchaseDate              *time.Time `json:"purchase_date"`
+	InstallmentNumber         *int32     `json:"installment_number"`
+	TotalInstallments         *int32     `json:"total_installments"`
+	BillID                    *uuid.UUID `json:"bill_id"`
+	Status                    string     `json:"status"`
+	IsNameOverridden          bool       `json:"is_name_overridden"`
+	IsAmountOverridden        bool       `json:"is_amount_overridden"`
+	IsDateOverridden          bool       `json:"is_date_overridden"`
+	IsCategoryOverridden      bool       `json:"is_category_overridden"`
+	IsPaymentMethodOverridden bool       `json:"is_payment_method_overridden"`
+	Notes                     *string    `json:"notes"`
+	MerchantID                *uuid.UUID `json:"merchant_id"`
+	IsHidden                  bool       `json:"is_hidden"`
+	CategoryName              *string    `json:"category_name"`
+	InstitutionName           *string    `json:"institution_name"`
+	InstitutionLogo           *string    `json:"institution_logo"`
+	PaymentMethodName         *string    `json:"payment_method_name"`
+	MerchantName              *string    `json:"merchant_name"`
+	MerchantLogo              *string    `json:"merchant_logo"`
 }
 
 func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (GetTransactionByIDRow, error) {
@@ -111,14 +268,370 @@ func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (GetTran
 		&i.CategoryID,
 		&i.AccountID,
 		&i.InstitutionID,
+		&i.PurchaseDate,
+		&i.InstallmentNumber,
+		&i.TotalInstallments,
+		&i.BillID,
+		&i.Status,
+		&i.IsNameOverridden,
+		&i.IsAmountOverridden,
+		&i.IsDateOverridden,
+		&i.IsCategoryOverridden,
+		&i.IsPaymentMethodOverridden,
+		&i.Notes,
+		&i.MerchantID,
+		&i.IsHidden,
 		&i.CategoryName,
 		&i.InstitutionName,
 		&i.InstitutionLogo,
 		&i.PaymentMethodName,
+		&i.MerchantName,
+		&i.MerchantLogo,
 	)
 	return i, err
 }
 
+const hideTransaction = `-- name: HideTransaction :exec
+UPDATE transactions
+SET deleted_at = NOW(),
+  is_hidden = true
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) HideTransaction(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, hideTransaction, id)
+	return err
+}
+
+const hideTransactions = `-- name: HideTransactions :exec
+UPDATE transactions
+SET deleted_at = NOW(),
+  is_hidden = true
+WHERE id = ANY($1::uuid[])
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) HideTransactions(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, hideTransactions, ids)
+	return err
+}
+
+const hideTransactionsByUserInstitutionID = `-- name: HideTransactionsByUserInstitutionID :exec
+UPDATE transactions
+SET is_hidden = true
+WHERE account_id IN (
+    SELECT id
+    FROM accounts
+    WHERE user_institution_id = $1
+  )
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) HideTransactionsByUserInstitutionID(ctx context.Context, userInstitutionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, hideTransactionsByUserInstitutionID, userInstitutionID)
+	return err
+}
+
+const listDeletedTransactions = `-- name: ListDeletedTransactions :many
+SELECT id, external_id, name, amount, is_ignored, date, created_at, updated_at, deleted_at, payment_method_id, user_id, category_id, account_id, institution_id, purchase_date, installment_number, total_installments, bill_id, status, is_name_overridden, is_amount_overridden, is_date_overridden, is_category_overridden, is_payment_method_overridden, notes, merchant_id, is_hidden
+FROM transactions
+WHERE user_id = $1
+  AND deleted_at >= $2
+  AND (
+    external_id IS NULL
+    OR external_id LIKE 'import:%'
+    OR is_hidden
+  )
+ORDER BY deleted_at DESC
+`
+
+type ListDeletedTransactionsParams struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func (q *Queries) ListDeletedTransactions(ctx context.Context, arg ListDeletedTransactionsParams) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, listDeletedTransactions, arg.UserID, arg.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Name,
+			&i.Amount,
+			&i.IsIgnored,
+			&i.Date,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.PaymentMethodID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.AccountID,
+			&i.InstitutionID,
+			&i.PurchaseDate,
+			&i.InstallmentNumber,
+			&i.TotalInstallments,
+			&i.BillID,
+			&i.Status,
+			&i.IsNameOverridden,
+			&i.IsAmountOverridden,
+			&i.IsDateOverridden,
+			&i.IsCategoryOverridden,
+			&i.IsPaymentMethodOverridden,
+			&i.Notes,
+			&i.MerchantID,
+			&i.IsHidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHiddenTransactionExternalIDs = `-- name: ListHiddenTransactionExternalIDs :many
+SELECT external_id::text
+FROM transactions
+WHERE user_id = $1
+  AND is_hidden
+  AND external_id IS NOT NULL
+`
+
+func (q *Queries) ListHiddenTransactionExternalIDs(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listHiddenTransactionExternalIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var external_id string
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInstallmentTransactions = `-- name: ListInstallmentTransactions :many
+SELECT DISTINCT ON (
+    account_id,
+    purchase_date,
+    regexp_replace(name, '\s*\d+/\d+\s*$', ''),
+    amount,
+    total_installments
+  ) id, external_id, name, amount, is_ignored, date, created_at, updated_at, deleted_at, payment_method_id, user_id, category_id, account_id, institution_id, purchase_date, installment_number, total_installments, bill_id, status, is_name_overridden, is_amount_overridden, is_date_overridden, is_category_overridden, is_payment_method_overridden, notes, merchant_id, is_hidden
+FROM transactions
+WHERE user_id = $1
+  AND installment_number IS NOT NULL
+  AND total_installments IS NOT NULL
+  AND date_trunc('month', date) + make_interval(months => total_installments - installment_number) >= date_trunc('month', NOW())
+  AND deleted_at IS NULL
+  AND NOT is_hidden
+ORDER BY account_id,
+  purchase_date,
+  regexp_replace(name, '\s*\d+/\d+\s*$', ''),
+  amount,
+  total_installments,
+  installment_number DESC
+`
+
+func (q *Queries) ListInstallmentTransactions(ctx context.Context, userID uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, listInstallmentTransactions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalID,
+			&i.Name,
+			&i.Amount,
+			&i.IsIgnored,
+			&i.Date,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.PaymentMethodID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.AccountID,
+			&i.InstitutionID,
+			&i.PurchaseDate,
+			&i.InstallmentNumber,
+			&i.TotalInstallments,
+			&i.BillID,
+			&i.Status,
+			&i.IsNameOverridden,
+			&i.IsAmountOverridden,
+			&i.IsDateOverridden,
+			&i.IsCategoryOverridden,
+			&i.IsPaymentMethodOverridden,
+			&i.Notes,
+			&i.MerchantID,
+			&i.IsHidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionExternalIDs = `-- name: ListTransactionExternalIDs :many
+SELECT external_id::text
+FROM transactions
+WHERE user_id = $1
+  AND external_id = ANY($2::text[])
+`
+
+type ListTransactionExternalIDsParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	ExternalIds []string  `json:"external_ids"`
+}
+
+func (q *Queries) ListTransactionExternalIDs(ctx context.Context, arg ListTransactionExternalIDsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTransactionExternalIDs, arg.UserID, arg.ExternalIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var external_id string
+		if err := rows.Scan(&external_id); err != nil {
+			return nil, err
+		}
+		items = append(items, external_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeTransactionCategories = `-- name: MergeTransactionCategories :exec
+UPDATE transactions
+SET category_id = $1
+WHERE user_id = $2
+  AND category_id = $3
+  AND deleted_at IS NULL
+`
+
+type MergeTransactionCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+}
+
+func (q *Queries) MergeTransactionCategories(ctx context.Context, arg MergeTransactionCategoriesParams) error {
+	_, err := q.db.Exec(ctx, mergeTransactionCategories, arg.TargetCategoryID, arg.UserID, arg.SourceCategoryID)
+	return err
+}
+
+const purgeDeletedTransactions = `-- name: PurgeDeletedTransactions :exec
+DELETE FROM transactions
+WHERE deleted_at < $1
+  AND (
+    external_id IS NULL
+    OR external_id LIKE 'import:%'
+  )
+`
+
+func (q *Queries) PurgeDeletedTransactions(ctx context.Context, deletedAt *time.Time) error {
+	_, err := q.db.Exec(ctx, purgeDeletedTransactions, deletedAt)
+	return err
+}
+
+const reconcileTransaction = `-- name: ReconcileTransaction :exec
+UPDATE transactions
+SET external_id = $2,
+  name = $3,
+  amount = $4,
+  date = $5,
+  status = $6,
+  purchase_date = $7,
+  installment_number = $8,
+  total_installments = $9,
+  bill_id = $10,
+  payment_method_id = $11,
+  category_id = $12,
+  is_ignored = $13,
+  merchant_id = $14
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type ReconcileTransactionParams struct {
+	ID                uuid.UUID  `json:"id"`
+	ExternalID        *string    `json:"external_id"`
+	Name              string     `json:"name"`
+	Amount            int64      `json:"amount"`
+	Date              time.Time  `json:"date"`
+	Status            string     `json:"status"`
+	PurchaseDate      *time.Time `json:"purchase_date"`
+	InstallmentNumber *int32     `json:"installment_number"`
+	TotalInstallments *int32     `json:"total_installments"`
+	BillID            *uuid.UUID `json:"bill_id"`
+	PaymentMethodID   uuid.UUID  `json:"payment_method_id"`
+	CategoryID        uuid.UUID  `json:"category_id"`
+	IsIgnored         bool       `json:"is_ignored"`
+	MerchantID        *uuid.UUID `json:"merchant_id"`
+}
+
+func (q *Queries) ReconcileTransaction(ctx context.Context, arg ReconcileTransactionParams) error {
+	_, err := q.db.Exec(ctx, reconcileTransaction,
+		arg.ID,
+		arg.ExternalID,
+		arg.Name,
+		arg.Amount,
+		arg.Date,
+		arg.Status,
+		arg.PurchaseDate,
+		arg.InstallmentNumber,
+		arg.TotalInstallments,
+		arg.BillID,
+		arg.PaymentMethodID,
+		arg.CategoryID,
+		arg.IsIgnored,
+		arg.MerchantID,
+	)
+	return err
+}
+
+const restoreTransaction = `-- name: RestoreTransaction :exec
+UPDATE transactions
+SET deleted_at = NULL,
+  is_hidden = false
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreTransaction(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, restoreTransaction, id)
+	return err
+}
+
 const updateTransaction = `-- name: UpdateTransaction :exec
 UPDATE transactions
 SET name = $2,
@@ -127,7 +640,18 @@ SET name = $2,
   date = $5,
   account_id = $6,
   institution_id = $7,
-  category_id = $8
+  category_id = $8,
+  notes = $10,
+  is_name_overridden = is_name_overridden
+  OR name <> $2,
+  is_amount_overridden = is_amount_overridden
+  OR amount <> $3,
+  is_date_overridden = is_date_overridden
+  OR date <> $5,
+  is_category_overridden = is_category_overridden
+  OR category_id <> $8,
+  is_payment_method_overridden = is_payment_method_overridden
+  OR payment_method_id <> $4
 WHERE id = $1
   AND user_id = $9
   AND deleted_at IS NULL
@@ -143,6 +667,7 @@ type UpdateTransactionParams struct {
 	InstitutionID   *uuid.UUID `json:"institution_id"`
 	CategoryID      uuid.UUID  `json:"category_id"`
 	UserID          uuid.UUID  `json:"user_id"`
+	Notes           *string    `json:"notes"`
 }
 
 func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionParams) error {
@@ -156,6 +681,47 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.InstitutionID,
 		arg.CategoryID,
 		arg.UserID,
+		arg.Notes,
 	)
 	return err
 }
+
+const updateTransactionsCategory = `-- name: UpdateTransactionsCategory :exec
+UPDATE transactions
+SET category_id = $1,
+  is_category_overridden = true
+WHERE id = ANY($2::uuid[])
+  AND user_id = $3
+  AND deleted_at IS NULL
+`
+
+type UpdateTransactionsCategoryParams struct {
+	CategoryID uuid.UUID   `json:"category_id"`
+	Ids        []uuid.UUID `json:"ids"`
+	UserID     uuid.UUID   `json:"user_id"`
+}
+
+func (q *Queries) UpdateTransactionsCategory(ctx context.Context, arg UpdateTransactionsCategoryParams) error {
+	_, err := q.db.Exec(ctx, updateTransactionsCategory, arg.CategoryID, arg.Ids, arg.UserID)
+	return err
+}
+
+const updateTransactionsIsIgnored = `-- name: UpdateTransactionsIsIgnored :exec
+UPDATE transactions
+SET is_ignored = $1,
+  is_category_overridden = true
+WHERE id = ANY($2::uuid[])
+  AND user_id = $3
+  AND deleted_at IS NULL
+`
+
+type UpdateTransactionsIsIgnoredParams struct {
+	IsIgnored bool        `json:"is_ignored"`
+	Ids       []uuid.UUID `json:"ids"`
+	UserID    uuid.UUID   `json:"user_id"`
+}
+
+func (q *Queries) UpdateTransactionsIsIgnored(ctx context.Context, arg UpdateTransactionsIsIgnoredParams) error {
+	_, err := q.db.Exec(ctx, updateTransactionsIsIgnored, arg.IsIgnored, arg.Ids, arg.UserID)
+	return err
+}
@@ -12,12 +12,61 @@ import (
 )
 
 type CreateTransactionCategoriesParams struct {
-	ExternalID string `json:"external_id"`
-	Name       string `json:"name"`
+	ExternalID *string `json:"external_id"`
+	Name       string  `json:"name"`
+}
+
+const createTransactionCategory = `-- name: CreateTransactionCategory :one
+INSERT INTO transaction_categories (name, icon, color, user_id, parent_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, external_id, name, created_at, updated_at, deleted_at, icon, color, user_id, parent_id
+`
+
+type CreateTransactionCategoryParams struct {
+	Name     string     `json:"name"`
+	Icon     *string    `json:"icon"`
+	Color    *string    `json:"color"`
+	UserID   *uuid.UUID `json:"user_id"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+func (q *Queries) CreateTransactionCategory(ctx context.Context, arg CreateTransactionCategoryParams) (TransactionCategory, error) {
+	row := q.db.QueryRow(ctx, createTransactionCategory,
+		arg.Name,
+		arg.Icon,
+		arg.Color,
+		arg.UserID,
+		arg.ParentID,
+	)
+	var i TransactionCategory
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Icon,
+		&i.Color,
+		&i.UserID,
+		&i.ParentID,
+	)
+	return i, err
+}
+
+const deleteTransactionCategory = `-- name: DeleteTransactionCategory :exec
+UPDATE transaction_categories
+SET deleted_at = now()
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTransactionCategory(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransactionCategory, id)
+	return err
 }
 
 const getDefaultTransactionCategory = `-- name: GetDefaultTransactionCategory :one
-SELECT id, external_id, name, created_at, updated_at, deleted_at
+SELECT id, external_id, name, created_at, updated_at, deleted_at, icon, color, user_id, parent_id
 FROM transaction_categories
 WHERE external_id = '99999999'
   AND deleted_at IS NULL
@@ -33,12 +82,16 @@ func (q *Queries) GetDefaultTransactionCategory(ctx context.Context) (Transactio
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Icon,
+		&i.Color,
+		&i.UserID,
+		&i.ParentID,
 	)
 	return i, err
 }
 
 const getTransactionCategoryByID = `-- name: GetTransactionCategoryByID :one
-SELECT id, external_id, name, created_at, updated_at, deleted_at
+SELECT id, external_id, name, created_at, updated_at, deleted_at, icon, color, user_id, parent_id
 FROM transaction_categories
 WHERE id = $1
   AND deleted_at IS NULL
@@ -54,6 +107,39 @@ func (q *Queries) GetTransactionCategoryByID(ctx context.Context, id uuid.UUID)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Icon,
+		&i.Color,
+		&i.UserID,
+		&i.ParentID,
 	)
 	return i, err
 }
+
+const updateTransactionCategory = `-- name: UpdateTransactionCategory :exec
+UPDATE transaction_categories
+SET name = $2,
+  icon = $3,
+  color = $4,
+  parent_id = $5
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type UpdateTransactionCategoryParams struct {
+	ID       uuid.UUID  `json:"id"`
+	Name     string     `json:"name"`
+	Icon     *string    `json:"icon"`
+	Color    *string    `json:"color"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+func (q *Queries) UpdateTransactionCategory(ctx context.Context, arg UpdateTransactionCategoryParams) error {
+	_, err := q.db.Exec(ctx, updateTransactionCategory,
+		arg.ID,
+		arg.Name,
+		arg.Icon,
+		arg.Color,
+		arg.ParentID,
+	)
+	return err
+}
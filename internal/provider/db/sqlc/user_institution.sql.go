@@ -14,7 +14,7 @@ import (
 const createUserInstitution = `-- name: CreateUserInstitution :one
 INSERT INTO user_institutions (external_id, user_id, institution_id)
 VALUES ($1, $2, $3)
-RETURNING id, external_id, created_at, deleted_at, user_id, institution_id
+RETURNING id, external_id, created_at, deleted_at, user_id, institution_id, status
 `
 
 type CreateUserInstitutionParams struct {
@@ -33,12 +33,24 @@ func (q *Queries) CreateUserInstitution(ctx context.Context, arg CreateUserInsti
 		&i.DeletedAt,
 		&i.UserID,
 		&i.InstitutionID,
+		&i.Status,
 	)
 	return i, err
 }
 
+const deleteUserInstitution = `-- name: DeleteUserInstitution :exec
+UPDATE user_institutions
+SET deleted_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUserInstitution(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserInstitution, id)
+	return err
+}
+
 const getUserInstitutionByExternalID = `-- name: GetUserInstitutionByExternalID :one
-SELECT id, external_id, created_at, deleted_at, user_id, institution_id
+SELECT id, external_id, created_at, deleted_at, user_id, institution_id, status
 FROM user_institutions
 WHERE external_id = $1
   AND deleted_at IS NULL
@@ -54,6 +66,45 @@ func (q *Queries) GetUserInstitutionByExternalID(ctx context.Context, externalID
 		&i.DeletedAt,
 		&i.UserID,
 		&i.InstitutionID,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getUserInstitutionByID = `-- name: GetUserInstitutionByID :one
+SELECT id, external_id, created_at, deleted_at, user_id, institution_id, status
+FROM user_institutions
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) GetUserInstitutionByID(ctx context.Context, id uuid.UUID) (UserInstitution, error) {
+	row := q.db.QueryRow(ctx, getUserInstitutionByID, id)
+	var i UserInstitution
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UserID,
+		&i.InstitutionID,
+		&i.Status,
 	)
 	return i, err
 }
+
+const updateUserInstitutionStatus = `-- name: UpdateUserInstitutionStatus :exec
+UPDATE user_institutions
+SET status = $2
+WHERE id = $1
+`
+
+type UpdateUserInstitutionStatusParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
+
+func (q *Queries) UpdateUserInstitutionStatus(ctx context.Context, arg UpdateUserInstitutionStatusParams) error {
+	_, err := q.db.Exec(ctx, updateUserInstitutionStatus, arg.ID, arg.Status)
+	return err
+}
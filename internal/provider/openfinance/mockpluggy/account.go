@@ -0,0 +1,36 @@
+package mockpluggy
+
+import (
+	"context"
+	"encoding/json"
+
+	root "github.com/danielmesquitta/api-finance-manager"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+)
+
+func (c *Client) ListAccounts(
+	ctx context.Context,
+	connectionID string,
+) ([]openfinance.Account, error) {
+	data, err := root.TestData.ReadFile("test/data/pluggy/accounts.json")
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	accountsRes := pluggy.AccountsResponse{}
+	if err := json.Unmarshal(data, &accountsRes); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []pluggy.AccountsResult
+	for _, r := range accountsRes.Results {
+		if r.ItemID != connectionID {
+			continue
+		}
+		results = append(results, r)
+	}
+
+	return pluggy.ParseAccountsResults(results), nil
+}
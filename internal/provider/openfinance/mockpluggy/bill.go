@@ -0,0 +1,14 @@
+package mockpluggy
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+func (c *Client) ListBills(
+	ctx context.Context,
+	accountID string,
+) ([]openfinance.Bill, error) {
+	return []openfinance.Bill{}, nil
+}
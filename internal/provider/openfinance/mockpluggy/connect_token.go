@@ -0,0 +1,17 @@
+package mockpluggy
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+const MockConnectToken = "mock-connect-token"
+
+func (c *Client) CreateConnectToken(
+	ctx context.Context,
+	clientUserID string,
+	options ...openfinance.ConnectTokenOption,
+) (string, error) {
+	return MockConnectToken, nil
+}
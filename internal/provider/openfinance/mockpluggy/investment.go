@@ -0,0 +1,36 @@
+package mockpluggy
+
+import (
+	"context"
+	"encoding/json"
+
+	root "github.com/danielmesquitta/api-finance-manager"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+)
+
+func (c *Client) ListInvestments(
+	ctx context.Context,
+	connectionID string,
+) ([]openfinance.Investment, error) {
+	data, err := root.TestData.ReadFile("test/data/pluggy/investments.json")
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	investmentsRes := pluggy.InvestmentsResponse{}
+	if err := json.Unmarshal(data, &investmentsRes); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []pluggy.InvestmentsResult
+	for _, r := range investmentsRes.Results {
+		if r.ItemID != connectionID {
+			continue
+		}
+		results = append(results, r)
+	}
+
+	return pluggy.ParseInvestmentsResults(results), nil
+}
@@ -0,0 +1,12 @@
+package mockpluggy
+
+import (
+	"context"
+)
+
+func (c *Client) DeleteItem(
+	ctx context.Context,
+	itemID string,
+) error {
+	return nil
+}
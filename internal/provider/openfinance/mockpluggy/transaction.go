@@ -0,0 +1,37 @@
+package mockpluggy
+
+import (
+	"context"
+	"encoding/json"
+
+	root "github.com/danielmesquitta/api-finance-manager"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+)
+
+// ListTransactions lists the test data transactions of the account, failing
+// for accounts without test data, like the provider does for unknown
+// accounts.
+func (c *Client) ListTransactions(
+	ctx context.Context,
+	accountID string,
+	options ...openfinance.TransactionOption,
+) ([]openfinance.Transaction, error) {
+	data, err := root.TestData.ReadFile(
+		"test/data/pluggy/transactions/" + accountID + ".json",
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	transactionsRes := pluggy.TransactionsResponse{}
+	if err := json.Unmarshal(data, &transactionsRes); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return pluggy.ParseTransactionsResults(
+		accountID,
+		transactionsRes.Results,
+	), nil
+}
@@ -45,10 +45,32 @@ func WithTransactionDateBefore(endDate time.Time) TransactionOption {
 	}
 }
 
+type ConnectTokenOptions struct {
+	ItemID string `json:"itemId,omitzero"`
+}
+
+type ConnectTokenOption func(*ConnectTokenOptions)
+
+// WithConnectTokenItemID scopes the connect token to an existing connection,
+// so the user can update its credentials instead of creating a new one.
+func WithConnectTokenItemID(itemID string) ConnectTokenOption {
+	return func(o *ConnectTokenOptions) {
+		o.ItemID = itemID
+	}
+}
+
 type Transaction struct {
 	entity.Transaction
 	CategoryExternalID      string
 	PaymentMethodExternalID string
+	BillExternalID          *string
+
+	// RawName, the counterparty and the MCC are the data the transaction
+	// merchant is normalised from. The counterparty is only set for companies.
+	RawName              string
+	CounterpartyName     *string
+	CounterpartyDocument *string
+	MCC                  *int32
 }
 
 type Account struct {
@@ -56,6 +78,17 @@ type Account struct {
 	Balance int64
 }
 
+type Bill struct {
+	ExternalID  string
+	DueDate     time.Time
+	TotalAmount int64
+}
+
+type Investment struct {
+	entity.Investment
+	Balance int64
+}
+
 type Client interface {
 	ListInstitutions(
 		ctx context.Context,
@@ -77,4 +110,21 @@ type Client interface {
 		ctx context.Context,
 		connectionID string,
 	) ([]Account, error)
+	ListBills(
+		ctx context.Context,
+		accountID string,
+	) ([]Bill, error)
+	ListInvestments(
+		ctx context.Context,
+		connectionID string,
+	) ([]Investment, error)
+	CreateConnectToken(
+		ctx context.Context,
+		clientUserID string,
+		options ...ConnectTokenOption,
+	) (accessToken string, err error)
+	DeleteItem(
+		ctx context.Context,
+		itemID string,
+	) error
 }
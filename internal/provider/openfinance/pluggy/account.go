@@ -10,12 +10,13 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
 )
 
-type accountsResponse struct {
-	Results []accountsResult `json:"results"`
+type AccountsResponse struct {
+	Results []AccountsResult `json:"results"`
 }
 
-type accountsResult struct {
+type AccountsResult struct {
 	ID      string  `json:"id"`
+	ItemID  string  `json:"itemId"`
 	Type    string  `json:"type"`
 	Name    string  `json:"name"`
 	Balance float64 `json:"balance"`
@@ -45,13 +46,17 @@ func (c *Client) ListAccounts(
 		return nil, errs.New(body)
 	}
 
-	accountsRes := accountsResponse{}
+	accountsRes := AccountsResponse{}
 	if err := json.Unmarshal(body, &accountsRes); err != nil {
 		return nil, errs.New(err)
 	}
 
+	return ParseAccountsResults(accountsRes.Results), nil
+}
+
+func ParseAccountsResults(results []AccountsResult) []openfinance.Account {
 	var accounts []openfinance.Account
-	for _, a := range accountsRes.Results {
+	for _, a := range results {
 		accounts = append(accounts, openfinance.Account{
 			Account: entity.Account{
 				ExternalID: a.ID,
@@ -62,5 +67,5 @@ func (c *Client) ListAccounts(
 		})
 	}
 
-	return accounts, nil
+	return accounts
 }
@@ -0,0 +1,62 @@
+package pluggy
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/money"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+type billsResponse struct {
+	Results []billsResult `json:"results"`
+}
+
+type billsResult struct {
+	ID          string    `json:"id"`
+	DueDate     time.Time `json:"dueDate"`
+	TotalAmount float64   `json:"totalAmount"`
+}
+
+func (c *Client) ListBills(
+	ctx context.Context,
+	accountID string,
+) ([]openfinance.Bill, error) {
+	if err := c.refreshAccessToken(ctx); err != nil {
+		return nil, errs.New(err)
+	}
+
+	queryParams := map[string]string{
+		"accountId": accountID,
+	}
+
+	res, err := c.c.R().
+		SetContext(ctx).
+		SetQueryParams(queryParams).
+		Get("/bills")
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	body := res.Body()
+	if res.IsError() {
+		return nil, errs.New(body)
+	}
+
+	billsRes := billsResponse{}
+	if err := json.Unmarshal(body, &billsRes); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var bills []openfinance.Bill
+	for _, b := range billsRes.Results {
+		bills = append(bills, openfinance.Bill{
+			ExternalID:  b.ID,
+			DueDate:     b.DueDate,
+			TotalAmount: money.ToCents(b.TotalAmount),
+		})
+	}
+
+	return bills, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 )
 
 type categoriesResponse struct {
@@ -48,7 +49,7 @@ func (c *Client) ListTransactionCategories(
 			continue
 		}
 		categories = append(categories, entity.TransactionCategory{
-			ExternalID: connector.ID,
+			ExternalID: ptr.New(connector.ID),
 			Name:       connector.DescriptionTranslated,
 		})
 	}
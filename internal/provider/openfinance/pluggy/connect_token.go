@@ -0,0 +1,67 @@
+package pluggy
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+type connectTokenRequest struct {
+	ItemID  string                     `json:"itemId,omitzero"`
+	Options connectTokenRequestOptions `json:"options"`
+}
+
+type connectTokenRequestOptions struct {
+	ClientUserID string `json:"clientUserId"`
+}
+
+type connectTokenResponse struct {
+	AccessToken string `json:"accessToken"`
+}
+
+func (c *Client) CreateConnectToken(
+	ctx context.Context,
+	clientUserID string,
+	options ...openfinance.ConnectTokenOption,
+) (string, error) {
+	opts := openfinance.ConnectTokenOptions{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	if err := c.refreshAccessToken(ctx); err != nil {
+		return "", errs.New(err)
+	}
+
+	connectTokenReq := connectTokenRequest{
+		ItemID: opts.ItemID,
+		Options: connectTokenRequestOptions{
+			ClientUserID: clientUserID,
+		},
+	}
+
+	res, err := c.c.R().
+		SetContext(ctx).
+		SetBody(connectTokenReq).
+		Post("/connect_token")
+	if err != nil {
+		return "", errs.New(err)
+	}
+	body := res.Body()
+	if res.IsError() {
+		return "", errs.New(body)
+	}
+
+	data := connectTokenResponse{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return "", errs.New(err)
+	}
+
+	if data.AccessToken == "" {
+		return "", errs.New("connect token is empty")
+	}
+
+	return data.AccessToken, nil
+}
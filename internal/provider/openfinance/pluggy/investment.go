@@ -0,0 +1,90 @@
+package pluggy
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/money"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance"
+)
+
+type InvestmentsResponse struct {
+	Total      int64               `json:"total"`
+	TotalPages int64               `json:"totalPages"`
+	Page       int64               `json:"page"`
+	Results    []InvestmentsResult `json:"results"`
+}
+
+type InvestmentsResult struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Balance float64 `json:"balance"`
+	Type    string  `json:"type"`
+	Subtype *string `json:"subtype"`
+	ItemID  string  `json:"itemId"`
+}
+
+func (c *Client) ListInvestments(
+	ctx context.Context,
+	connectionID string,
+) ([]openfinance.Investment, error) {
+	if err := c.refreshAccessToken(ctx); err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []InvestmentsResult
+	for page := 1; ; page++ {
+		queryParams := map[string]string{
+			"itemId":   connectionID,
+			"pageSize": "500",
+			"page":     strconv.Itoa(page),
+		}
+
+		res, err := c.c.R().
+			SetContext(ctx).
+			SetQueryParams(queryParams).
+			Get("/investments")
+		if err != nil {
+			return nil, errs.New(err)
+		}
+		body := res.Body()
+		if res.IsError() {
+			return nil, errs.New(body)
+		}
+
+		investmentsRes := InvestmentsResponse{}
+		if err := json.Unmarshal(body, &investmentsRes); err != nil {
+			return nil, errs.New(err)
+		}
+
+		results = append(results, investmentsRes.Results...)
+
+		if int64(page) >= investmentsRes.TotalPages {
+			break
+		}
+	}
+
+	return ParseInvestmentsResults(results), nil
+}
+
+func ParseInvestmentsResults(
+	results []InvestmentsResult,
+) []openfinance.Investment {
+	investments := []openfinance.Investment{}
+	for _, r := range results {
+		investments = append(investments, openfinance.Investment{
+			Investment: entity.Investment{
+				ExternalID: r.ID,
+				Name:       r.Name,
+				Type:       r.Type,
+				Subtype:    r.Subtype,
+			},
+			Balance: money.ToCents(r.Balance),
+		})
+	}
+
+	return investments
+}
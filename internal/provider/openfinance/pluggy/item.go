@@ -0,0 +1,29 @@
+package pluggy
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+)
+
+func (c *Client) DeleteItem(
+	ctx context.Context,
+	itemID string,
+) error {
+	if err := c.refreshAccessToken(ctx); err != nil {
+		return errs.New(err)
+	}
+
+	res, err := c.c.R().
+		SetContext(ctx).
+		SetPathParam("id", itemID).
+		Delete("/items/{id}")
+	if err != nil {
+		return errs.New(err)
+	}
+	if res.IsError() {
+		return errs.New(res.Body())
+	}
+
+	return nil
+}
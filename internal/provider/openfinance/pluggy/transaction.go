@@ -1,6 +1,7 @@
 package pluggy
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"log/slog"
@@ -195,9 +196,42 @@ func (c *Client) ListTransactions(
 		})
 	}
 
+	return ParseTransactionsResults(accountID, allTransactions.Results), nil
+}
+
+func (c *Client) fetchTransactions(
+	ctx context.Context,
+	queryParams map[string]string,
+) (*TransactionsResponse, error) {
+	res, err := c.c.R().
+		SetContext(ctx).
+		SetQueryParams(queryParams).
+		Get("/transactions")
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	body := res.Body()
+	if res.IsError() {
+		return nil, errs.New(body)
+	}
+
+	transRes := new(TransactionsResponse)
+	if err := json.Unmarshal(body, transRes); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return transRes, nil
+}
+
+// ParseTransactionsResults converts the transactions of an account into open
+// finance transactions, skipping the ones that cannot be parsed.
+func ParseTransactionsResults(
+	accountID string,
+	results []Result,
+) []openfinance.Transaction {
 	transactions := []openfinance.Transaction{}
-	for _, t := range allTransactions.Results {
-		transaction, err := c.parseTransactionResultToEntity(t)
+	for _, t := range results {
+		transaction, err := parseTransactionResultToEntity(t)
 		if err != nil {
 			slog.Error(
 				"openfinance-list-transactions: error parsing transaction result to entity",
@@ -225,34 +259,10 @@ func (c *Client) ListTransactions(
 		transactions = append(transactions, *transaction)
 	}
 
-	return transactions, nil
+	return transactions
 }
 
-func (c *Client) fetchTransactions(
-	ctx context.Context,
-	queryParams map[string]string,
-) (*TransactionsResponse, error) {
-	res, err := c.c.R().
-		SetContext(ctx).
-		SetQueryParams(queryParams).
-		Get("/transactions")
-	if err != nil {
-		return nil, errs.New(err)
-	}
-	body := res.Body()
-	if res.IsError() {
-		return nil, errs.New(body)
-	}
-
-	transRes := new(TransactionsResponse)
-	if err := json.Unmarshal(body, transRes); err != nil {
-		return nil, errs.New(err)
-	}
-
-	return transRes, nil
-}
-
-func (c *Client) parseTransactionResultToEntity(
+func parseTransactionResultToEntity(
 	r Result,
 ) (*openfinance.Transaction, error) {
 	transaction := openfinance.Transaction{
@@ -260,21 +270,26 @@ func (c *Client) parseTransactionResultToEntity(
 			ExternalID: &r.ID,
 			Name:       r.Description,
 			Date:       r.Date,
+			Status:     cmp.Or(r.Status, Posted),
 		},
 	}
 
-	c.setTransactionCategory(&transaction, r)
+	setTransactionCategory(&transaction, r)
 
-	if err := c.setTransactionAmount(&transaction, r); err != nil {
+	if err := setTransactionAmount(&transaction, r); err != nil {
 		return nil, errs.New(err)
 	}
 
-	c.setTransactionPaymentMethod(&transaction, r)
+	setTransactionPaymentMethod(&transaction, r)
+
+	setTransactionCreditCardMetadata(&transaction, r)
+
+	setTransactionMerchantData(&transaction, r)
 
 	return &transaction, nil
 }
 
-func (c *Client) setTransactionCategory(
+func setTransactionCategory(
 	t *openfinance.Transaction,
 	r Result,
 ) {
@@ -299,7 +314,7 @@ func (c *Client) setTransactionCategory(
 	}
 }
 
-func (c *Client) setTransactionAmount(
+func setTransactionAmount(
 	t *openfinance.Transaction,
 	r Result,
 ) error {
@@ -317,7 +332,7 @@ func (c *Client) setTransactionAmount(
 	return errs.New("amount is 0")
 }
 
-func (c *Client) setTransactionPaymentMethod(
+func setTransactionPaymentMethod(
 	t *openfinance.Transaction,
 	r Result,
 ) {
@@ -345,3 +360,54 @@ func (c *Client) setTransactionPaymentMethod(
 
 	t.PaymentMethodExternalID = PaymentMethodOther
 }
+
+func setTransactionCreditCardMetadata(
+	t *openfinance.Transaction,
+	r Result,
+) {
+	if r.CreditCardMetadata == nil {
+		return
+	}
+
+	m := r.CreditCardMetadata
+	t.BillExternalID = m.BillID
+	t.PurchaseDate = m.PurchaseDate
+
+	if m.TotalInstallments != nil && m.InstallmentNumber != nil &&
+		*m.TotalInstallments > 1 {
+		t.TotalInstallments = ptr.New(int32(*m.TotalInstallments))
+		t.InstallmentNumber = ptr.New(int32(*m.InstallmentNumber))
+	}
+}
+
+func setTransactionMerchantData(
+	t *openfinance.Transaction,
+	r Result,
+) {
+	t.RawName = r.DescriptionRaw
+
+	if r.CreditCardMetadata != nil && r.CreditCardMetadata.PayeeMCC != nil {
+		t.MCC = ptr.New(int32(*r.CreditCardMetadata.PayeeMCC))
+	}
+
+	if r.PaymentData == nil {
+		return
+	}
+
+	// The counterparty receives the money on debits and sends it on credits
+	counterparty := r.PaymentData.Receiver
+	if r.Type == Credit {
+		counterparty = r.PaymentData.Payer
+	}
+
+	// People are left out, since banks often report the account owner
+	// as the counterparty of their own operations.
+	if counterparty == nil ||
+		counterparty.DocumentNumber == nil ||
+		counterparty.DocumentNumber.Type != DocumentTypeCNPJ {
+		return
+	}
+
+	t.CounterpartyName = counterparty.Name
+	t.CounterpartyDocument = &counterparty.DocumentNumber.Value
+}
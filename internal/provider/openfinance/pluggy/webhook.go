@@ -0,0 +1,12 @@
+package pluggy
+
+type WebhookEvent = string
+
+const (
+	WebhookEventItemCreated          WebhookEvent = "item/created"
+	WebhookEventItemUpdated          WebhookEvent = "item/updated"
+	WebhookEventItemLoginError       WebhookEvent = "item/login_error"
+	WebhookEventItemWaitingUserInput WebhookEvent = "item/waiting_user_input"
+	WebhookEventItemDeleted          WebhookEvent = "item/deleted"
+	WebhookEventItemConsentRevoked   WebhookEvent = "item/consent_revoked"
+)
@@ -10,11 +10,17 @@ import (
 type AccountOptions struct {
 	Limit                uint                 `json:"limit"`
 	Offset               uint                 `json:"offset"`
+	IDs                  []uuid.UUID          `json:"ids"`
 	UserIDs              []uuid.UUID          `json:"user_id"`
+	UserInstitutionIDs   []uuid.UUID          `json:"user_institution_ids"`
 	ExternalIDs          []string             `json:"external_ids"`
 	UserTiers            []entity.Tier        `json:"user_tiers"`
 	Types                []entity.AccountType `json:"types"`
 	IsSubscriptionActive *bool                `json:"is_subscription_active"`
+
+	// IsManual filters the accounts created by the user, which have no
+	// institution connection and are not synced.
+	IsManual *bool `json:"is_manual"`
 }
 
 type AccountRepo interface {
@@ -30,4 +36,24 @@ type AccountRepo interface {
 		ctx context.Context,
 		params []CreateAccountsParams,
 	) error
+	DeleteAccountsByUserInstitutionID(
+		ctx context.Context,
+		userInstitutionID uuid.UUID,
+	) error
+	ListManualAccounts(
+		ctx context.Context,
+		opts ...AccountOptions,
+	) ([]entity.ManualAccount, error)
+	CreateAccount(
+		ctx context.Context,
+		params CreateAccountParams,
+	) (*entity.Account, error)
+	UpdateAccount(
+		ctx context.Context,
+		params UpdateAccountParams,
+	) error
+	DeleteAccount(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
 }
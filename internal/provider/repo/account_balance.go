@@ -4,11 +4,15 @@ import (
 	"context"
 	"time"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/google/uuid"
 )
 
 type AccountBalanceOptions struct {
 	InstitutionIDs []uuid.UUID `json:"institution_ids"`
+	// AccountIDs filters manual accounts along with the ones of
+	// InstitutionIDs.
+	AccountIDs []uuid.UUID `json:"account_ids"`
 }
 
 type AccountBalanceRepo interface {
@@ -22,4 +26,11 @@ type AccountBalanceRepo interface {
 		date time.Time,
 		opts ...AccountBalanceOptions,
 	) (int64, error)
+	ListUserBalanceHistory(
+		ctx context.Context,
+		userID uuid.UUID,
+		startDate, endDate time.Time,
+		interval entity.BalanceInterval,
+		opts ...AccountBalanceOptions,
+	) ([]entity.AccountBalancePoint, error)
 }
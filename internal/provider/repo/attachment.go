@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type AttachmentRepo interface {
+	CreateAttachment(
+		ctx context.Context,
+		params CreateAttachmentParams,
+	) (*entity.Attachment, error)
+	DeleteAttachment(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	DeleteAttachmentsByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) error
+	GetAttachmentByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Attachment, error)
+	ListAttachmentsByTransactionID(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) ([]entity.Attachment, error)
+	ListAttachmentsByTransactionDeletedBefore(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) ([]entity.Attachment, error)
+	ListAttachmentsByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.Attachment, error)
+	SumAttachmentsSizeByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) (int64, error)
+}
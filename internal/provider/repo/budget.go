@@ -33,5 +33,9 @@ type BudgetRepo interface {
 		ctx context.Context,
 		budgetID uuid.UUID,
 	) ([]entity.BudgetCategory, []entity.TransactionCategory, error)
+	MergeBudgetCategories(
+		ctx context.Context,
+		params MergeBudgetCategoriesParams,
+	) error
 	UpdateBudget(ctx context.Context, params UpdateBudgetParams) error
 }
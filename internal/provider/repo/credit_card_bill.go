@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type CreditCardBillRepo interface {
+	UpsertCreditCardBill(
+		ctx context.Context,
+		params UpsertCreditCardBillParams,
+	) (*entity.CreditCardBill, error)
+	ListCreditCardBillsByAccountID(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) ([]entity.CreditCardBill, error)
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type GoalRepo interface {
+	CreateGoal(
+		ctx context.Context,
+		params CreateGoalParams,
+	) (*entity.Goal, error)
+	DeleteGoal(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	GetGoalByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Goal, error)
+	ListGoalsByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.Goal, error)
+	UpdateGoal(
+		ctx context.Context,
+		params UpdateGoalParams,
+	) error
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type GoalContributionRepo interface {
+	CreateGoalContribution(
+		ctx context.Context,
+		params CreateGoalContributionParams,
+	) (*entity.GoalContribution, error)
+	DeleteGoalContribution(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	GetGoalContributionByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.GoalContribution, error)
+	ListGoalContributionsByGoalID(
+		ctx context.Context,
+		goalID uuid.UUID,
+	) ([]entity.GoalContribution, error)
+	SumGoalContributionsByGoalID(
+		ctx context.Context,
+		goalID uuid.UUID,
+	) (int64, error)
+}
@@ -19,6 +19,10 @@ type InstitutionRepo interface {
 		ctx context.Context,
 		opts ...InstitutionOptions,
 	) ([]entity.Institution, error)
+	ListFullInstitutions(
+		ctx context.Context,
+		opts ...InstitutionOptions,
+	) ([]entity.FullInstitution, error)
 	CountInstitutions(
 		ctx context.Context,
 		opts ...InstitutionOptions,
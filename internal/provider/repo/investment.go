@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type InvestmentOptions struct {
+	UserIDs            []uuid.UUID `json:"user_ids"`
+	UserInstitutionIDs []uuid.UUID `json:"user_institution_ids"`
+	ExternalIDs        []string    `json:"external_ids"`
+}
+
+type InvestmentRepo interface {
+	ListInvestments(
+		ctx context.Context,
+		opts ...InvestmentOptions,
+	) ([]entity.Investment, error)
+	ListFullInvestments(
+		ctx context.Context,
+		opts ...InvestmentOptions,
+	) ([]entity.FullInvestment, error)
+	UpsertInvestment(
+		ctx context.Context,
+		params UpsertInvestmentParams,
+	) (*entity.Investment, error)
+	DeleteInvestmentsNotInExternalIDs(
+		ctx context.Context,
+		params DeleteInvestmentsNotInExternalIDsParams,
+	) error
+}
@@ -0,0 +1,12 @@
+package repo
+
+import (
+	"context"
+)
+
+type InvestmentPositionRepo interface {
+	CreateInvestmentPositions(
+		ctx context.Context,
+		params []CreateInvestmentPositionsParams,
+	) error
+}
@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+)
+
+type JobRunRepo interface {
+	CreateJobRun(
+		ctx context.Context,
+		job string,
+	) (*entity.JobRun, error)
+	FinishJobRun(
+		ctx context.Context,
+		params FinishJobRunParams,
+	) error
+}
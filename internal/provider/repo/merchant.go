@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type MerchantRepo interface {
+	ListMerchantsByIDs(
+		ctx context.Context,
+		ids []uuid.UUID,
+	) ([]entity.Merchant, error)
+	UpsertMerchant(
+		ctx context.Context,
+		params UpsertMerchantParams,
+	) (*entity.Merchant, error)
+}
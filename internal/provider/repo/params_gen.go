@@ -10,12 +10,28 @@ import (
 	"github.com/google/uuid"
 )
 
+type CreateAccountParams struct {
+	ID             uuid.UUID `json:"id"`
+	ExternalID     string    `json:"external_id"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	UserID         uuid.UUID `json:"user_id"`
+	OpeningBalance int64     `json:"opening_balance"`
+}
+
 type CreateAccountsParams struct {
-	ID                uuid.UUID `json:"id"`
-	ExternalID        string    `json:"external_id"`
-	Name              string    `json:"name"`
-	Type              string    `json:"type"`
-	UserInstitutionID uuid.UUID `json:"user_institution_id"`
+	ID                uuid.UUID  `json:"id"`
+	ExternalID        string     `json:"external_id"`
+	Name              string     `json:"name"`
+	Type              string     `json:"type"`
+	UserInstitutionID *uuid.UUID `json:"user_institution_id"`
+	UserID            uuid.UUID  `json:"user_id"`
+}
+
+type UpdateAccountParams struct {
+	ID             uuid.UUID `json:"id"`
+	Name           string    `json:"name"`
+	OpeningBalance int64     `json:"opening_balance"`
 }
 
 type CreateAccountBalancesParams struct {
@@ -49,6 +65,16 @@ type CreateAIChatMessageParams struct {
 	AiChatID uuid.UUID `json:"ai_chat_id"`
 }
 
+type CreateAttachmentParams struct {
+	ID            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"storage_key"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	UserID        uuid.UUID `json:"user_id"`
+}
+
 type CreateBudgetParams struct {
 	Amount int64     `json:"amount"`
 	Date   time.Time `json:"date"`
@@ -77,42 +103,269 @@ type GetBudgetCategoryParams struct {
 	Date   time.Time `json:"date"`
 }
 
+type MergeBudgetCategoriesParams struct {
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+}
+
+type MoveBudgetCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+}
+
+type UpsertCreditCardBillParams struct {
+	ExternalID  string     `json:"external_id"`
+	ClosingDate *time.Time `json:"closing_date"`
+	DueDate     time.Time  `json:"due_date"`
+	TotalAmount int64      `json:"total_amount"`
+	AccountID   uuid.UUID  `json:"account_id"`
+}
+
 type CreateFeedbackParams struct {
 	Message string     `json:"message"`
 	UserID  *uuid.UUID `json:"user_id"`
 }
 
+type CreateGoalParams struct {
+	Name                string     `json:"name"`
+	TargetAmount        int64      `json:"target_amount"`
+	Deadline            time.Time  `json:"deadline"`
+	Interest            int64      `json:"interest"`
+	MonthlyContribution int64      `json:"monthly_contribution"`
+	AccountID           *uuid.UUID `json:"account_id"`
+	UserID              uuid.UUID  `json:"user_id"`
+}
+
+type UpdateGoalParams struct {
+	ID                  uuid.UUID  `json:"id"`
+	Name                string     `json:"name"`
+	TargetAmount        int64      `json:"target_amount"`
+	Deadline            time.Time  `json:"deadline"`
+	Interest            int64      `json:"interest"`
+	MonthlyContribution int64      `json:"monthly_contribution"`
+	AccountID           *uuid.UUID `json:"account_id"`
+}
+
+type CreateGoalContributionParams struct {
+	Amount int64     `json:"amount"`
+	Date   time.Time `json:"date"`
+	GoalID uuid.UUID `json:"goal_id"`
+}
+
+type HideCategoryParams struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	CategoryID   uuid.UUID  `json:"category_id"`
+	MergedIntoID *uuid.UUID `json:"merged_into_id"`
+}
+
+type MergeHiddenCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+}
+
+type UnhideCategoryParams struct {
+	UserID     uuid.UUID `json:"user_id"`
+	CategoryID uuid.UUID `json:"category_id"`
+}
+
 type CreateInstitutionsParams struct {
 	ExternalID string  `json:"external_id"`
 	Name       string  `json:"name"`
 	Logo       *string `json:"logo"`
 }
 
+type DeleteInvestmentsNotInExternalIDsParams struct {
+	UserInstitutionID uuid.UUID `json:"user_institution_id"`
+	ExternalIds       []string  `json:"external_ids"`
+}
+
+type UpsertInvestmentParams struct {
+	ExternalID        string    `json:"external_id"`
+	Name              string    `json:"name"`
+	Type              string    `json:"type"`
+	Subtype           *string   `json:"subtype"`
+	UserInstitutionID uuid.UUID `json:"user_institution_id"`
+}
+
+type CreateInvestmentPositionsParams struct {
+	Amount       int64     `json:"amount"`
+	InvestmentID uuid.UUID `json:"investment_id"`
+}
+
+type FinishJobRunParams struct {
+	ID                uuid.UUID `json:"id"`
+	AccountsProcessed int64     `json:"accounts_processed"`
+	ErrorMessage      *string   `json:"error_message"`
+}
+
+type UpsertMerchantParams struct {
+	Key      string  `json:"key"`
+	Name     string  `json:"name"`
+	Document *string `json:"document"`
+	Mcc      *int32  `json:"mcc"`
+	Logo     *string `json:"logo"`
+}
+
 type CreatePaymentMethodsParams struct {
 	ExternalID string `json:"external_id"`
 	Name       string `json:"name"`
 }
 
-type CreateTransactionParams struct {
+type DeleteRecurringSeriesExceptParams struct {
+	UserID uuid.UUID   `json:"user_id"`
+	Ids    []uuid.UUID `json:"ids"`
+}
+
+type UpsertRecurringSeriesParams struct {
 	Name            string    `json:"name"`
+	NormalizedName  string    `json:"normalized_name"`
+	Frequency       string    `json:"frequency"`
 	Amount          int64     `json:"amount"`
-	PaymentMethodID uuid.UUID `json:"payment_method_id"`
-	Date            time.Time `json:"date"`
+	PreviousAmount  *int64    `json:"previous_amount"`
+	AverageAmount   int64     `json:"average_amount"`
+	Occurrences     int32     `json:"occurrences"`
+	FirstDate       time.Time `json:"first_date"`
+	LastDate        time.Time `json:"last_date"`
+	NextDate        time.Time `json:"next_date"`
 	UserID          uuid.UUID `json:"user_id"`
+	PaymentMethodID uuid.UUID `json:"payment_method_id"`
 	CategoryID      uuid.UUID `json:"category_id"`
 }
 
-type CreateTransactionsParams struct {
-	ExternalID      *string    `json:"external_id"`
+type CreateRuleParams struct {
+	Name            string     `json:"name"`
+	Priority        int32      `json:"priority"`
+	NameMatchType   *string    `json:"name_match_type"`
+	NamePattern     *string    `json:"name_pattern"`
+	MinAmount       *int64     `json:"min_amount"`
+	MaxAmount       *int64     `json:"max_amount"`
+	Direction       *string    `json:"direction"`
+	RenameTo        *string    `json:"rename_to"`
+	IsIgnored       *bool      `json:"is_ignored"`
+	UserID          uuid.UUID  `json:"user_id"`
+	PaymentMethodID *uuid.UUID `json:"payment_method_id"`
+	InstitutionID   *uuid.UUID `json:"institution_id"`
+	CategoryID      *uuid.UUID `json:"category_id"`
+	TagID           *uuid.UUID `json:"tag_id"`
+}
+
+type MergeRuleCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+}
+
+type UpdateRuleParams struct {
+	ID              uuid.UUID  `json:"id"`
+	Name            string     `json:"name"`
+	Priority        int32      `json:"priority"`
+	NameMatchType   *string    `json:"name_match_type"`
+	NamePattern     *string    `json:"name_pattern"`
+	MinAmount       *int64     `json:"min_amount"`
+	MaxAmount       *int64     `json:"max_amount"`
+	Direction       *string    `json:"direction"`
+	RenameTo        *string    `json:"rename_to"`
+	IsIgnored       *bool      `json:"is_ignored"`
+	PaymentMethodID *uuid.UUID `json:"payment_method_id"`
+	InstitutionID   *uuid.UUID `json:"institution_id"`
+	CategoryID      *uuid.UUID `json:"category_id"`
+	TagID           *uuid.UUID `json:"tag_id"`
+}
+
+type CreateSyncRunItemsParams struct {
+	SyncRunID     uuid.UUID `json:"sync_run_id"`
+	AccountID     uuid.UUID `json:"account_id"`
+	Status        string    `json:"status"`
+	Fetched       int64     `json:"fetched"`
+	Inserted      int64     `json:"inserted"`
+	Updated       int64     `json:"updated"`
+	Skipped       int64     `json:"skipped"`
+	Deleted       int64     `json:"deleted"`
+	FailureReason *string   `json:"failure_reason"`
+}
+
+type CreateTagParams struct {
+	Name   string    `json:"name"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type UpdateTagParams struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type ApplyTransactionRulesParams struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	CategoryID uuid.UUID `json:"category_id"`
+	IsIgnored  bool      `json:"is_ignored"`
+}
+
+type CreateTransactionParams struct {
 	Name            string     `json:"name"`
 	Amount          int64      `json:"amount"`
 	PaymentMethodID uuid.UUID  `json:"payment_method_id"`
 	Date            time.Time  `json:"date"`
 	UserID          uuid.UUID  `json:"user_id"`
-	AccountID       *uuid.UUID `json:"account_id"`
-	InstitutionID   *uuid.UUID `json:"institution_id"`
 	CategoryID      uuid.UUID  `json:"category_id"`
 	IsIgnored       bool       `json:"is_ignored"`
+	Notes           *string    `json:"notes"`
+	AccountID       *uuid.UUID `json:"account_id"`
+}
+
+type CreateTransactionsParams struct {
+	ExternalID        *string    `json:"external_id"`
+	Name              string     `json:"name"`
+	Amount            int64      `json:"amount"`
+	PaymentMethodID   uuid.UUID  `json:"payment_method_id"`
+	Date              time.Time  `json:"date"`
+	UserID            uuid.UUID  `json:"user_id"`
+	AccountID         *uuid.UUID `json:"account_id"`
+	InstitutionID     *uuid.UUID `json:"institution_id"`
+	CategoryID        uuid.UUID  `json:"category_id"`
+	IsIgnored         bool       `json:"is_ignored"`
+	PurchaseDate      *time.Time `json:"purchase_date"`
+	InstallmentNumber *int32     `json:"installment_number"`
+	TotalInstallments *int32     `json:"total_installments"`
+	BillID            *uuid.UUID `json:"bill_id"`
+	Status            string     `json:"status"`
+	MerchantID        *uuid.UUID `json:"merchant_id"`
+}
+
+type ListDeletedTransactionsParams struct {
+	UserID    uuid.UUID  `json:"user_id"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+type ListTransactionExternalIDsParams struct {
+	UserID      uuid.UUID `json:"user_id"`
+	ExternalIds []string  `json:"external_ids"`
+}
+
+type MergeTransactionCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+}
+
+type ReconcileTransactionParams struct {
+	ID                uuid.UUID  `json:"id"`
+	ExternalID        *string    `json:"external_id"`
+	Name              string     `json:"name"`
+	Amount            int64      `json:"amount"`
+	Date              time.Time  `json:"date"`
+	Status            string     `json:"status"`
+	PurchaseDate      *time.Time `json:"purchase_date"`
+	InstallmentNumber *int32     `json:"installment_number"`
+	TotalInstallments *int32     `json:"total_installments"`
+	BillID            *uuid.UUID `json:"bill_id"`
+	PaymentMethodID   uuid.UUID  `json:"payment_method_id"`
+	CategoryID        uuid.UUID  `json:"category_id"`
+	IsIgnored         bool       `json:"is_ignored"`
+	MerchantID        *uuid.UUID `json:"merchant_id"`
 }
 
 type UpdateTransactionParams struct {
@@ -125,11 +378,86 @@ type UpdateTransactionParams struct {
 	InstitutionID   *uuid.UUID `json:"institution_id"`
 	CategoryID      uuid.UUID  `json:"category_id"`
 	UserID          uuid.UUID  `json:"user_id"`
+	Notes           *string    `json:"notes"`
+}
+
+type UpdateTransactionsCategoryParams struct {
+	CategoryID uuid.UUID   `json:"category_id"`
+	Ids        []uuid.UUID `json:"ids"`
+	UserID     uuid.UUID   `json:"user_id"`
+}
+
+type UpdateTransactionsIsIgnoredParams struct {
+	IsIgnored bool        `json:"is_ignored"`
+	Ids       []uuid.UUID `json:"ids"`
+	UserID    uuid.UUID   `json:"user_id"`
 }
 
 type CreateTransactionCategoriesParams struct {
-	ExternalID string `json:"external_id"`
-	Name       string `json:"name"`
+	ExternalID *string `json:"external_id"`
+	Name       string  `json:"name"`
+}
+
+type CreateTransactionCategoryParams struct {
+	Name     string     `json:"name"`
+	Icon     *string    `json:"icon"`
+	Color    *string    `json:"color"`
+	UserID   *uuid.UUID `json:"user_id"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+type UpdateTransactionCategoryParams struct {
+	ID       uuid.UUID  `json:"id"`
+	Name     string     `json:"name"`
+	Icon     *string    `json:"icon"`
+	Color    *string    `json:"color"`
+	ParentID *uuid.UUID `json:"parent_id"`
+}
+
+type CreateTransactionSplitsParams struct {
+	Amount        int64     `json:"amount"`
+	Note          *string   `json:"note"`
+	TransactionID uuid.UUID `json:"transaction_id"`
+	CategoryID    uuid.UUID `json:"category_id"`
+}
+
+type MergeTransactionSplitCategoriesParams struct {
+	TargetCategoryID uuid.UUID `json:"target_category_id"`
+	SourceCategoryID uuid.UUID `json:"source_category_id"`
+	UserID           uuid.UUID `json:"user_id"`
+}
+
+type CreateTransactionTagsParams struct {
+	TransactionID uuid.UUID `json:"transaction_id"`
+	TagID         uuid.UUID `json:"tag_id"`
+}
+
+type TagTransactionsParams struct {
+	TransactionIds []uuid.UUID `json:"transaction_ids"`
+	TagID          uuid.UUID   `json:"tag_id"`
+}
+
+type TagTransactionsByExternalIDsParams struct {
+	TagID       uuid.UUID `json:"tag_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	ExternalIds []string  `json:"external_ids"`
+}
+
+type UntagTransactionsParams struct {
+	TransactionIds []uuid.UUID `json:"transaction_ids"`
+	TagID          uuid.UUID   `json:"tag_id"`
+}
+
+type CreateTransfersParams struct {
+	Status                string    `json:"status"`
+	UserID                uuid.UUID `json:"user_id"`
+	OutgoingTransactionID uuid.UUID `json:"outgoing_transaction_id"`
+	IncomingTransactionID uuid.UUID `json:"incoming_transaction_id"`
+}
+
+type UpdateTransferStatusParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
 }
 
 type CreateUserParams struct {
@@ -183,3 +511,8 @@ type CreateUserInstitutionParams struct {
 	UserID        uuid.UUID `json:"user_id"`
 	InstitutionID uuid.UUID `json:"institution_id"`
 }
+
+type UpdateUserInstitutionStatusParams struct {
+	ID     uuid.UUID `json:"id"`
+	Status string    `json:"status"`
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/query"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 )
 
@@ -27,6 +28,65 @@ func NewAccountRepo(
 	}
 }
 
+func (r *AccountRepo) CreateAccount(
+	ctx context.Context,
+	params repo.CreateAccountParams,
+) (*entity.Account, error) {
+	dbParams := sqlc.CreateAccountParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	account, err := tx.CreateAccount(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	result := entity.Account{}
+	if err := copier.Copy(&result, account); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *AccountRepo) UpdateAccount(
+	ctx context.Context,
+	params repo.UpdateAccountParams,
+) error {
+	dbParams := sqlc.UpdateAccountParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateAccount(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *AccountRepo) DeleteAccount(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteAccount(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *AccountRepo) ListManualAccounts(
+	ctx context.Context,
+	opts ...repo.AccountOptions,
+) ([]entity.ManualAccount, error) {
+	return r.qb.ListManualAccounts(ctx, opts...)
+}
+
 func (r *AccountRepo) ListAccounts(
 	ctx context.Context,
 	opts ...repo.AccountOptions,
@@ -59,4 +119,19 @@ func (r *AccountRepo) CreateAccounts(
 	return nil
 }
 
+func (r *AccountRepo) DeleteAccountsByUserInstitutionID(
+	ctx context.Context,
+	userInstitutionID uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteAccountsByUserInstitutionID(
+		ctx,
+		userInstitutionID,
+	); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
 var _ repo.AccountRepo = (*AccountRepo)(nil)
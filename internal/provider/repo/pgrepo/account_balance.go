@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
@@ -48,4 +49,21 @@ func (r *AccountBalanceRepo) GetUserBalanceOnDate(
 	return r.db.GetUserBalanceOnDate(ctx, userID, date, opts...)
 }
 
+func (r *AccountBalanceRepo) ListUserBalanceHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate time.Time,
+	interval entity.BalanceInterval,
+	opts ...repo.AccountBalanceOptions,
+) ([]entity.AccountBalancePoint, error) {
+	return r.db.ListUserBalanceHistory(
+		ctx,
+		userID,
+		startDate,
+		endDate,
+		interval,
+		opts...,
+	)
+}
+
 var _ repo.AccountBalanceRepo = (*AccountBalanceRepo)(nil)
@@ -0,0 +1,160 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type AttachmentRepo struct {
+	db *db.DB
+}
+
+func NewAttachmentRepo(
+	db *db.DB,
+) *AttachmentRepo {
+	return &AttachmentRepo{
+		db: db,
+	}
+}
+
+func (r *AttachmentRepo) CreateAttachment(
+	ctx context.Context,
+	params repo.CreateAttachmentParams,
+) (*entity.Attachment, error) {
+	dbParams := sqlc.CreateAttachmentParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	attachment, err := tx.CreateAttachment(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.Attachment
+	if err := copier.Copy(&result, attachment); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *AttachmentRepo) DeleteAttachment(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteAttachment(ctx, id); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *AttachmentRepo) DeleteAttachmentsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteAttachmentsByUserID(ctx, userID); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *AttachmentRepo) GetAttachmentByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Attachment, error) {
+	attachment, err := r.db.GetAttachmentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.Attachment
+	if err := copier.Copy(&result, attachment); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *AttachmentRepo) ListAttachmentsByTransactionID(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) ([]entity.Attachment, error) {
+	attachments, err := r.db.ListAttachmentsByTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.Attachment
+	if err := copier.Copy(&results, attachments); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *AttachmentRepo) ListAttachmentsByTransactionDeletedBefore(
+	ctx context.Context,
+	deletedBefore time.Time,
+) ([]entity.Attachment, error) {
+	attachments, err := r.db.ListAttachmentsByTransactionDeletedBefore(
+		ctx,
+		&deletedBefore,
+	)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.Attachment
+	if err := copier.Copy(&results, attachments); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *AttachmentRepo) ListAttachmentsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.Attachment, error) {
+	attachments, err := r.db.ListAttachmentsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.Attachment
+	if err := copier.Copy(&results, attachments); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *AttachmentRepo) SumAttachmentsSizeByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) (int64, error) {
+	size, err := r.db.SumAttachmentsSizeByUserID(ctx, userID)
+	if err != nil {
+		return 0, errs.New(err)
+	}
+	return size, nil
+}
+
+var _ repo.AttachmentRepo = (*AttachmentRepo)(nil)
@@ -167,6 +167,35 @@ func (r *BudgetRepo) ListBudgetCategories(
 	return budgetCategories, categories, nil
 }
 
+// MergeBudgetCategories moves the budget categories of the source category
+// to the target one. Budgets that already have the target category get the
+// source amount added to it instead.
+func (r *BudgetRepo) MergeBudgetCategories(
+	ctx context.Context,
+	params repo.MergeBudgetCategoriesParams,
+) error {
+	mergeParams := sqlc.MergeBudgetCategoriesParams{}
+	if err := copier.Copy(&mergeParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	moveParams := sqlc.MoveBudgetCategoriesParams{}
+	if err := copier.Copy(&moveParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.MergeBudgetCategories(ctx, mergeParams); err != nil {
+		return errs.New(err)
+	}
+
+	if err := tx.MoveBudgetCategories(ctx, moveParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
 func (r *BudgetRepo) UpdateBudget(
 	ctx context.Context,
 	params repo.UpdateBudgetParams,
@@ -0,0 +1,65 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type CreditCardBillRepo struct {
+	db *db.DB
+}
+
+func NewCreditCardBillRepo(db *db.DB) *CreditCardBillRepo {
+	return &CreditCardBillRepo{
+		db: db,
+	}
+}
+
+func (r *CreditCardBillRepo) UpsertCreditCardBill(
+	ctx context.Context,
+	params repo.UpsertCreditCardBillParams,
+) (*entity.CreditCardBill, error) {
+	dbParams := sqlc.UpsertCreditCardBillParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	bill, err := tx.UpsertCreditCardBill(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	result := entity.CreditCardBill{}
+	if err := copier.Copy(&result, bill); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *CreditCardBillRepo) ListCreditCardBillsByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+) ([]entity.CreditCardBill, error) {
+	bills, err := r.db.ListCreditCardBillsByAccountID(ctx, accountID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	results := []entity.CreditCardBill{}
+	if err := copier.Copy(&results, bills); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+var _ repo.CreditCardBillRepo = (*CreditCardBillRepo)(nil)
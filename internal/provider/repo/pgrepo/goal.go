@@ -0,0 +1,120 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type GoalRepo struct {
+	db *db.DB
+}
+
+func NewGoalRepo(
+	db *db.DB,
+) *GoalRepo {
+	return &GoalRepo{
+		db: db,
+	}
+}
+
+func (r *GoalRepo) CreateGoal(
+	ctx context.Context,
+	params repo.CreateGoalParams,
+) (*entity.Goal, error) {
+	dbParams := sqlc.CreateGoalParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	goal, err := tx.CreateGoal(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.Goal
+	if err := copier.Copy(&result, goal); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+// DeleteGoal soft deletes the goal along with its contributions.
+func (r *GoalRepo) DeleteGoal(ctx context.Context, id uuid.UUID) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteGoal(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	if err := tx.DeleteGoalContributionsByGoalID(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *GoalRepo) GetGoalByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Goal, error) {
+	goal, err := r.db.GetGoalByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.Goal
+	if err := copier.Copy(&result, goal); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *GoalRepo) ListGoalsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.Goal, error) {
+	goals, err := r.db.ListGoalsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.Goal
+	if err := copier.Copy(&results, goals); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *GoalRepo) UpdateGoal(
+	ctx context.Context,
+	params repo.UpdateGoalParams,
+) error {
+	dbParams := sqlc.UpdateGoalParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateGoal(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.GoalRepo = (*GoalRepo)(nil)
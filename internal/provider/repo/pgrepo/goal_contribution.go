@@ -0,0 +1,111 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type GoalContributionRepo struct {
+	db *db.DB
+}
+
+func NewGoalContributionRepo(
+	db *db.DB,
+) *GoalContributionRepo {
+	return &GoalContributionRepo{
+		db: db,
+	}
+}
+
+func (r *GoalContributionRepo) CreateGoalContribution(
+	ctx context.Context,
+	params repo.CreateGoalContributionParams,
+) (*entity.GoalContribution, error) {
+	dbParams := sqlc.CreateGoalContributionParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	contribution, err := tx.CreateGoalContribution(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.GoalContribution
+	if err := copier.Copy(&result, contribution); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *GoalContributionRepo) DeleteGoalContribution(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteGoalContribution(ctx, id); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *GoalContributionRepo) GetGoalContributionByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.GoalContribution, error) {
+	contribution, err := r.db.GetGoalContributionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.GoalContribution
+	if err := copier.Copy(&result, contribution); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *GoalContributionRepo) ListGoalContributionsByGoalID(
+	ctx context.Context,
+	goalID uuid.UUID,
+) ([]entity.GoalContribution, error) {
+	contributions, err := r.db.ListGoalContributionsByGoalID(ctx, goalID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.GoalContribution
+	if err := copier.Copy(&results, contributions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *GoalContributionRepo) SumGoalContributionsByGoalID(
+	ctx context.Context,
+	goalID uuid.UUID,
+) (int64, error) {
+	total, err := r.db.SumGoalContributionsByGoalID(ctx, goalID)
+	if err != nil {
+		return 0, errs.New(err)
+	}
+	return total, nil
+}
+
+var _ repo.GoalContributionRepo = (*GoalContributionRepo)(nil)
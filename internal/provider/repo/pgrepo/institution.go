@@ -42,6 +42,13 @@ func (r *InstitutionRepo) ListInstitutions(
 	return results, nil
 }
 
+func (r *InstitutionRepo) ListFullInstitutions(
+	ctx context.Context,
+	opts ...repo.InstitutionOptions,
+) ([]entity.FullInstitution, error) {
+	return r.db.ListFullInstitutions(ctx, opts...)
+}
+
 func (r *InstitutionRepo) CountInstitutions(
 	ctx context.Context,
 	opts ...repo.InstitutionOptions,
@@ -0,0 +1,78 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type InvestmentRepo struct {
+	db *db.DB
+}
+
+func NewInvestmentRepo(db *db.DB) *InvestmentRepo {
+	return &InvestmentRepo{
+		db: db,
+	}
+}
+
+func (r *InvestmentRepo) ListInvestments(
+	ctx context.Context,
+	opts ...repo.InvestmentOptions,
+) ([]entity.Investment, error) {
+	return r.db.ListInvestments(ctx, opts...)
+}
+
+func (r *InvestmentRepo) ListFullInvestments(
+	ctx context.Context,
+	opts ...repo.InvestmentOptions,
+) ([]entity.FullInvestment, error) {
+	return r.db.ListFullInvestments(ctx, opts...)
+}
+
+func (r *InvestmentRepo) UpsertInvestment(
+	ctx context.Context,
+	params repo.UpsertInvestmentParams,
+) (*entity.Investment, error) {
+	dbParams := sqlc.UpsertInvestmentParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	investment, err := tx.UpsertInvestment(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	result := entity.Investment{}
+	if err := copier.Copy(&result, investment); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *InvestmentRepo) DeleteInvestmentsNotInExternalIDs(
+	ctx context.Context,
+	params repo.DeleteInvestmentsNotInExternalIDsParams,
+) error {
+	dbParams := sqlc.DeleteInvestmentsNotInExternalIDsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteInvestmentsNotInExternalIDs(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.InvestmentRepo = (*InvestmentRepo)(nil)
@@ -0,0 +1,40 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type InvestmentPositionRepo struct {
+	db *db.DB
+}
+
+func NewInvestmentPositionRepo(db *db.DB) *InvestmentPositionRepo {
+	return &InvestmentPositionRepo{
+		db: db,
+	}
+}
+
+func (r *InvestmentPositionRepo) CreateInvestmentPositions(
+	ctx context.Context,
+	params []repo.CreateInvestmentPositionsParams,
+) error {
+	dbParams := make([]sqlc.CreateInvestmentPositionsParams, len(params))
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if _, err := tx.CreateInvestmentPositions(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.InvestmentPositionRepo = (*InvestmentPositionRepo)(nil)
@@ -0,0 +1,61 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/jinzhu/copier"
+)
+
+type JobRunRepo struct {
+	db *db.DB
+}
+
+func NewJobRunRepo(
+	db *db.DB,
+) *JobRunRepo {
+	return &JobRunRepo{
+		db: db,
+	}
+}
+
+func (r *JobRunRepo) CreateJobRun(
+	ctx context.Context,
+	job string,
+) (*entity.JobRun, error) {
+	tx := r.db.UseTx(ctx)
+	jobRun, err := tx.CreateJobRun(ctx, job)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.JobRun
+	if err := copier.Copy(&result, jobRun); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *JobRunRepo) FinishJobRun(
+	ctx context.Context,
+	params repo.FinishJobRunParams,
+) error {
+	dbParams := sqlc.FinishJobRunParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.FinishJobRun(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.JobRunRepo = (*JobRunRepo)(nil)
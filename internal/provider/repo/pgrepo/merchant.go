@@ -0,0 +1,67 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type MerchantRepo struct {
+	db *db.DB
+}
+
+func NewMerchantRepo(
+	db *db.DB,
+) *MerchantRepo {
+	return &MerchantRepo{
+		db: db,
+	}
+}
+
+func (r *MerchantRepo) ListMerchantsByIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]entity.Merchant, error) {
+	merchants, err := r.db.ListMerchantsByIDs(ctx, ids)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	results := []entity.Merchant{}
+	if err := copier.Copy(&results, merchants); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *MerchantRepo) UpsertMerchant(
+	ctx context.Context,
+	params repo.UpsertMerchantParams,
+) (*entity.Merchant, error) {
+	dbParams := sqlc.UpsertMerchantParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	merchant, err := tx.UpsertMerchant(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	result := entity.Merchant{}
+	if err := copier.Copy(&result, merchant); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+var _ repo.MerchantRepo = (*MerchantRepo)(nil)
@@ -0,0 +1,84 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type RecurringSeriesRepo struct {
+	db *db.DB
+}
+
+func NewRecurringSeriesRepo(
+	db *db.DB,
+) *RecurringSeriesRepo {
+	return &RecurringSeriesRepo{
+		db: db,
+	}
+}
+
+func (r *RecurringSeriesRepo) DeleteRecurringSeriesExcept(
+	ctx context.Context,
+	params repo.DeleteRecurringSeriesExceptParams,
+) error {
+	dbParams := sqlc.DeleteRecurringSeriesExceptParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteRecurringSeriesExcept(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *RecurringSeriesRepo) ListRecurringSeriesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.RecurringSeries, error) {
+	series, err := r.db.ListRecurringSeriesByUserID(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.RecurringSeries
+	if err := copier.Copy(&results, series); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *RecurringSeriesRepo) UpsertRecurringSeries(
+	ctx context.Context,
+	params repo.UpsertRecurringSeriesParams,
+) (*entity.RecurringSeries, error) {
+	dbParams := sqlc.UpsertRecurringSeriesParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	series, err := tx.UpsertRecurringSeries(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.RecurringSeries
+	if err := copier.Copy(&result, series); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+var _ repo.RecurringSeriesRepo = (*RecurringSeriesRepo)(nil)
@@ -0,0 +1,134 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type RuleRepo struct {
+	db *db.DB
+}
+
+func NewRuleRepo(
+	db *db.DB,
+) *RuleRepo {
+	return &RuleRepo{
+		db: db,
+	}
+}
+
+func (r *RuleRepo) CreateRule(
+	ctx context.Context,
+	params repo.CreateRuleParams,
+) (*entity.Rule, error) {
+	dbParams := sqlc.CreateRuleParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	rule, err := tx.CreateRule(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.Rule
+	if err := copier.Copy(&result, rule); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *RuleRepo) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteRule(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *RuleRepo) GetRuleByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Rule, error) {
+	rule, err := r.db.GetRuleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.Rule
+	if err := copier.Copy(&result, rule); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *RuleRepo) ListRules(
+	ctx context.Context,
+	opts ...repo.RuleOptions,
+) ([]entity.Rule, error) {
+	rules, err := r.db.ListRules(ctx, opts...)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return rules, nil
+}
+
+func (r *RuleRepo) CountRules(
+	ctx context.Context,
+	opts ...repo.RuleOptions,
+) (int64, error) {
+	return r.db.CountRules(ctx, opts...)
+}
+
+func (r *RuleRepo) UpdateRule(
+	ctx context.Context,
+	params repo.UpdateRuleParams,
+) error {
+	dbParams := sqlc.UpdateRuleParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateRule(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *RuleRepo) MergeRuleCategories(
+	ctx context.Context,
+	params repo.MergeRuleCategoriesParams,
+) error {
+	dbParams := sqlc.MergeRuleCategoriesParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.MergeRuleCategories(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.RuleRepo = (*RuleRepo)(nil)
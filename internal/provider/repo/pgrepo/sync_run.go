@@ -0,0 +1,84 @@
+package pgrepo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/query"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type SyncRunRepo struct {
+	db *db.DB
+	qb *query.QueryBuilder
+}
+
+func NewSyncRunRepo(
+	db *db.DB,
+	qb *query.QueryBuilder,
+) *SyncRunRepo {
+	return &SyncRunRepo{
+		db: db,
+		qb: qb,
+	}
+}
+
+func (r *SyncRunRepo) CreateSyncRun(
+	ctx context.Context,
+) (*entity.SyncRun, error) {
+	tx := r.db.UseTx(ctx)
+	syncRun, err := tx.CreateSyncRun(ctx)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.SyncRun
+	if err := copier.Copy(&result, syncRun); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *SyncRunRepo) FinishSyncRun(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.FinishSyncRun(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *SyncRunRepo) CreateSyncRunItems(
+	ctx context.Context,
+	params []repo.CreateSyncRunItemsParams,
+) error {
+	dbParams := make([]sqlc.CreateSyncRunItemsParams, len(params))
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if _, err := tx.CreateSyncRunItems(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *SyncRunRepo) ListAccountSyncStatuses(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.AccountSyncStatus, error) {
+	return r.qb.ListAccountSyncStatuses(ctx, userID)
+}
+
+var _ repo.SyncRunRepo = (*SyncRunRepo)(nil)
@@ -0,0 +1,127 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type TagRepo struct {
+	db *db.DB
+}
+
+func NewTagRepo(
+	db *db.DB,
+) *TagRepo {
+	return &TagRepo{
+		db: db,
+	}
+}
+
+func (r *TagRepo) CreateTag(
+	ctx context.Context,
+	params repo.CreateTagParams,
+) (*entity.Tag, error) {
+	dbParams := sqlc.CreateTagParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	tag, err := tx.CreateTag(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.Tag
+	if err := copier.Copy(&result, tag); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+// DeleteTag soft deletes the tag, removes it from the transactions and
+// clears it from the rules that would add it.
+func (r *TagRepo) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteTag(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	if err := tx.DeleteTransactionTagsByTagID(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	if err := tx.RemoveRulesTag(ctx, &id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TagRepo) GetTagByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Tag, error) {
+	tag, err := r.db.GetTagByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.Tag
+	if err := copier.Copy(&result, tag); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *TagRepo) ListTags(
+	ctx context.Context,
+	opts ...repo.TagOptions,
+) ([]entity.Tag, error) {
+	tags, err := r.db.ListTags(ctx, opts...)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepo) CountTags(
+	ctx context.Context,
+	opts ...repo.TagOptions,
+) (int64, error) {
+	return r.db.CountTags(ctx, opts...)
+}
+
+func (r *TagRepo) UpdateTag(
+	ctx context.Context,
+	params repo.UpdateTagParams,
+) error {
+	dbParams := sqlc.UpdateTagParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateTag(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.TagRepo = (*TagRepo)(nil)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
@@ -51,6 +52,15 @@ func (r *TransactionRepo) ListFullTransactions(
 	return transactions, nil
 }
 
+func (r *TransactionRepo) EachFullTransaction(
+	ctx context.Context,
+	userID uuid.UUID,
+	fn func(entity.FullTransaction) error,
+	opts ...repo.TransactionOptions,
+) error {
+	return r.db.EachFullTransaction(ctx, userID, fn, opts...)
+}
+
 func (r *TransactionRepo) CountTransactions(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -75,6 +85,22 @@ func (r *TransactionRepo) SumTransactionsByCategory(
 	return r.db.SumTransactionsByCategory(ctx, userID, opts...)
 }
 
+func (r *TransactionRepo) SumTransactionsByMerchant(
+	ctx context.Context,
+	userID uuid.UUID,
+	opts ...repo.TransactionOptions,
+) (map[uuid.UUID]int64, error) {
+	return r.db.SumTransactionsByMerchant(ctx, userID, opts...)
+}
+
+func (r *TransactionRepo) SumTransactionsByTag(
+	ctx context.Context,
+	userID uuid.UUID,
+	opts ...repo.TransactionOptions,
+) (map[uuid.UUID]int64, error) {
+	return r.db.SumTransactionsByTag(ctx, userID, opts...)
+}
+
 func (r *TransactionRepo) CreateTransactions(
 	ctx context.Context,
 	params []repo.CreateTransactionsParams,
@@ -96,14 +122,43 @@ func (r *TransactionRepo) CreateTransactions(
 func (r *TransactionRepo) CreateTransaction(
 	ctx context.Context,
 	params repo.CreateTransactionParams,
-) error {
+) (*entity.Transaction, error) {
 	var dbParams sqlc.CreateTransactionParams
 	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	transaction, err := tx.CreateTransaction(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var result entity.Transaction
+	if err := copier.Copy(&result, transaction); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *TransactionRepo) DeleteTransactions(
+	ctx context.Context,
+	ids []uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteTransactions(ctx, ids); err != nil {
 		return errs.New(err)
 	}
+	return nil
+}
 
+func (r *TransactionRepo) DeleteTransactionsByAccountID(
+	ctx context.Context,
+	accountID uuid.UUID,
+) error {
 	tx := r.db.UseTx(ctx)
-	if err := tx.CreateTransaction(ctx, dbParams); err != nil {
+	if err := tx.DeleteTransactionsByAccountID(ctx, &accountID); err != nil {
 		return errs.New(err)
 	}
 
@@ -130,6 +185,352 @@ func (r *TransactionRepo) GetTransactionByID(
 	return &result, nil
 }
 
+func (r *TransactionRepo) HideTransaction(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.HideTransaction(ctx, id); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *TransactionRepo) HideTransactions(
+	ctx context.Context,
+	ids []uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.HideTransactions(ctx, ids); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *TransactionRepo) HideTransactionsByUserInstitutionID(
+	ctx context.Context,
+	userInstitutionID uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.HideTransactionsByUserInstitutionID(
+		ctx,
+		userInstitutionID,
+	); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) GetDeletedTransactionByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Transaction, error) {
+	transaction, err := r.db.GetDeletedTransactionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	result := entity.Transaction{}
+	if err := copier.Copy(&result, transaction); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *TransactionRepo) ListDeletedTransactions(
+	ctx context.Context,
+	params repo.ListDeletedTransactionsParams,
+) ([]entity.Transaction, error) {
+	dbParams := sqlc.ListDeletedTransactionsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	transactions, err := r.db.ListDeletedTransactions(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	results := []entity.Transaction{}
+	if err := copier.Copy(&results, transactions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *TransactionRepo) ListHiddenTransactionExternalIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]string, error) {
+	externalIDs, err := r.db.ListHiddenTransactionExternalIDs(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	return externalIDs, nil
+}
+
+// ListTransactionExternalIDs returns the given external ids already used by
+// transactions of the user, deleted ones included.
+func (r *TransactionRepo) ListTransactionExternalIDs(
+	ctx context.Context,
+	params repo.ListTransactionExternalIDsParams,
+) ([]string, error) {
+	dbParams := sqlc.ListTransactionExternalIDsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	externalIDs, err := r.db.ListTransactionExternalIDs(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	return externalIDs, nil
+}
+
+func (r *TransactionRepo) RestoreTransaction(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.RestoreTransaction(ctx, id); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *TransactionRepo) PurgeDeletedTransactions(
+	ctx context.Context,
+	deletedBefore time.Time,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.PurgeDeletedTransactions(ctx, &deletedBefore); err != nil {
+		return errs.New(err)
+	}
+	return nil
+}
+
+func (r *TransactionRepo) ListInstallmentTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.Transaction, error) {
+	transactions, err := r.db.ListInstallmentTransactions(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	results := []entity.Transaction{}
+	if err := copier.Copy(&results, transactions); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+// MergeTransactionCategories moves the transactions and the split parts of
+// the source category to the target one.
+func (r *TransactionRepo) MergeTransactionCategories(
+	ctx context.Context,
+	params repo.MergeTransactionCategoriesParams,
+) error {
+	dbParams := sqlc.MergeTransactionCategoriesParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	splitParams := sqlc.MergeTransactionSplitCategoriesParams{}
+	if err := copier.Copy(&splitParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.MergeTransactionCategories(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	if err := tx.MergeTransactionSplitCategories(ctx, splitParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) CreateTransactionSplits(
+	ctx context.Context,
+	params []repo.CreateTransactionSplitsParams,
+) error {
+	dbParams := make([]sqlc.CreateTransactionSplitsParams, len(params))
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if _, err := tx.CreateTransactionSplits(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) DeleteTransactionSplits(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteTransactionSplits(ctx, transactionID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+// ListSplitTransactionIDs returns the ids of the transactions of the user
+// that have splits.
+func (r *TransactionRepo) ListSplitTransactionIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]uuid.UUID, error) {
+	ids, err := r.db.ListSplitTransactionIDs(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+	return ids, nil
+}
+
+func (r *TransactionRepo) ListTransactionSplits(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) ([]entity.TransactionSplit, error) {
+	splits, err := r.db.ListTransactionSplits(ctx, transactionID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.TransactionSplit
+	if err := copier.Copy(&results, splits); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *TransactionRepo) CreateTransactionTags(
+	ctx context.Context,
+	params []repo.CreateTransactionTagsParams,
+) error {
+	dbParams := make([]sqlc.CreateTransactionTagsParams, len(params))
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if _, err := tx.CreateTransactionTags(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) DeleteTransactionTags(
+	ctx context.Context,
+	transactionID uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteTransactionTags(ctx, transactionID); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+// ListTransactionTags returns the tags of each of the given transactions,
+// keyed by transaction ID.
+func (r *TransactionRepo) ListTransactionTags(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+) (map[uuid.UUID][]entity.Tag, error) {
+	rows, err := r.db.ListTransactionTags(ctx, transactionIDs)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	results := map[uuid.UUID][]entity.Tag{}
+	for _, row := range rows {
+		var tag entity.Tag
+		if err := copier.Copy(&tag, row.Tag); err != nil {
+			return nil, errs.New(err)
+		}
+		results[row.TransactionID] = append(results[row.TransactionID], tag)
+	}
+
+	return results, nil
+}
+
+func (r *TransactionRepo) TagTransactions(
+	ctx context.Context,
+	params repo.TagTransactionsParams,
+) error {
+	dbParams := sqlc.TagTransactionsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.TagTransactions(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) TagTransactionsByExternalIDs(
+	ctx context.Context,
+	params repo.TagTransactionsByExternalIDsParams,
+) error {
+	dbParams := sqlc.TagTransactionsByExternalIDsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.TagTransactionsByExternalIDs(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) ReconcileTransaction(
+	ctx context.Context,
+	params repo.ReconcileTransactionParams,
+) error {
+	dbParams := sqlc.ReconcileTransactionParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	return tx.ReconcileTransaction(ctx, dbParams)
+}
+
+func (r *TransactionRepo) ApplyTransactionRules(
+	ctx context.Context,
+	params repo.ApplyTransactionRulesParams,
+) error {
+	dbParams := sqlc.ApplyTransactionRulesParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	return tx.ApplyTransactionRules(ctx, dbParams)
+}
+
 func (r *TransactionRepo) UpdateTransaction(
 	ctx context.Context,
 	params repo.UpdateTransactionParams,
@@ -144,3 +545,54 @@ func (r *TransactionRepo) UpdateTransaction(
 }
 
 var _ repo.TransactionRepo = (*TransactionRepo)(nil)
+
+func (r *TransactionRepo) UntagTransactions(
+	ctx context.Context,
+	params repo.UntagTransactionsParams,
+) error {
+	dbParams := sqlc.UntagTransactionsParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UntagTransactions(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) UpdateTransactionsCategory(
+	ctx context.Context,
+	params repo.UpdateTransactionsCategoryParams,
+) error {
+	dbParams := sqlc.UpdateTransactionsCategoryParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateTransactionsCategory(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionRepo) UpdateTransactionsIsIgnored(
+	ctx context.Context,
+	params repo.UpdateTransactionsIsIgnoredParams,
+) error {
+	dbParams := sqlc.UpdateTransactionsIsIgnoredParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateTransactionsIsIgnored(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
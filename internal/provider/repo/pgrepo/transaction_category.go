@@ -103,4 +103,124 @@ func (r *TransactionCategoryRepo) GetDefaultTransactionCategory(
 	return &result, nil
 }
 
+func (r *TransactionCategoryRepo) CreateTransactionCategory(
+	ctx context.Context,
+	params repo.CreateTransactionCategoryParams,
+) (*entity.TransactionCategory, error) {
+	dbParams := sqlc.CreateTransactionCategoryParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return nil, errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	category, err := tx.CreateTransactionCategory(ctx, dbParams)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	result := entity.TransactionCategory{}
+	if err := copier.Copy(&result, category); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *TransactionCategoryRepo) UpdateTransactionCategory(
+	ctx context.Context,
+	params repo.UpdateTransactionCategoryParams,
+) error {
+	dbParams := sqlc.UpdateTransactionCategoryParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateTransactionCategory(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionCategoryRepo) DeleteTransactionCategory(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteTransactionCategory(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionCategoryRepo) HideCategory(
+	ctx context.Context,
+	params repo.HideCategoryParams,
+) error {
+	dbParams := sqlc.HideCategoryParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.HideCategory(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionCategoryRepo) UnhideCategory(
+	ctx context.Context,
+	params repo.UnhideCategoryParams,
+) error {
+	dbParams := sqlc.UnhideCategoryParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UnhideCategory(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransactionCategoryRepo) ListHiddenCategories(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.HiddenCategory, error) {
+	hiddenCategories, err := r.db.ListHiddenCategories(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.HiddenCategory
+	if err := copier.Copy(&results, hiddenCategories); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *TransactionCategoryRepo) MergeHiddenCategories(
+	ctx context.Context,
+	params repo.MergeHiddenCategoriesParams,
+) error {
+	dbParams := sqlc.MergeHiddenCategoriesParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.MergeHiddenCategories(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
 var _ repo.TransactionCategoryRepo = (*TransactionCategoryRepo)(nil)
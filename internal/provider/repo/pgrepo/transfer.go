@@ -0,0 +1,100 @@
+package pgrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+)
+
+type TransferRepo struct {
+	db *db.DB
+}
+
+func NewTransferRepo(
+	db *db.DB,
+) *TransferRepo {
+	return &TransferRepo{
+		db: db,
+	}
+}
+
+func (r *TransferRepo) CreateTransfers(
+	ctx context.Context,
+	params []repo.CreateTransfersParams,
+) error {
+	dbParams := make([]sqlc.CreateTransfersParams, len(params))
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if _, err := tx.CreateTransfers(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *TransferRepo) GetTransferByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.Transfer, error) {
+	transfer, err := r.db.GetTransferByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	var result entity.Transfer
+	if err := copier.Copy(&result, transfer); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *TransferRepo) ListTransfersByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]entity.Transfer, error) {
+	transfers, err := r.db.ListTransfersByUserID(ctx, userID)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	var results []entity.Transfer
+	if err := copier.Copy(&results, transfers); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return results, nil
+}
+
+func (r *TransferRepo) UpdateTransferStatus(
+	ctx context.Context,
+	params repo.UpdateTransferStatusParams,
+) error {
+	dbParams := sqlc.UpdateTransferStatusParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateTransferStatus(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+var _ repo.TransferRepo = (*TransferRepo)(nil)
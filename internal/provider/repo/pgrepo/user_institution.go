@@ -10,6 +10,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/repo"
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 )
 
@@ -23,6 +24,13 @@ func NewUserInstitutionRepo(db *db.DB) *UserInstitutionRepo {
 	}
 }
 
+func (r *UserInstitutionRepo) ListUserInstitutions(
+	ctx context.Context,
+	opts ...repo.UserInstitutionOptions,
+) ([]entity.UserInstitution, error) {
+	return r.db.ListUserInstitutions(ctx, opts...)
+}
+
 func (r *UserInstitutionRepo) CreateUserInstitution(
 	ctx context.Context,
 	params repo.CreateUserInstitutionParams,
@@ -66,4 +74,53 @@ func (r *UserInstitutionRepo) GetUserInstitutionByExternalID(
 	return &result, nil
 }
 
+func (r *UserInstitutionRepo) GetUserInstitutionByID(
+	ctx context.Context,
+	id uuid.UUID,
+) (*entity.UserInstitution, error) {
+	userInstitution, err := r.db.GetUserInstitutionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errs.New(err)
+	}
+
+	result := entity.UserInstitution{}
+	if err := copier.Copy(&result, userInstitution); err != nil {
+		return nil, errs.New(err)
+	}
+
+	return &result, nil
+}
+
+func (r *UserInstitutionRepo) UpdateUserInstitutionStatus(
+	ctx context.Context,
+	params repo.UpdateUserInstitutionStatusParams,
+) error {
+	dbParams := sqlc.UpdateUserInstitutionStatusParams{}
+	if err := copier.Copy(&dbParams, params); err != nil {
+		return errs.New(err)
+	}
+
+	tx := r.db.UseTx(ctx)
+	if err := tx.UpdateUserInstitutionStatus(ctx, dbParams); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (r *UserInstitutionRepo) DeleteUserInstitution(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	tx := r.db.UseTx(ctx)
+	if err := tx.DeleteUserInstitution(ctx, id); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
 var _ repo.UserInstitutionRepo = (*UserInstitutionRepo)(nil)
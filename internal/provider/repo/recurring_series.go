@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RecurringSeriesRepo interface {
+	DeleteRecurringSeriesExcept(
+		ctx context.Context,
+		params DeleteRecurringSeriesExceptParams,
+	) error
+	ListRecurringSeriesByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.RecurringSeries, error)
+	UpsertRecurringSeries(
+		ctx context.Context,
+		params UpsertRecurringSeriesParams,
+	) (*entity.RecurringSeries, error)
+}
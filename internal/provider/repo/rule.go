@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type RuleOptions struct {
+	Limit  uint      `json:"-"`
+	Offset uint      `json:"-"`
+	UserID uuid.UUID `json:"-"`
+}
+
+type RuleRepo interface {
+	CreateRule(
+		ctx context.Context,
+		params CreateRuleParams,
+	) (*entity.Rule, error)
+	DeleteRule(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	GetRuleByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Rule, error)
+	ListRules(
+		ctx context.Context,
+		opts ...RuleOptions,
+	) ([]entity.Rule, error)
+	CountRules(
+		ctx context.Context,
+		opts ...RuleOptions,
+	) (int64, error)
+	UpdateRule(
+		ctx context.Context,
+		params UpdateRuleParams,
+	) error
+	MergeRuleCategories(
+		ctx context.Context,
+		params MergeRuleCategoriesParams,
+	) error
+}
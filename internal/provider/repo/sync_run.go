@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type SyncRunRepo interface {
+	CreateSyncRun(
+		ctx context.Context,
+	) (*entity.SyncRun, error)
+	FinishSyncRun(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	CreateSyncRunItems(
+		ctx context.Context,
+		params []CreateSyncRunItemsParams,
+	) error
+	ListAccountSyncStatuses(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.AccountSyncStatus, error)
+}
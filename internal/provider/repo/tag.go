@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TagOptions struct {
+	Limit  uint        `json:"-"`
+	Offset uint        `json:"-"`
+	Search string      `json:"search"`
+	IDs    []uuid.UUID `json:"tag_ids"`
+	UserID uuid.UUID   `json:"-"`
+}
+
+type TagRepo interface {
+	CreateTag(
+		ctx context.Context,
+		params CreateTagParams,
+	) (*entity.Tag, error)
+	DeleteTag(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	GetTagByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Tag, error)
+	ListTags(
+		ctx context.Context,
+		opts ...TagOptions,
+	) ([]entity.Tag, error)
+	CountTags(
+		ctx context.Context,
+		opts ...TagOptions,
+	) (int64, error)
+	UpdateTag(
+		ctx context.Context,
+		params UpdateTagParams,
+	) error
+}
@@ -20,9 +20,32 @@ type TransactionOptions struct {
 	IsExpense        bool        `json:"is_expense"`
 	IsIncome         bool        `json:"is_income"`
 	IsIgnored        *bool       `json:"is_ignored"`
+	TagIDs           []uuid.UUID `json:"tag_ids"`
+	MerchantIDs      []uuid.UUID `json:"merchant_ids"`
+	IDs              []uuid.UUID `json:"ids"`
+
+	// AccountIDs filters the transactions of manual accounts, which have no
+	// institution, along with the ones of InstitutionIDs.
+	AccountIDs []uuid.UUID `json:"account_ids"`
+
+	// IsTransfer filters the transactions linked as a transfer between the
+	// user accounts, unlinked pairs are not transfers.
+	IsTransfer *bool `json:"is_transfer"`
+
+	// ShouldExpandSplits lists the parts of split transactions in place of
+	// the transactions themselves. Sums always use the parts.
+	ShouldExpandSplits bool `json:"-"`
+
+	// ShouldOrderByDate lists the oldest transactions first instead of
+	// ordering them by name.
+	ShouldOrderByDate bool `json:"-"`
 }
 
 type TransactionRepo interface {
+	ApplyTransactionRules(
+		ctx context.Context,
+		params ApplyTransactionRulesParams,
+	) error
 	CountTransactions(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -31,15 +54,77 @@ type TransactionRepo interface {
 	CreateTransaction(
 		ctx context.Context,
 		params CreateTransactionParams,
-	) error
+	) (*entity.Transaction, error)
 	CreateTransactions(
 		ctx context.Context,
 		params []CreateTransactionsParams,
 	) error
+	CreateTransactionSplits(
+		ctx context.Context,
+		params []CreateTransactionSplitsParams,
+	) error
+	CreateTransactionTags(
+		ctx context.Context,
+		params []CreateTransactionTagsParams,
+	) error
+	DeleteTransactions(
+		ctx context.Context,
+		ids []uuid.UUID,
+	) error
+	DeleteTransactionsByAccountID(
+		ctx context.Context,
+		accountID uuid.UUID,
+	) error
+	DeleteTransactionSplits(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) error
+	DeleteTransactionTags(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) error
+	EachFullTransaction(
+		ctx context.Context,
+		userID uuid.UUID,
+		fn func(entity.FullTransaction) error,
+		opts ...TransactionOptions,
+	) error
+	GetDeletedTransactionByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Transaction, error)
 	GetTransactionByID(
 		ctx context.Context,
 		id uuid.UUID,
 	) (*entity.FullTransaction, error)
+	HideTransaction(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	HideTransactions(
+		ctx context.Context,
+		ids []uuid.UUID,
+	) error
+	HideTransactionsByUserInstitutionID(
+		ctx context.Context,
+		userInstitutionID uuid.UUID,
+	) error
+	ListDeletedTransactions(
+		ctx context.Context,
+		params ListDeletedTransactionsParams,
+	) ([]entity.Transaction, error)
+	ListHiddenTransactionExternalIDs(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]string, error)
+	ListInstallmentTransactions(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.Transaction, error)
+	ListTransactionExternalIDs(
+		ctx context.Context,
+		params ListTransactionExternalIDsParams,
+	) ([]string, error)
 	ListTransactions(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -50,6 +135,34 @@ type TransactionRepo interface {
 		userID uuid.UUID,
 		opts ...TransactionOptions,
 	) ([]entity.FullTransaction, error)
+	ListSplitTransactionIDs(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]uuid.UUID, error)
+	ListTransactionSplits(
+		ctx context.Context,
+		transactionID uuid.UUID,
+	) ([]entity.TransactionSplit, error)
+	ListTransactionTags(
+		ctx context.Context,
+		transactionIDs []uuid.UUID,
+	) (map[uuid.UUID][]entity.Tag, error)
+	MergeTransactionCategories(
+		ctx context.Context,
+		params MergeTransactionCategoriesParams,
+	) error
+	PurgeDeletedTransactions(
+		ctx context.Context,
+		deletedBefore time.Time,
+	) error
+	ReconcileTransaction(
+		ctx context.Context,
+		params ReconcileTransactionParams,
+	) error
+	RestoreTransaction(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
 	SumTransactions(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -60,8 +173,38 @@ type TransactionRepo interface {
 		userID uuid.UUID,
 		opts ...TransactionOptions,
 	) (map[uuid.UUID]int64, error)
+	SumTransactionsByMerchant(
+		ctx context.Context,
+		userID uuid.UUID,
+		opts ...TransactionOptions,
+	) (map[uuid.UUID]int64, error)
+	SumTransactionsByTag(
+		ctx context.Context,
+		userID uuid.UUID,
+		opts ...TransactionOptions,
+	) (map[uuid.UUID]int64, error)
+	TagTransactions(
+		ctx context.Context,
+		params TagTransactionsParams,
+	) error
+	TagTransactionsByExternalIDs(
+		ctx context.Context,
+		params TagTransactionsByExternalIDsParams,
+	) error
+	UntagTransactions(
+		ctx context.Context,
+		params UntagTransactionsParams,
+	) error
 	UpdateTransaction(
 		ctx context.Context,
 		params UpdateTransactionParams,
 	) error
+	UpdateTransactionsCategory(
+		ctx context.Context,
+		params UpdateTransactionsCategoryParams,
+	) error
+	UpdateTransactionsIsIgnored(
+		ctx context.Context,
+		params UpdateTransactionsIsIgnoredParams,
+	) error
 }
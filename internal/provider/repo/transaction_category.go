@@ -7,11 +7,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// TransactionCategoryOptions filters categories. Without a UserID only the
+// provider categories are listed, with it the user categories are listed
+// too. IsHidden filters by the categories the user has hidden.
 type TransactionCategoryOptions struct {
-	Limit  uint        `json:"-"`
-	Offset uint        `json:"-"`
-	Search string      `json:"search"`
-	IDs    []uuid.UUID `json:"category_ids"`
+	Limit    uint        `json:"-"`
+	Offset   uint        `json:"-"`
+	Search   string      `json:"search"`
+	IDs      []uuid.UUID `json:"category_ids"`
+	UserID   uuid.UUID   `json:"-"`
+	IsHidden *bool       `json:"is_hidden"`
 }
 
 type TransactionCategoryRepo interface {
@@ -34,4 +39,32 @@ type TransactionCategoryRepo interface {
 	GetDefaultTransactionCategory(
 		ctx context.Context,
 	) (*entity.TransactionCategory, error)
+	CreateTransactionCategory(
+		ctx context.Context,
+		params CreateTransactionCategoryParams,
+	) (*entity.TransactionCategory, error)
+	UpdateTransactionCategory(
+		ctx context.Context,
+		params UpdateTransactionCategoryParams,
+	) error
+	DeleteTransactionCategory(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
+	HideCategory(
+		ctx context.Context,
+		params HideCategoryParams,
+	) error
+	UnhideCategory(
+		ctx context.Context,
+		params UnhideCategoryParams,
+	) error
+	ListHiddenCategories(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.HiddenCategory, error)
+	MergeHiddenCategories(
+		ctx context.Context,
+		params MergeHiddenCategoriesParams,
+	) error
 }
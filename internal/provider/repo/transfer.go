@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+type TransferRepo interface {
+	CreateTransfers(
+		ctx context.Context,
+		params []CreateTransfersParams,
+	) error
+	GetTransferByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.Transfer, error)
+	ListTransfersByUserID(
+		ctx context.Context,
+		userID uuid.UUID,
+	) ([]entity.Transfer, error)
+	UpdateTransferStatus(
+		ctx context.Context,
+		params UpdateTransferStatusParams,
+	) error
+}
@@ -4,9 +4,21 @@ import (
 	"context"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/google/uuid"
 )
 
+type UserInstitutionOptions struct {
+	Limit                uint        `json:"-"`
+	Offset               uint        `json:"-"`
+	IDs                  []uuid.UUID `json:"ids"`
+	IsSubscriptionActive *bool       `json:"is_subscription_active"`
+}
+
 type UserInstitutionRepo interface {
+	ListUserInstitutions(
+		ctx context.Context,
+		opts ...UserInstitutionOptions,
+	) ([]entity.UserInstitution, error)
 	CreateUserInstitution(
 		ctx context.Context,
 		params CreateUserInstitutionParams,
@@ -15,4 +27,16 @@ type UserInstitutionRepo interface {
 		ctx context.Context,
 		externalID string,
 	) (*entity.UserInstitution, error)
+	GetUserInstitutionByID(
+		ctx context.Context,
+		id uuid.UUID,
+	) (*entity.UserInstitution, error)
+	UpdateUserInstitutionStatus(
+		ctx context.Context,
+		params UpdateUserInstitutionStatusParams,
+	) error
+	DeleteUserInstitution(
+		ctx context.Context,
+		id uuid.UUID,
+	) error
 }
@@ -0,0 +1,107 @@
+package localstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+)
+
+// LocalStorage stores objects as files under a root directory,
+// it is meant for development and tests.
+type LocalStorage struct {
+	root string
+}
+
+func NewLocalStorage(
+	e *env.Env,
+) *LocalStorage {
+	return &LocalStorage{
+		root: e.StorageLocalPath,
+	}
+}
+
+func (s *LocalStorage) Upload(
+	ctx context.Context,
+	key storage.Key,
+	data []byte,
+	contentType string,
+) error {
+	path, err := s.path(key)
+	if err != nil {
+		return errs.New(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errs.New(err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) Download(
+	ctx context.Context,
+	key storage.Key,
+) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(
+	ctx context.Context,
+	keys ...storage.Key,
+) error {
+	for _, key := range keys {
+		path, err := s.path(key)
+		if err != nil {
+			return errs.New(err)
+		}
+
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return errs.New(err)
+		}
+	}
+
+	return nil
+}
+
+// path resolves the key inside the root directory,
+// rejecting keys that would escape it.
+func (s *LocalStorage) path(key storage.Key) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." || rel == ".." ||
+		strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+
+	return path, nil
+}
+
+var _ storage.Storage = (*LocalStorage)(nil)
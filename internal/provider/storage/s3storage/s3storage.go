@@ -0,0 +1,296 @@
+package s3storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/errs"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/storage"
+)
+
+// S3Storage stores objects in an S3 compatible bucket,
+// signing the requests with AWS Signature Version 4.
+// Objects are addressed path style so any S3 compatible
+// provider (AWS, R2, MinIO...) can be used.
+type S3Storage struct {
+	client          *http.Client
+	endpoint        string
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+}
+
+func NewS3Storage(
+	e *env.Env,
+) *S3Storage {
+	endpoint := e.S3Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", e.S3Region)
+	}
+
+	return &S3Storage{
+		client:          &http.Client{Timeout: 30 * time.Second},
+		endpoint:        strings.TrimSuffix(endpoint, "/"),
+		region:          e.S3Region,
+		bucket:          e.S3Bucket,
+		accessKeyID:     e.S3AccessKeyID,
+		secretAccessKey: e.S3SecretAccessKey,
+	}
+}
+
+func (s *S3Storage) Upload(
+	ctx context.Context,
+	key storage.Key,
+	data []byte,
+	contentType string,
+) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return errs.New(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return errs.New(err)
+	}
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return errs.New(err)
+	}
+
+	return nil
+}
+
+func (s *S3Storage) Download(
+	ctx context.Context,
+	key storage.Key,
+) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, errs.New(err)
+	}
+
+	if err := checkResponse(res); err != nil {
+		_ = res.Body.Close()
+		return nil, errs.New(err)
+	}
+
+	return res.Body, nil
+}
+
+func (s *S3Storage) Delete(
+	ctx context.Context,
+	keys ...storage.Key,
+) error {
+	for _, key := range keys {
+		req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+		if err != nil {
+			return errs.New(err)
+		}
+
+		res, err := s.client.Do(req)
+		if err != nil {
+			return errs.New(err)
+		}
+		_ = res.Body.Close()
+
+		if res.StatusCode != http.StatusNotFound {
+			if err := checkResponse(res); err != nil {
+				return errs.New(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *S3Storage) newRequest(
+	ctx context.Context,
+	method string,
+	key storage.Key,
+	body []byte,
+) (*http.Request, error) {
+	rawURL, err := url.JoinPath(s.endpoint, s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		rawURL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+
+	s.sign(req, body, time.Now())
+
+	return req, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization headers to the request.
+// Every header already set on the request is signed along with the host.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+func (s *S3Storage) sign(req *http.Request, body []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hashHex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// The request is sent with the same path encoding that is signed
+	if req.URL.Path == "" {
+		req.URL.Path = "/"
+	}
+	req.URL.RawPath = uriEncode(req.URL.Path, false)
+
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(req)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.RawPath,
+		canonicalizeQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+// canonicalizeHeaders returns the canonical headers and the signed headers
+// list, with the names lowercased and sorted.
+func canonicalizeHeaders(req *http.Request) (canonical, signed string) {
+	values := map[string]string{"host": req.URL.Host}
+	for name, v := range req.Header {
+		name = strings.ToLower(name)
+		if name == "authorization" {
+			continue
+		}
+		trimmed := make([]string, len(v))
+		for i := range v {
+			trimmed[i] = strings.Join(strings.Fields(v[i]), " ")
+		}
+		values[name] = strings.Join(trimmed, ",")
+	}
+
+	names := slices.Sorted(maps.Keys(values))
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + values[name] + "\n")
+	}
+
+	return b.String(), strings.Join(names, ";")
+}
+
+// canonicalizeQuery returns the query parameters sorted by name and value,
+// with both URI encoded.
+func canonicalizeQuery(query url.Values) string {
+	params := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			params = append(
+				params,
+				uriEncode(name, true)+"="+uriEncode(value, true),
+			)
+		}
+	}
+	slices.Sort(params)
+
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent encodes every byte but the unreserved characters, as
+// required by Signature Version 4. Slashes are kept when encoding paths.
+func uriEncode(s string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		isUnreserved := 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' ||
+			'0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' ||
+			c == '~'
+		if isUnreserved || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hexDigits[c>>4])
+		b.WriteByte(hexDigits[c&15])
+	}
+
+	return b.String()
+}
+
+func checkResponse(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf(
+		"s3 request failed with status %d: %s",
+		res.StatusCode,
+		body,
+	)
+}
+
+func hashHex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+var _ storage.Storage = (*S3Storage)(nil)
@@ -0,0 +1,41 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+type Storage interface {
+	Upload(
+		ctx context.Context,
+		key Key,
+		data []byte,
+		contentType string,
+	) error
+
+	// Download returns a reader for the stored object,
+	// which must be closed by the caller.
+	Download(
+		ctx context.Context,
+		key Key,
+	) (io.ReadCloser, error)
+
+	Delete(
+		ctx context.Context,
+		keys ...Key,
+	) error
+}
+
+type Key = string
+
+func AttachmentKey(userID, transactionID, attachmentID uuid.UUID) Key {
+	return fmt.Sprintf(
+		"users/%s/transactions/%s/attachments/%s",
+		userID,
+		transactionID,
+		attachmentID,
+	)
+}
@@ -0,0 +1,15 @@
+-- CreateTable
+CREATE TABLE "job_runs" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "job" TEXT NOT NULL,
+    "started_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "finished_at" TIMESTAMPTZ,
+    "accounts_processed" BIGINT NOT NULL DEFAULT 0,
+    "error_message" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "job_runs_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "job_runs_job_started_at_idx" ON "job_runs"("job", "started_at");
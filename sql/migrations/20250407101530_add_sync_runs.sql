@@ -0,0 +1,33 @@
+-- CreateTable
+CREATE TABLE "sync_run_items" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "status" TEXT NOT NULL,
+    "fetched" BIGINT NOT NULL DEFAULT 0,
+    "inserted" BIGINT NOT NULL DEFAULT 0,
+    "skipped" BIGINT NOT NULL DEFAULT 0,
+    "failure_reason" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "sync_run_id" UUID NOT NULL,
+    "account_id" UUID NOT NULL,
+
+    CONSTRAINT "sync_run_items_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "sync_runs" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "started_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "finished_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "sync_runs_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "sync_run_items_account_id_created_at_idx" ON "sync_run_items"("account_id", "created_at");
+
+-- AddForeignKey
+ALTER TABLE "sync_run_items" ADD CONSTRAINT "sync_run_items_sync_run_id_fkey" FOREIGN KEY ("sync_run_id") REFERENCES "sync_runs"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "sync_run_items" ADD CONSTRAINT "sync_run_items_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "accounts"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "user_institutions" ADD COLUMN     "status" TEXT NOT NULL DEFAULT 'CONNECTED';
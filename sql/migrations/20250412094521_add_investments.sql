@@ -0,0 +1,33 @@
+-- CreateTable
+CREATE TABLE "investment_positions" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "amount" BIGINT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "investment_id" UUID NOT NULL,
+
+    CONSTRAINT "investment_positions_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "investments" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "external_id" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "type" TEXT NOT NULL,
+    "subtype" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "user_institution_id" UUID NOT NULL,
+
+    CONSTRAINT "investments_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "investment_positions_investment_id_created_at_idx" ON "investment_positions"("investment_id", "created_at");
+
+-- AddForeignKey
+ALTER TABLE "investment_positions" ADD CONSTRAINT "investment_positions_investment_id_fkey" FOREIGN KEY ("investment_id") REFERENCES "investments"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "investments" ADD CONSTRAINT "investments_user_institution_id_fkey" FOREIGN KEY ("user_institution_id") REFERENCES "user_institutions"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,29 @@
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN     "purchase_date" TIMESTAMPTZ,
+ADD COLUMN     "installment_number" INTEGER,
+ADD COLUMN     "total_installments" INTEGER,
+ADD COLUMN     "bill_id" UUID;
+
+-- CreateTable
+CREATE TABLE "credit_card_bills" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "external_id" TEXT NOT NULL,
+    "closing_date" TIMESTAMPTZ,
+    "due_date" TIMESTAMPTZ NOT NULL,
+    "total_amount" BIGINT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "account_id" UUID NOT NULL,
+
+    CONSTRAINT "credit_card_bills_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "credit_card_bills_account_id_external_id_key" ON "credit_card_bills"("account_id", "external_id");
+
+-- AddForeignKey
+ALTER TABLE "credit_card_bills" ADD CONSTRAINT "credit_card_bills_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "accounts"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transactions" ADD CONSTRAINT "transactions_bill_id_fkey" FOREIGN KEY ("bill_id") REFERENCES "credit_card_bills"("id") ON DELETE SET NULL ON UPDATE CASCADE;
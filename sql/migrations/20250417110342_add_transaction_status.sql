@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN     "status" TEXT NOT NULL DEFAULT 'POSTED';
@@ -0,0 +1,10 @@
+-- AlterTable
+ALTER TABLE "sync_run_items" ADD COLUMN     "updated" BIGINT NOT NULL DEFAULT 0,
+ADD COLUMN     "deleted" BIGINT NOT NULL DEFAULT 0;
+
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN     "is_name_overridden" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "is_amount_overridden" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "is_date_overridden" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "is_category_overridden" BOOLEAN NOT NULL DEFAULT false,
+ADD COLUMN     "is_payment_method_overridden" BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "rules" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "rules_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "rules_updated_at_trigger"
+BEFORE UPDATE ON "rules"
+FOR EACH ROW
+EXECUTE PROCEDURE "rules_updated_at_trigger"();
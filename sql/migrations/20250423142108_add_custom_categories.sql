@@ -0,0 +1,35 @@
+-- AlterTable
+ALTER TABLE "transaction_categories" ADD COLUMN     "icon" TEXT,
+ADD COLUMN     "color" TEXT,
+ADD COLUMN     "user_id" UUID,
+ADD COLUMN     "parent_id" UUID,
+ALTER COLUMN "external_id" DROP NOT NULL;
+
+-- CreateTable
+CREATE TABLE "hidden_categories" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "user_id" UUID NOT NULL,
+    "category_id" UUID NOT NULL,
+    "merged_into_id" UUID,
+
+    CONSTRAINT "hidden_categories_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "hidden_categories_user_id_category_id_key" ON "hidden_categories"("user_id", "category_id");
+
+-- AddForeignKey
+ALTER TABLE "hidden_categories" ADD CONSTRAINT "hidden_categories_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "hidden_categories" ADD CONSTRAINT "hidden_categories_category_id_fkey" FOREIGN KEY ("category_id") REFERENCES "transaction_categories"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "hidden_categories" ADD CONSTRAINT "hidden_categories_merged_into_id_fkey" FOREIGN KEY ("merged_into_id") REFERENCES "transaction_categories"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transaction_categories" ADD CONSTRAINT "transaction_categories_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transaction_categories" ADD CONSTRAINT "transaction_categories_parent_id_fkey" FOREIGN KEY ("parent_id") REFERENCES "transaction_categories"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,22 @@
+-- CreateTable
+CREATE TABLE "transaction_splits" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "amount" BIGINT NOT NULL,
+    "note" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "transaction_id" UUID NOT NULL,
+    "category_id" UUID NOT NULL,
+
+    CONSTRAINT "transaction_splits_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "transaction_splits_transaction_id_idx" ON "transaction_splits"("transaction_id");
+
+-- AddForeignKey
+ALTER TABLE "transaction_splits" ADD CONSTRAINT "transaction_splits_transaction_id_fkey" FOREIGN KEY ("transaction_id") REFERENCES "transactions"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transaction_splits" ADD CONSTRAINT "transaction_splits_category_id_fkey" FOREIGN KEY ("category_id") REFERENCES "transaction_categories"("id") ON DELETE CASCADE ON UPDATE CASCADE;
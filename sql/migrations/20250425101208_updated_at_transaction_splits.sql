@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "transaction_splits" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "transaction_splits_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "transaction_splits_updated_at_trigger"
+BEFORE UPDATE ON "transaction_splits"
+FOR EACH ROW
+EXECUTE PROCEDURE "transaction_splits_updated_at_trigger"();
@@ -0,0 +1,47 @@
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN "notes" TEXT;
+
+-- AlterTable
+ALTER TABLE "rules" ADD COLUMN "tag_id" UUID;
+
+-- CreateTable
+CREATE TABLE "tags" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "name" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "user_id" UUID NOT NULL,
+
+    CONSTRAINT "tags_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "transaction_tags" (
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "transaction_id" UUID NOT NULL,
+    "tag_id" UUID NOT NULL,
+
+    CONSTRAINT "transaction_tags_pkey" PRIMARY KEY ("transaction_id","tag_id")
+);
+
+-- CreateIndex
+CREATE INDEX "transaction_tags_tag_id_idx" ON "transaction_tags"("tag_id");
+
+-- AddForeignKey
+ALTER TABLE "rules" ADD CONSTRAINT "rules_tag_id_fkey" FOREIGN KEY ("tag_id") REFERENCES "tags"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "tags" ADD CONSTRAINT "tags_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transaction_tags" ADD CONSTRAINT "transaction_tags_transaction_id_fkey" FOREIGN KEY ("transaction_id") REFERENCES "transactions"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transaction_tags" ADD CONSTRAINT "transaction_tags_tag_id_fkey" FOREIGN KEY ("tag_id") REFERENCES "tags"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- CreateIndex
+CREATE INDEX idx_transactions_notes_unaccent_trgm ON transactions USING gin (indexed_unaccent(notes) gin_trgm_ops);
+
+-- CreateIndex
+CREATE INDEX idx_tags_name_unaccent_trgm ON tags USING gin (indexed_unaccent(name) gin_trgm_ops);
\ No newline at end of file
@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "tags" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "tags_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "tags_updated_at_trigger"
+BEFORE UPDATE ON "tags"
+FOR EACH ROW
+EXECUTE PROCEDURE "tags_updated_at_trigger"();
@@ -0,0 +1,33 @@
+-- CreateTable
+CREATE TABLE "recurring_series" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "name" TEXT NOT NULL,
+    "normalized_name" TEXT NOT NULL,
+    "frequency" TEXT NOT NULL,
+    "amount" BIGINT NOT NULL,
+    "previous_amount" BIGINT,
+    "average_amount" BIGINT NOT NULL,
+    "occurrences" INTEGER NOT NULL,
+    "first_date" TIMESTAMPTZ NOT NULL,
+    "last_date" TIMESTAMPTZ NOT NULL,
+    "next_date" TIMESTAMPTZ NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "user_id" UUID NOT NULL,
+    "payment_method_id" UUID NOT NULL,
+    "category_id" UUID NOT NULL,
+
+    CONSTRAINT "recurring_series_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "recurring_series_user_id_payment_method_id_normalized_name_key" ON "recurring_series"("user_id", "payment_method_id", "normalized_name");
+
+-- AddForeignKey
+ALTER TABLE "recurring_series" ADD CONSTRAINT "recurring_series_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "recurring_series" ADD CONSTRAINT "recurring_series_payment_method_id_fkey" FOREIGN KEY ("payment_method_id") REFERENCES "payment_methods"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "recurring_series" ADD CONSTRAINT "recurring_series_category_id_fkey" FOREIGN KEY ("category_id") REFERENCES "transaction_categories"("id") ON DELETE CASCADE ON UPDATE CASCADE;
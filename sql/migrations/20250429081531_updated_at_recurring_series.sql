@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "recurring_series" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "recurring_series_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "recurring_series_updated_at_trigger"
+BEFORE UPDATE ON "recurring_series"
+FOR EACH ROW
+EXECUTE PROCEDURE "recurring_series_updated_at_trigger"();
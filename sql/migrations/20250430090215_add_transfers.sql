@@ -0,0 +1,30 @@
+-- CreateTable
+CREATE TABLE "transfers" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "status" TEXT NOT NULL DEFAULT 'MATCHED',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "user_id" UUID NOT NULL,
+    "outgoing_transaction_id" UUID NOT NULL,
+    "incoming_transaction_id" UUID NOT NULL,
+
+    CONSTRAINT "transfers_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "transfers_user_id_idx" ON "transfers"("user_id");
+
+-- CreateIndex
+CREATE INDEX "transfers_incoming_transaction_id_idx" ON "transfers"("incoming_transaction_id");
+
+-- CreateIndex
+CREATE UNIQUE INDEX "transfers_outgoing_transaction_id_incoming_transaction_id_key" ON "transfers"("outgoing_transaction_id", "incoming_transaction_id");
+
+-- AddForeignKey
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_outgoing_transaction_id_fkey" FOREIGN KEY ("outgoing_transaction_id") REFERENCES "transactions"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_incoming_transaction_id_fkey" FOREIGN KEY ("incoming_transaction_id") REFERENCES "transactions"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "transfers" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "transfers_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "transfers_updated_at_trigger"
+BEFORE UPDATE ON "transfers"
+FOR EACH ROW
+EXECUTE PROCEDURE "transfers_updated_at_trigger"();
@@ -0,0 +1,28 @@
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN "merchant_id" UUID;
+
+-- CreateTable
+CREATE TABLE "merchants" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "key" TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    "document" TEXT,
+    "mcc" INTEGER,
+    "logo" TEXT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT "merchants_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "merchants_key_key" ON "merchants"("key");
+
+-- CreateIndex
+CREATE INDEX "transactions_merchant_id_idx" ON "transactions"("merchant_id");
+
+-- AddForeignKey
+ALTER TABLE "transactions" ADD CONSTRAINT "transactions_merchant_id_fkey" FOREIGN KEY ("merchant_id") REFERENCES "merchants"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- CreateIndex
+CREATE INDEX idx_merchants_name_unaccent_trgm ON merchants USING gin (indexed_unaccent(name) gin_trgm_ops);
@@ -0,0 +1,13 @@
+-- Auto-generated trigger for table "merchants" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "merchants_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "merchants_updated_at_trigger"
+BEFORE UPDATE ON "merchants"
+FOR EACH ROW
+EXECUTE PROCEDURE "merchants_updated_at_trigger"();
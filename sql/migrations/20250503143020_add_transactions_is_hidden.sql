@@ -0,0 +1,5 @@
+-- AlterTable
+ALTER TABLE "transactions" ADD COLUMN "is_hidden" BOOLEAN NOT NULL DEFAULT false;
+
+-- CreateIndex
+CREATE INDEX "transactions_user_id_deleted_at_idx" ON "transactions"("user_id", "deleted_at");
@@ -0,0 +1,18 @@
+-- AlterTable
+ALTER TABLE "accounts" ADD COLUMN "user_id" UUID,
+ALTER COLUMN "user_institution_id" DROP NOT NULL;
+
+-- Backfill
+UPDATE "accounts"
+SET "user_id" = "user_institutions"."user_id"
+FROM "user_institutions"
+WHERE "accounts"."user_institution_id" = "user_institutions"."id";
+
+-- AlterTable
+ALTER TABLE "accounts" ALTER COLUMN "user_id" SET NOT NULL;
+
+-- CreateIndex
+CREATE INDEX "accounts_user_id_idx" ON "accounts"("user_id");
+
+-- AddForeignKey
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,2 @@
+-- AlterTable
+ALTER TABLE "accounts" ADD COLUMN "opening_balance" BIGINT NOT NULL DEFAULT 0;
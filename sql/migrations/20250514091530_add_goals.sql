@@ -0,0 +1,43 @@
+-- CreateTable
+CREATE TABLE "goal_contributions" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "amount" BIGINT NOT NULL,
+    "date" TIMESTAMPTZ NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "goal_id" UUID NOT NULL,
+
+    CONSTRAINT "goal_contributions_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateTable
+CREATE TABLE "goals" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "name" TEXT NOT NULL,
+    "target_amount" BIGINT NOT NULL,
+    "deadline" TIMESTAMPTZ NOT NULL,
+    "interest" BIGINT NOT NULL DEFAULT 0,
+    "monthly_contribution" BIGINT NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    "account_id" UUID,
+    "user_id" UUID NOT NULL,
+
+    CONSTRAINT "goals_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE INDEX "goal_contributions_goal_id_idx" ON "goal_contributions"("goal_id");
+
+-- CreateIndex
+CREATE INDEX "goals_user_id_idx" ON "goals"("user_id");
+
+-- AddForeignKey
+ALTER TABLE "goal_contributions" ADD CONSTRAINT "goal_contributions_goal_id_fkey" FOREIGN KEY ("goal_id") REFERENCES "goals"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "goals" ADD CONSTRAINT "goals_account_id_fkey" FOREIGN KEY ("account_id") REFERENCES "accounts"("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- AddForeignKey
+ALTER TABLE "goals" ADD CONSTRAINT "goals_user_id_fkey" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE ON UPDATE CASCADE;
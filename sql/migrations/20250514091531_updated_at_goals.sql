@@ -0,0 +1,14 @@
+
+-- Auto-generated trigger for table "goals" to update @updatedAt columns
+CREATE OR REPLACE FUNCTION "goals_updated_at_trigger"()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW."updated_at" = now();
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER "goals_updated_at_trigger"
+BEFORE UPDATE ON "goals"
+FOR EACH ROW
+EXECUTE PROCEDURE "goals_updated_at_trigger"();
@@ -0,0 +1,28 @@
+-- Deduplicate
+UPDATE "investment_positions"
+SET "investment_id" = "kept"."id"
+FROM "investments"
+  JOIN (
+    SELECT DISTINCT ON ("user_institution_id", "external_id") "id",
+      "user_institution_id",
+      "external_id"
+    FROM "investments"
+    ORDER BY "user_institution_id",
+      "external_id",
+      "created_at" ASC
+  ) AS "kept" ON "kept"."user_institution_id" = "investments"."user_institution_id"
+  AND "kept"."external_id" = "investments"."external_id"
+WHERE "investment_positions"."investment_id" = "investments"."id"
+  AND "investments"."id" <> "kept"."id";
+
+DELETE FROM "investments"
+WHERE "id" NOT IN (
+    SELECT DISTINCT ON ("user_institution_id", "external_id") "id"
+    FROM "investments"
+    ORDER BY "user_institution_id",
+      "external_id",
+      "created_at" ASC
+  );
+
+-- CreateIndex
+CREATE UNIQUE INDEX "investments_user_institution_id_external_id_key" ON "investments"("user_institution_id", "external_id");
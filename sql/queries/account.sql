@@ -4,6 +4,34 @@ INSERT INTO accounts (
     external_id,
     name,
     type,
-    user_institution_id
+    user_institution_id,
+    user_id
   )
-VALUES ($1, $2, $3, $4, $5);
\ No newline at end of file
+VALUES ($1, $2, $3, $4, $5, $6);
+-- name: DeleteAccountsByUserInstitutionID :exec
+UPDATE accounts
+SET deleted_at = NOW()
+WHERE user_institution_id = $1
+  AND deleted_at IS NULL;
+-- name: CreateAccount :one
+INSERT INTO accounts (
+    id,
+    external_id,
+    name,
+    type,
+    user_id,
+    opening_balance
+  )
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+-- name: UpdateAccount :exec
+UPDATE accounts
+SET name = $2,
+  opening_balance = $3
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: DeleteAccount :exec
+UPDATE accounts
+SET deleted_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
@@ -0,0 +1,44 @@
+-- name: CreateAttachment :one
+INSERT INTO attachments (
+    id,
+    name,
+    content_type,
+    size,
+    storage_key,
+    transaction_id,
+    user_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+-- name: DeleteAttachment :exec
+DELETE FROM attachments
+WHERE id = $1;
+-- name: DeleteAttachmentsByUserID :exec
+DELETE FROM attachments
+WHERE user_id = $1;
+-- name: GetAttachmentByID :one
+SELECT *
+FROM attachments
+WHERE id = $1;
+-- name: ListAttachmentsByTransactionID :many
+SELECT *
+FROM attachments
+WHERE transaction_id = $1
+ORDER BY created_at ASC;
+-- name: ListAttachmentsByTransactionDeletedBefore :many
+SELECT attachments.*
+FROM attachments
+  JOIN transactions ON attachments.transaction_id = transactions.id
+WHERE transactions.deleted_at < $1
+  AND (
+    transactions.external_id IS NULL
+    OR transactions.external_id LIKE 'import:%'
+  );
+-- name: ListAttachmentsByUserID :many
+SELECT *
+FROM attachments
+WHERE user_id = $1;
+-- name: SumAttachmentsSizeByUserID :one
+SELECT COALESCE(SUM(size), 0)::bigint
+FROM attachments
+WHERE user_id = $1;
\ No newline at end of file
@@ -23,4 +23,34 @@ FROM budget_categories bc
 WHERE b.user_id = $1
   AND b.date <= $2
   AND bc.deleted_at IS NULL
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: MergeBudgetCategories :exec
+WITH merged AS (
+  UPDATE budget_categories t
+  SET amount = t.amount + s.amount
+  FROM budget_categories s
+    JOIN budgets b ON s.budget_id = b.id
+  WHERE b.user_id = @user_id
+    AND s.category_id = @source_category_id
+    AND t.category_id = @target_category_id
+    AND t.budget_id = s.budget_id
+    AND s.deleted_at IS NULL
+    AND t.deleted_at IS NULL
+  RETURNING s.id
+)
+UPDATE budget_categories
+SET deleted_at = NOW()
+WHERE id IN (
+    SELECT id
+    FROM merged
+  );
+-- name: MoveBudgetCategories :exec
+UPDATE budget_categories
+SET category_id = @target_category_id
+WHERE category_id = @source_category_id
+  AND deleted_at IS NULL
+  AND budget_id IN (
+    SELECT id
+    FROM budgets
+    WHERE user_id = @user_id
+  );
\ No newline at end of file
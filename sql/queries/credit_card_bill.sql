@@ -0,0 +1,25 @@
+-- name: UpsertCreditCardBill :one
+INSERT INTO credit_card_bills (
+    external_id,
+    closing_date,
+    due_date,
+    total_amount,
+    account_id
+  )
+VALUES ($1, $2, $3, $4, $5) ON CONFLICT (account_id, external_id) DO
+UPDATE
+SET closing_date = GREATEST(
+    credit_card_bills.closing_date,
+    EXCLUDED.closing_date
+  ),
+  due_date = EXCLUDED.due_date,
+  total_amount = EXCLUDED.total_amount,
+  updated_at = NOW(),
+  deleted_at = NULL
+RETURNING *;
+-- name: ListCreditCardBillsByAccountID :many
+SELECT *
+FROM credit_card_bills
+WHERE account_id = $1
+  AND deleted_at IS NULL
+ORDER BY due_date DESC;
\ No newline at end of file
@@ -0,0 +1,38 @@
+-- name: CreateGoal :one
+INSERT INTO goals (
+    name,
+    target_amount,
+    deadline,
+    interest,
+    monthly_contribution,
+    account_id,
+    user_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+-- name: UpdateGoal :exec
+UPDATE goals
+SET name = $2,
+  target_amount = $3,
+  deadline = $4,
+  interest = $5,
+  monthly_contribution = $6,
+  account_id = $7
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: DeleteGoal :exec
+UPDATE goals
+SET deleted_at = NOW()
+WHERE id = $1;
+-- name: GetGoalByID :one
+SELECT *
+FROM goals
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: ListGoalsByUserID :many
+SELECT *
+FROM goals
+WHERE user_id = $1
+  AND deleted_at IS NULL
+ORDER BY deadline,
+  name;
@@ -0,0 +1,31 @@
+-- name: CreateGoalContribution :one
+INSERT INTO goal_contributions (amount, date, goal_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+-- name: DeleteGoalContribution :exec
+UPDATE goal_contributions
+SET deleted_at = NOW()
+WHERE id = $1;
+-- name: DeleteGoalContributionsByGoalID :exec
+UPDATE goal_contributions
+SET deleted_at = NOW()
+WHERE goal_id = $1
+  AND deleted_at IS NULL;
+-- name: GetGoalContributionByID :one
+SELECT *
+FROM goal_contributions
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: ListGoalContributionsByGoalID :many
+SELECT *
+FROM goal_contributions
+WHERE goal_id = $1
+  AND deleted_at IS NULL
+ORDER BY date DESC,
+  created_at DESC;
+-- name: SumGoalContributionsByGoalID :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS total
+FROM goal_contributions
+WHERE goal_id = $1
+  AND deleted_at IS NULL
+  AND date <= NOW();
@@ -0,0 +1,21 @@
+-- name: HideCategory :exec
+INSERT INTO hidden_categories (user_id, category_id, merged_into_id)
+VALUES ($1, $2, $3) ON CONFLICT (user_id, category_id) DO
+UPDATE
+SET merged_into_id = COALESCE(
+    EXCLUDED.merged_into_id,
+    hidden_categories.merged_into_id
+  );
+-- name: UnhideCategory :exec
+DELETE FROM hidden_categories
+WHERE user_id = $1
+  AND category_id = $2;
+-- name: ListHiddenCategories :many
+SELECT *
+FROM hidden_categories
+WHERE user_id = $1;
+-- name: MergeHiddenCategories :exec
+UPDATE hidden_categories
+SET merged_into_id = @target_category_id::uuid
+WHERE user_id = @user_id
+  AND merged_into_id = @source_category_id::uuid;
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- name: UpsertInvestment :one
+INSERT INTO investments (
+    external_id,
+    name,
+    type,
+    subtype,
+    user_institution_id
+  )
+VALUES ($1, $2, $3, $4, $5) ON CONFLICT (user_institution_id, external_id) DO
+UPDATE
+SET name = EXCLUDED.name,
+  type = EXCLUDED.type,
+  subtype = EXCLUDED.subtype,
+  deleted_at = NULL
+RETURNING *;
+-- name: DeleteInvestmentsNotInExternalIDs :exec
+UPDATE investments
+SET deleted_at = NOW()
+WHERE user_institution_id = @user_institution_id
+  AND external_id <> ALL(@external_ids::text[])
+  AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+-- name: CreateInvestmentPositions :copyfrom
+INSERT INTO investment_positions (amount, investment_id)
+VALUES ($1, $2);
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- name: CreateJobRun :one
+INSERT INTO job_runs (job)
+VALUES ($1)
+RETURNING *;
+-- name: FinishJobRun :exec
+UPDATE job_runs
+SET finished_at = now(),
+  accounts_processed = $2,
+  error_message = $3
+WHERE id = $1;
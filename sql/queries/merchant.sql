@@ -0,0 +1,14 @@
+-- name: UpsertMerchant :one
+INSERT INTO merchants (key, name, document, mcc, logo)
+VALUES ($1, $2, $3, $4, $5) ON CONFLICT (key) DO
+UPDATE
+SET name = EXCLUDED.name,
+  document = COALESCE(EXCLUDED.document, merchants.document),
+  mcc = COALESCE(EXCLUDED.mcc, merchants.mcc),
+  logo = COALESCE(EXCLUDED.logo, merchants.logo)
+RETURNING *;
+-- name: ListMerchantsByIDs :many
+SELECT *
+FROM merchants
+WHERE id = ANY(@ids::uuid[])
+ORDER BY name;
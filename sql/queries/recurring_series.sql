@@ -0,0 +1,52 @@
+-- name: UpsertRecurringSeries :one
+INSERT INTO recurring_series (
+    name,
+    normalized_name,
+    frequency,
+    amount,
+    previous_amount,
+    average_amount,
+    occurrences,
+    first_date,
+    last_date,
+    next_date,
+    user_id,
+    payment_method_id,
+    category_id
+  )
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13
+  ) ON CONFLICT (user_id, payment_method_id, normalized_name) DO
+UPDATE
+SET name = EXCLUDED.name,
+  frequency = EXCLUDED.frequency,
+  amount = EXCLUDED.amount,
+  previous_amount = EXCLUDED.previous_amount,
+  average_amount = EXCLUDED.average_amount,
+  occurrences = EXCLUDED.occurrences,
+  first_date = EXCLUDED.first_date,
+  last_date = EXCLUDED.last_date,
+  next_date = EXCLUDED.next_date,
+  category_id = EXCLUDED.category_id
+RETURNING *;
+-- name: DeleteRecurringSeriesExcept :exec
+DELETE FROM recurring_series
+WHERE user_id = @user_id
+  AND NOT (id = ANY(@ids::uuid[]));
+-- name: ListRecurringSeriesByUserID :many
+SELECT *
+FROM recurring_series
+WHERE user_id = $1
+ORDER BY next_date ASC;
\ No newline at end of file
@@ -0,0 +1,55 @@
+-- name: CreateRule :one
+INSERT INTO rules (
+    name,
+    priority,
+    name_match_type,
+    name_pattern,
+    min_amount,
+    max_amount,
+    direction,
+    rename_to,
+    is_ignored,
+    user_id,
+    payment_method_id,
+    institution_id,
+    category_id,
+    tag_id
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+-- name: UpdateRule :exec
+UPDATE rules
+SET name = $2,
+  priority = $3,
+  name_match_type = $4,
+  name_pattern = $5,
+  min_amount = $6,
+  max_amount = $7,
+  direction = $8,
+  rename_to = $9,
+  is_ignored = $10,
+  payment_method_id = $11,
+  institution_id = $12,
+  category_id = $13,
+  tag_id = $14
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: DeleteRule :exec
+UPDATE rules
+SET deleted_at = now()
+WHERE id = $1;
+-- name: GetRuleByID :one
+SELECT *
+FROM rules
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: MergeRuleCategories :exec
+UPDATE rules
+SET category_id = @target_category_id::uuid
+WHERE user_id = @user_id
+  AND category_id = @source_category_id::uuid
+  AND deleted_at IS NULL;
+-- name: RemoveRulesTag :exec
+UPDATE rules
+SET tag_id = NULL
+WHERE tag_id = $1;
\ No newline at end of file
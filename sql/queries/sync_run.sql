@@ -0,0 +1,20 @@
+-- name: CreateSyncRun :one
+INSERT INTO sync_runs DEFAULT VALUES
+RETURNING *;
+-- name: FinishSyncRun :exec
+UPDATE sync_runs
+SET finished_at = now()
+WHERE id = $1;
+-- name: CreateSyncRunItems :copyfrom
+INSERT INTO sync_run_items (
+    sync_run_id,
+    account_id,
+    status,
+    fetched,
+    inserted,
+    updated,
+    skipped,
+    deleted,
+    failure_reason
+  )
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
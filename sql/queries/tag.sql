@@ -0,0 +1,18 @@
+-- name: CreateTag :one
+INSERT INTO tags (name, user_id)
+VALUES ($1, $2)
+RETURNING *;
+-- name: UpdateTag :exec
+UPDATE tags
+SET name = $2
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: DeleteTag :exec
+UPDATE tags
+SET deleted_at = NOW()
+WHERE id = $1;
+-- name: GetTagByID :one
+SELECT *
+FROM tags
+WHERE id = $1
+  AND deleted_at IS NULL;
\ No newline at end of file
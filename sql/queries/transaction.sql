@@ -9,19 +9,63 @@ INSERT INTO transactions (
     account_id,
     institution_id,
     category_id,
-    is_ignored
+    is_ignored,
+    purchase_date,
+    installment_number,
+    total_installments,
+    bill_id,
+    status,
+    merchant_id
   )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
--- name: CreateTransaction :exec
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12,
+    $13,
+    $14,
+    $15,
+    $16
+  );
+-- name: CreateTransaction :one
 INSERT INTO transactions (
     name,
     amount,
     payment_method_id,
     date,
     user_id,
-    category_id
+    category_id,
+    is_ignored,
+    notes,
+    account_id
   )
-VALUES ($1, $2, $3, $4, $5, $6);
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+-- name: ReconcileTransaction :exec
+UPDATE transactions
+SET external_id = $2,
+  name = $3,
+  amount = $4,
+  date = $5,
+  status = $6,
+  purchase_date = $7,
+  installment_number = $8,
+  total_installments = $9,
+  bill_id = $10,
+  payment_method_id = $11,
+  category_id = $12,
+  is_ignored = $13,
+  merchant_id = $14
+WHERE id = $1
+  AND deleted_at IS NULL;
 -- name: UpdateTransaction :exec
 UPDATE transactions
 SET name = $2,
@@ -30,19 +74,153 @@ SET name = $2,
   date = $5,
   account_id = $6,
   institution_id = $7,
-  category_id = $8
+  category_id = $8,
+  notes = $10,
+  is_name_overridden = is_name_overridden
+  OR name <> $2,
+  is_amount_overridden = is_amount_overridden
+  OR amount <> $3,
+  is_date_overridden = is_date_overridden
+  OR date <> $5,
+  is_category_overridden = is_category_overridden
+  OR category_id <> $8,
+  is_payment_method_overridden = is_payment_method_overridden
+  OR payment_method_id <> $4
 WHERE id = $1
   AND user_id = $9
   AND deleted_at IS NULL;
+-- name: ApplyTransactionRules :exec
+UPDATE transactions
+SET name = $2,
+  category_id = $3,
+  is_ignored = $4
+WHERE id = $1
+  AND deleted_at IS NULL;
 -- name: GetTransactionByID :one
 SELECT transactions.*,
   transaction_categories.name as category_name,
   institutions.name as institution_name,
   institutions.logo as institution_logo,
-  payment_methods.name as payment_method_name
+  payment_methods.name as payment_method_name,
+  merchants.name as merchant_name,
+  merchants.logo as merchant_logo
 FROM transactions
   LEFT JOIN transaction_categories ON transactions.category_id = transaction_categories.id
   LEFT JOIN institutions ON transactions.institution_id = institutions.id
   LEFT JOIN payment_methods ON transactions.payment_method_id = payment_methods.id
+  LEFT JOIN merchants ON transactions.merchant_id = merchants.id
 WHERE transactions.id = $1
-  AND transactions.deleted_at IS NULL;
\ No newline at end of file
+  AND transactions.deleted_at IS NULL
+  AND NOT transactions.is_hidden;
+-- name: DeleteTransactions :exec
+UPDATE transactions
+SET deleted_at = NOW()
+WHERE id = ANY(@ids::uuid[])
+  AND deleted_at IS NULL;
+-- name: DeleteTransactionsByAccountID :exec
+UPDATE transactions
+SET deleted_at = NOW()
+WHERE account_id = $1
+  AND deleted_at IS NULL;
+-- name: ListInstallmentTransactions :many
+SELECT DISTINCT ON (
+    account_id,
+    purchase_date,
+    regexp_replace(name, '\s*\d+/\d+\s*$', ''),
+    amount,
+    total_installments
+  ) *
+FROM transactions
+WHERE user_id = $1
+  AND installment_number IS NOT NULL
+  AND total_installments IS NOT NULL
+  AND date_trunc('month', date) + make_interval(months => total_installments - installment_number) >= date_trunc('month', NOW())
+  AND deleted_at IS NULL
+  AND NOT is_hidden
+ORDER BY account_id,
+  purchase_date,
+  regexp_replace(name, '\s*\d+/\d+\s*$', ''),
+  amount,
+  total_installments,
+  installment_number DESC;
+-- name: ListTransactionExternalIDs :many
+SELECT external_id::text
+FROM transactions
+WHERE user_id = @user_id
+  AND external_id = ANY(@external_ids::text[]);
+-- name: MergeTransactionCategories :exec
+UPDATE transactions
+SET category_id = @target_category_id
+WHERE user_id = @user_id
+  AND category_id = @source_category_id
+  AND deleted_at IS NULL;
+-- name: HideTransaction :exec
+UPDATE transactions
+SET deleted_at = NOW(),
+  is_hidden = true
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: HideTransactions :exec
+UPDATE transactions
+SET deleted_at = NOW(),
+  is_hidden = true
+WHERE id = ANY(@ids::uuid[])
+  AND deleted_at IS NULL;
+-- name: HideTransactionsByUserInstitutionID :exec
+UPDATE transactions
+SET is_hidden = true
+WHERE account_id IN (
+    SELECT id
+    FROM accounts
+    WHERE user_institution_id = $1
+  )
+  AND deleted_at IS NULL;
+-- name: UpdateTransactionsCategory :exec
+UPDATE transactions
+SET category_id = @category_id,
+  is_category_overridden = true
+WHERE id = ANY(@ids::uuid[])
+  AND user_id = @user_id
+  AND deleted_at IS NULL;
+-- name: UpdateTransactionsIsIgnored :exec
+UPDATE transactions
+SET is_ignored = @is_ignored,
+  is_category_overridden = true
+WHERE id = ANY(@ids::uuid[])
+  AND user_id = @user_id
+  AND deleted_at IS NULL;
+-- name: GetDeletedTransactionByID :one
+SELECT *
+FROM transactions
+WHERE id = $1
+  AND deleted_at IS NOT NULL;
+-- name: ListDeletedTransactions :many
+SELECT *
+FROM transactions
+WHERE user_id = $1
+  AND deleted_at >= $2
+  AND (
+    external_id IS NULL
+    OR external_id LIKE 'import:%'
+    OR is_hidden
+  )
+ORDER BY deleted_at DESC;
+-- name: RestoreTransaction :exec
+UPDATE transactions
+SET deleted_at = NULL,
+  is_hidden = false
+WHERE id = $1
+  AND deleted_at IS NOT NULL;
+-- name: PurgeDeletedTransactions :exec
+DELETE FROM transactions
+WHERE deleted_at < $1
+  AND (
+    external_id IS NULL
+    OR external_id LIKE 'import:%'
+  );
+-- name: ListHiddenTransactionExternalIDs :many
+SELECT external_id::text
+FROM transactions
+WHERE user_id = $1
+  AND is_hidden
+  AND external_id IS NOT NULL;
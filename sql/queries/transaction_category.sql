@@ -1,6 +1,22 @@
 -- name: CreateTransactionCategories :copyfrom
 INSERT INTO transaction_categories (external_id, name)
 VALUES ($1, $2);
+-- name: CreateTransactionCategory :one
+INSERT INTO transaction_categories (name, icon, color, user_id, parent_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+-- name: UpdateTransactionCategory :exec
+UPDATE transaction_categories
+SET name = $2,
+  icon = $3,
+  color = $4,
+  parent_id = $5
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: DeleteTransactionCategory :exec
+UPDATE transaction_categories
+SET deleted_at = now()
+WHERE id = $1;
 -- name: GetTransactionCategoryByID :one
 SELECT *
 FROM transaction_categories
@@ -0,0 +1,30 @@
+-- name: CreateTransactionSplits :copyfrom
+INSERT INTO transaction_splits (amount, note, transaction_id, category_id)
+VALUES ($1, $2, $3, $4);
+-- name: DeleteTransactionSplits :exec
+UPDATE transaction_splits
+SET deleted_at = NOW()
+WHERE transaction_splits.transaction_id = $1
+  AND transaction_splits.deleted_at IS NULL;
+-- name: ListSplitTransactionIDs :many
+SELECT DISTINCT transaction_splits.transaction_id
+FROM transaction_splits
+  JOIN transactions ON transaction_splits.transaction_id = transactions.id
+WHERE transactions.user_id = $1
+  AND transaction_splits.deleted_at IS NULL;
+-- name: ListTransactionSplits :many
+SELECT *
+FROM transaction_splits
+WHERE transaction_id = $1
+  AND deleted_at IS NULL
+ORDER BY amount ASC;
+-- name: MergeTransactionSplitCategories :exec
+UPDATE transaction_splits
+SET category_id = @target_category_id
+WHERE category_id = @source_category_id
+  AND deleted_at IS NULL
+  AND transaction_id IN (
+    SELECT id
+    FROM transactions
+    WHERE user_id = @user_id
+  );
\ No newline at end of file
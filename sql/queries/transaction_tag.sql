@@ -0,0 +1,33 @@
+-- name: CreateTransactionTags :copyfrom
+INSERT INTO transaction_tags (transaction_id, tag_id)
+VALUES ($1, $2);
+-- name: DeleteTransactionTags :exec
+DELETE FROM transaction_tags
+WHERE transaction_id = $1;
+-- name: DeleteTransactionTagsByTagID :exec
+DELETE FROM transaction_tags
+WHERE tag_id = $1;
+-- name: ListTransactionTags :many
+SELECT transaction_tags.transaction_id,
+  sqlc.embed(tags)
+FROM transaction_tags
+  JOIN tags ON transaction_tags.tag_id = tags.id
+WHERE transaction_tags.transaction_id = ANY(@transaction_ids::uuid[])
+  AND tags.deleted_at IS NULL
+ORDER BY tags.name ASC;
+-- name: TagTransactions :exec
+INSERT INTO transaction_tags (transaction_id, tag_id)
+SELECT unnest(@transaction_ids::uuid[]),
+  @tag_id::uuid ON CONFLICT DO NOTHING;
+-- name: TagTransactionsByExternalIDs :exec
+INSERT INTO transaction_tags (transaction_id, tag_id)
+SELECT id,
+  @tag_id::uuid
+FROM transactions
+WHERE user_id = @user_id
+  AND external_id = ANY(@external_ids::text[])
+  AND deleted_at IS NULL ON CONFLICT DO NOTHING;
+-- name: UntagTransactions :exec
+DELETE FROM transaction_tags
+WHERE transaction_id = ANY(@transaction_ids::uuid[])
+  AND tag_id = @tag_id;
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- name: CreateTransfers :copyfrom
+INSERT INTO transfers (
+    status,
+    user_id,
+    outgoing_transaction_id,
+    incoming_transaction_id
+  )
+VALUES ($1, $2, $3, $4);
+-- name: GetTransferByID :one
+SELECT *
+FROM transfers
+WHERE id = $1;
+-- name: ListTransfersByUserID :many
+SELECT *
+FROM transfers
+WHERE user_id = $1
+ORDER BY created_at DESC;
+-- name: UpdateTransferStatus :exec
+UPDATE transfers
+SET status = $2
+WHERE id = $1;
\ No newline at end of file
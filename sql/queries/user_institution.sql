@@ -6,4 +6,17 @@ RETURNING *;
 SELECT *
 FROM user_institutions
 WHERE external_id = $1
-  AND deleted_at IS NULL;
\ No newline at end of file
+  AND deleted_at IS NULL;
+-- name: GetUserInstitutionByID :one
+SELECT *
+FROM user_institutions
+WHERE id = $1
+  AND deleted_at IS NULL;
+-- name: UpdateUserInstitutionStatus :exec
+UPDATE user_institutions
+SET status = $2
+WHERE id = $1;
+-- name: DeleteUserInstitution :exec
+UPDATE user_institutions
+SET deleted_at = NOW()
+WHERE id = $1;
\ No newline at end of file
@@ -26,3 +26,76 @@ func (tdb *TestDB) GetLatestTransactionByUserID(
 
 	return dest, nil
 }
+
+func (tdb *TestDB) GetLatestSyncedTransactionByUserID(
+	ctx context.Context,
+	userID string,
+) (*entity.Transaction, error) {
+	query := goqu.
+		Select(schema.Transaction.All()).
+		From(schema.Transaction.String()).
+		Where(
+			goqu.Ex{schema.Transaction.UserID(): userID},
+			goqu.I(schema.Transaction.ExternalID()).IsNotNull(),
+			goqu.I(schema.Transaction.DeletedAt()).IsNull(),
+		).
+		Order(goqu.I(schema.Transaction.CreatedAt()).Desc()).
+		Limit(1)
+
+	dest := &entity.Transaction{}
+	if err := tdb.Scan(ctx, query, dest); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+func (tdb *TestDB) CountUserInstitutionTransactions(
+	ctx context.Context,
+	userInstitutionID string,
+) (int64, error) {
+	return tdb.countUserInstitutionTransactions(ctx, userInstitutionID, nil)
+}
+
+func (tdb *TestDB) CountUserInstitutionHiddenTransactions(
+	ctx context.Context,
+	userInstitutionID string,
+) (int64, error) {
+	return tdb.countUserInstitutionTransactions(
+		ctx,
+		userInstitutionID,
+		goqu.I(schema.Transaction.IsHidden()).IsTrue(),
+	)
+}
+
+func (tdb *TestDB) countUserInstitutionTransactions(
+	ctx context.Context,
+	userInstitutionID string,
+	filter goqu.Expression,
+) (int64, error) {
+	query := goqu.
+		Select(goqu.COUNT(schema.Transaction.All())).
+		From(schema.Transaction.String()).
+		Join(
+			goqu.I(schema.Account.String()),
+			goqu.On(
+				goqu.I(schema.Transaction.AccountID()).
+					Eq(goqu.I(schema.Account.ID())),
+			),
+		).
+		Where(
+			goqu.Ex{schema.Account.UserInstitutionID(): userInstitutionID},
+			goqu.I(schema.Transaction.DeletedAt()).IsNull(),
+		)
+
+	if filter != nil {
+		query = query.Where(filter)
+	}
+
+	var count int64
+	if err := tdb.Scan(ctx, query, &count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/schema"
+	"github.com/doug-martin/goqu/v9"
+)
+
+func (tdb *TestDB) GetUserInstitutionByID(
+	ctx context.Context,
+	id string,
+) (*entity.UserInstitution, error) {
+	query := goqu.
+		Select(schema.UserInstitution.All()).
+		From(schema.UserInstitution.String()).
+		Where(goqu.Ex{schema.UserInstitution.ID(): id}).
+		Limit(1)
+
+	dest := &entity.UserInstitution{}
+	if err := tdb.Scan(ctx, query, dest); err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
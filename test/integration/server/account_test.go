@@ -4,12 +4,18 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/handler"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/account"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
 	"github.com/danielmesquitta/api-finance-manager/internal/pkg/dateutil"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -173,3 +179,409 @@ func TestGetAccountsBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestListCreditCardBills(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description  string
+		token        string
+		accountID    string
+		expectedCode int
+	}{
+		{
+			description:  "fails without token",
+			token:        "",
+			accountID:    "8567ca77-ac20-4526-b3d9-dbf380a1c00d",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "fails with non-existing account",
+			token:        mockoauth.PremiumTierMockToken,
+			accountID:    "4f5d0d6f-2f0f-4b52-8d38-4d7bb3b4f0b5",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			description:  "fails with bank account",
+			token:        mockoauth.PremiumTierMockToken,
+			accountID:    "e5f31705-cb65-42a5-9072-2b9b59e338a8",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "lists credit card bills",
+			token:        mockoauth.PremiumTierMockToken,
+			accountID:    "8567ca77-ac20-4526-b3d9-dbf380a1c00d",
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var actualResponse dto.ListCreditCardBillsResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodGet,
+				"/api/v1/accounts/"+test.accountID+"/bills",
+				WithBearerToken(signInRes.AccessToken),
+				WithResponse(&actualResponse),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if test.expectedCode != http.StatusOK {
+				return
+			}
+
+			assert.NotNil(t, actualResponse.Bills)
+		})
+	}
+}
+
+func TestManualAccounts(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	var wallet dto.CreateManualAccountResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/accounts/manual",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateManualAccountRequest{
+			CreateManualAccountUseCaseInput: account.CreateManualAccountUseCaseInput{
+				Type:           entity.AccountTypeWallet,
+				OpeningBalance: 100_00,
+			},
+		}),
+		WithResponse(&wallet),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+	assert.Equal(t, "Carteira", wallet.Name)
+	assert.Equal(t, int64(100_00), wallet.Balance)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/accounts/manual",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateManualAccountRequest{
+			CreateManualAccountUseCaseInput: account.CreateManualAccountUseCaseInput{
+				Type: entity.AccountTypeWallet,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Padaria",
+				Amount: -25_00,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date:      time.Now().Add(-time.Hour),
+				AccountID: &wallet.ID,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Padaria",
+				Amount: -25_00,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date: time.Now(),
+				AccountID: ptr.New(
+					uuid.MustParse("e5f31705-cb65-42a5-9072-2b9b59e338a8"),
+				),
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		"/api/v1/accounts/manual/"+wallet.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.UpdateManualAccountRequest{
+			UpdateManualAccountUseCaseInput: account.UpdateManualAccountUseCaseInput{
+				OpeningBalance: ptr.New(int64(200_00)),
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	var listResponse dto.ListManualAccountsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/manual",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&listResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	if assert.Len(t, listResponse.Items, 1) {
+		assert.Equal(t, "Carteira", listResponse.Items[0].Name)
+		assert.Equal(t, int64(175_00), listResponse.Items[0].Balance)
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		"/api/v1/accounts/manual/"+wallet.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		"/api/v1/accounts/manual/"+wallet.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode, rawBody)
+}
+
+func TestGetAccountsBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	var wallet dto.CreateManualAccountResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/accounts/manual",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateManualAccountRequest{
+			CreateManualAccountUseCaseInput: account.CreateManualAccountUseCaseInput{
+				Type:           entity.AccountTypeWallet,
+				OpeningBalance: 100_00,
+			},
+		}),
+		WithResponse(&wallet),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+
+	var historyResponse dto.GetAccountsBalanceHistoryResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/balances/history",
+		WithBearerToken(signInRes.AccessToken),
+		WithQueryParams(map[string]string{
+			handler.QueryParamInterval:   string(entity.BalanceIntervalDay),
+			handler.QueryParamAccountIDs: wallet.ID.String(),
+		}),
+		WithResponse(&historyResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, historyResponse.NetWorth, 30)
+	for _, point := range historyResponse.NetWorth {
+		assert.Equal(t, int64(100_00), point.Balance)
+	}
+	if assert.Len(t, historyResponse.Series, 1) {
+		assert.Equal(t, &wallet.ID, historyResponse.Series[0].AccountID)
+		assert.Len(t, historyResponse.Series[0].Points, 30)
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/balances/history",
+		WithBearerToken(signInRes.AccessToken),
+		WithQueryParams(map[string]string{
+			handler.QueryParamInterval: "year",
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/balances/history",
+		WithBearerToken(signInRes.AccessToken),
+		WithQueryParams(map[string]string{
+			handler.QueryParamInterval:  string(entity.BalanceIntervalDay),
+			handler.QueryParamStartDate: "2020-01-01T00:00:00Z",
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+}
+
+func TestGetAccountsSyncStatus(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+	userID := signInRes.User.ID
+
+	// The open finance provider has no transactions for this account, so
+	// its sync fails while the other accounts succeed
+	failingAccountID := uuid.New()
+	_, err := app.db.CreateAccounts(
+		context.Background(),
+		[]sqlc.CreateAccountsParams{
+			{
+				ID:         failingAccountID,
+				ExternalID: uuid.NewString(),
+				Name:       "Conta inexistente",
+				Type:       entity.AccountTypeBank,
+				UserInstitutionID: ptr.New(
+					uuid.MustParse("d237bbc3-8f60-4a78-9282-8e3f1dbe1630"),
+				),
+				UserID: userID,
+			},
+		},
+	)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	var beforeSyncResponse dto.GetAccountsSyncStatusResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/sync-status",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&beforeSyncResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, beforeSyncResponse.Accounts, 5)
+	for _, status := range beforeSyncResponse.Accounts {
+		assert.Nil(t, status.Status)
+		assert.Nil(t, status.LastSyncAt)
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/admin/transactions/sync",
+		WithBasicAuth(),
+		WithQueryParams(map[string]string{
+			handler.QueryParamUserIDs: userID.String(),
+		}),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusNoContent, statusCode, rawBody) {
+		return
+	}
+
+	assertSyncStatuses := func(
+		t *testing.T,
+		statuses []entity.AccountSyncStatus,
+	) {
+		if !assert.Len(t, statuses, 5) {
+			return
+		}
+
+		for _, status := range statuses {
+			if !assert.NotNil(t, status.Status) {
+				continue
+			}
+			assert.NotNil(t, status.LastSyncAt)
+
+			if status.AccountID == failingAccountID {
+				assert.Equal(t, entity.SyncRunItemStatusFailed, *status.Status)
+				if assert.NotNil(t, status.FailureReason) {
+					assert.Contains(
+						t,
+						*status.FailureReason,
+						"error fetching open finance transactions",
+					)
+				}
+				assert.Nil(t, status.LastSuccessAt)
+				continue
+			}
+
+			assert.Equal(t, entity.SyncRunItemStatusSuccess, *status.Status)
+			assert.Nil(t, status.FailureReason)
+			assert.NotNil(t, status.LastSuccessAt)
+			if assert.NotNil(t, status.Fetched) {
+				assert.Positive(t, *status.Fetched)
+			}
+		}
+	}
+
+	var userResponse dto.GetAccountsSyncStatusResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/accounts/sync-status",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&userResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assertSyncStatuses(t, userResponse.Accounts)
+
+	var adminResponse dto.GetAccountsSyncStatusResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/admin/users/"+userID.String()+"/accounts/sync-status",
+		WithBasicAuth(),
+		WithResponse(&adminResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assertSyncStatuses(t, adminResponse.Accounts)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/admin/users/"+userID.String()+"/accounts/sync-status",
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, statusCode, rawBody)
+}
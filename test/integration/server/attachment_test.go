@@ -0,0 +1,151 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// pngReceipt starts with the PNG signature, which is enough for the content
+// type detection to identify it as an image.
+const pngReceipt = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDRreceipt"
+
+func newMultipartFile(
+	t *testing.T,
+	name string,
+	content []byte,
+) ([]byte, string) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreateFormFile("file", name)
+	assert.Nil(t, err)
+
+	_, err = part.Write(content)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	return body.Bytes(), w.FormDataContentType()
+}
+
+func TestTransactionAttachments(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Farmácia",
+				Amount: -4590,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date: time.Now(),
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+
+	createdTransaction, err := app.db.GetLatestTransactionByUserID(
+		context.Background(),
+		signInRes.User.ID.String(),
+	)
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	path := fmt.Sprintf(
+		"/api/v1/transactions/%s/attachments",
+		createdTransaction.ID,
+	)
+
+	body, contentType := newMultipartFile(t, "notes.txt", []byte("plain text"))
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		path,
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	content := []byte(pngReceipt)
+	body, contentType = newMultipartFile(t, "receipt.png", content)
+
+	var uploadResponse dto.UploadAttachmentResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		path,
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+		WithResponse(&uploadResponse),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+	assert.Equal(t, "receipt.png", uploadResponse.Name)
+	assert.Equal(t, "image/png", uploadResponse.ContentType)
+	assert.Equal(t, int64(len(content)), uploadResponse.Size)
+
+	var listResponse dto.ListAttachmentsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		path,
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&listResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, listResponse.Items, 1)
+
+	attachmentPath := fmt.Sprintf("%s/%s", path, uploadResponse.ID)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		attachmentPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Equal(t, string(content), rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		attachmentPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		attachmentPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode, rawBody)
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/goal"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoals(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/goals",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateGoalRequest{
+			CreateGoalUseCaseInput: goal.CreateGoalUseCaseInput{
+				GoalInput: goal.GoalInput{
+					Name:     "Viagem",
+					Deadline: time.Now().AddDate(1, 0, 0),
+				},
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	var emergencyReserve dto.CreateGoalResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/goals",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateGoalRequest{
+			CreateGoalUseCaseInput: goal.CreateGoalUseCaseInput{
+				GoalInput: goal.GoalInput{
+					Name:     "Reserva de emergência",
+					Deadline: time.Now().AddDate(2, 0, 0),
+					EmergencyReserve: &goal.EmergencyReserveInput{
+						JobType:         entity.JobTypeEmployee,
+						MonthlyExpenses: 3_000_00,
+					},
+				},
+			},
+		}),
+		WithResponse(&emergencyReserve),
+	)
+	assert.Nil(t, err)
+	if assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		assert.Equal(t, int64(18_000_00), emergencyReserve.TargetAmount)
+	}
+
+	var trip dto.CreateGoalResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/goals",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateGoalRequest{
+			CreateGoalUseCaseInput: goal.CreateGoalUseCaseInput{
+				GoalInput: goal.GoalInput{
+					Name:                "Viagem",
+					TargetAmount:        1_200_00,
+					Deadline:            time.Now().AddDate(1, 0, 1),
+					MonthlyContribution: 100_00,
+				},
+			},
+		}),
+		WithResponse(&trip),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/goals/"+trip.ID.String()+"/contributions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateGoalContributionRequest{
+			CreateGoalContributionUseCaseInput: goal.CreateGoalContributionUseCaseInput{
+				Amount: 300_00,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	var getResponse dto.GetGoalResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/goals/"+trip.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&getResponse),
+	)
+	assert.Nil(t, err)
+	if assert.Equal(t, http.StatusOK, statusCode, rawBody) {
+		assert.Equal(t, int64(300_00), getResponse.CurrentAmount)
+		assert.Equal(t, int64(900_00), getResponse.RemainingAmount)
+		assert.Equal(t, int64(25_00), getResponse.ProgressPercentage)
+		assert.Equal(t, 12, getResponse.RemainingMonths)
+		assert.Equal(t, int64(75_00), getResponse.SuggestedMonthlyContribution)
+		assert.NotNil(t, getResponse.ProjectedCompletionDate)
+	}
+
+	var listResponse dto.ListGoalsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/goals",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&listResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, listResponse.Items, 2)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		"/api/v1/goals/"+trip.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/goals/"+trip.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode, rawBody)
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/stretchr/testify/assert"
+)
+
+const importCSV = "Data;Descrição;Valor\n" +
+	"05/01/2024;Padaria;-12,50\n" +
+	"05/01/2024;Padaria;-12,50\n" +
+	"06/01/2024;Salário;1.500,00\n"
+
+func newImportForm(
+	t *testing.T,
+	fields map[string]string,
+	content string,
+) ([]byte, string) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	for name, value := range fields {
+		assert.Nil(t, w.WriteField(name, value))
+	}
+
+	part, err := w.CreateFormFile("file", "extrato.csv")
+	assert.Nil(t, err)
+
+	_, err = part.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+
+	return body.Bytes(), w.FormDataContentType()
+}
+
+func TestImportTransactions(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	fields := map[string]string{
+		"account_name":      "Banco pequeno",
+		"payment_method_id": "5d140153-c072-42ce-b19c-c5c9b528dba4",
+	}
+
+	body, contentType := newImportForm(t, fields, importCSV)
+	var previewResponse dto.ImportTransactionsResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/imports/preview",
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+		WithResponse(&previewResponse),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusOK, statusCode, rawBody) {
+		return
+	}
+	assert.Equal(t, 3, previewResponse.Total)
+	assert.Equal(t, 0, previewResponse.Imported)
+	assert.Nil(t, previewResponse.AccountID)
+	assert.Len(t, previewResponse.Items, 3)
+
+	var importResponse dto.ImportTransactionsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/imports",
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+		WithResponse(&importResponse),
+	)
+	assert.Nil(t, err)
+	if !assert.Equal(t, http.StatusCreated, statusCode, rawBody) {
+		return
+	}
+	assert.Equal(t, 3, importResponse.Imported)
+	if !assert.NotNil(t, importResponse.AccountID) {
+		return
+	}
+
+	fields = map[string]string{
+		"account_id":        importResponse.AccountID.String(),
+		"payment_method_id": fields["payment_method_id"],
+	}
+	body, contentType = newImportForm(
+		t,
+		fields,
+		importCSV+"07/01/2024;Mercado;-80,00\n",
+	)
+
+	importResponse = dto.ImportTransactionsResponse{}
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/imports",
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+		WithResponse(&importResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+	assert.Equal(t, 4, importResponse.Total)
+	assert.Equal(t, 3, importResponse.Duplicates)
+	assert.Equal(t, 1, importResponse.Imported)
+
+	// Imported transactions belong to the user, so they are deleted instead
+	// of hidden
+	importedTransaction, err := app.db.GetLatestTransactionByUserID(
+		context.Background(),
+		signInRes.User.ID.String(),
+	)
+	if !assert.Nil(t, err) {
+		return
+	}
+	importedTransactionPath := "/api/v1/transactions/" +
+		importedTransaction.ID.String()
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		importedTransactionPath+"/hidden",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		importedTransactionPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	body, contentType = newImportForm(t, fields, "foo,bar\n1,2\n")
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/imports",
+		WithBearerToken(signInRes.AccessToken),
+		WithRawBody(body, contentType),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/handler"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/institution"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/mockpluggy"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/pluggy"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -192,7 +198,7 @@ func TestListUserInstitutions(t *testing.T) {
 				signInRes = app.SignIn(test.token)
 			}
 
-			var out dto.ListInstitutionsResponse
+			var out dto.ListUserInstitutionsResponse
 			statusCode, rawBody, err := app.MakeRequest(
 				http.MethodGet,
 				"/api/v1/users/institutions",
@@ -223,6 +229,7 @@ func TestListUserInstitutions(t *testing.T) {
 			institutionIDs := make([]string, len(out.Items))
 			for i, institution := range out.Items {
 				institutionIDs[i] = institution.ID.String()
+				assert.NotNil(t, institution.ConnectionStatus)
 			}
 
 			assert.ElementsMatch(
@@ -233,3 +240,350 @@ func TestListUserInstitutions(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateConnectToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description         string
+		token               string
+		expectedCode        int
+		expectedAccessToken string
+	}{
+		{
+			description:  "fails without token",
+			token:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "fails for free tier user",
+			token:        mockoauth.FreeTierMockToken,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:         "creates connect token",
+			token:               mockoauth.PremiumTierMockToken,
+			expectedCode:        http.StatusCreated,
+			expectedAccessToken: mockpluggy.MockConnectToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var out dto.CreateConnectTokenResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/institutions/connect-token",
+				WithBearerToken(signInRes.AccessToken),
+				WithResponse(&out),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			assert.Equal(t, test.expectedAccessToken, out.AccessToken)
+		})
+	}
+}
+
+func TestDeleteUserInstitution(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description                string
+		token                      string
+		userInstitutionID          string
+		queryParams                map[string]string
+		expectedCode               int
+		expectedTransactionsHidden bool
+	}{
+		{
+			description:       "fails without token",
+			token:             "",
+			userInstitutionID: "d237bbc3-8f60-4a78-9282-8e3f1dbe1630",
+			expectedCode:      http.StatusBadRequest,
+		},
+		{
+			description:       "fails with non-existing",
+			token:             mockoauth.PremiumTierMockToken,
+			userInstitutionID: "4f5d0d6f-2f0f-4b52-8d38-4d7bb3b4f0b5",
+			expectedCode:      http.StatusNotFound,
+		},
+		{
+			description:       "deletes user institution keeping transactions",
+			token:             mockoauth.PremiumTierMockToken,
+			userInstitutionID: "d237bbc3-8f60-4a78-9282-8e3f1dbe1630",
+			expectedCode:      http.StatusNoContent,
+		},
+		{
+			description:       "deletes user institution hiding transactions",
+			token:             mockoauth.PremiumTierMockToken,
+			userInstitutionID: "d237bbc3-8f60-4a78-9282-8e3f1dbe1630",
+			queryParams: map[string]string{
+				handler.QueryParamHideTransactions: "true",
+			},
+			expectedCode:               http.StatusNoContent,
+			expectedTransactionsHidden: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			transactionsBefore, err := app.db.CountUserInstitutionTransactions(
+				ctx,
+				test.userInstitutionID,
+			)
+			assert.Nil(t, err)
+
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodDelete,
+				"/api/v1/users/institutions/"+test.userInstitutionID,
+				WithQueryParams(test.queryParams),
+				WithBearerToken(signInRes.AccessToken),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if test.expectedCode != http.StatusNoContent {
+				return
+			}
+
+			userInstitution, err := app.db.GetUserInstitutionByID(
+				ctx,
+				test.userInstitutionID,
+			)
+			assert.Nil(t, err)
+			assert.NotNil(t, userInstitution.DeletedAt)
+
+			transactionsAfter, err := app.db.CountUserInstitutionTransactions(
+				ctx,
+				test.userInstitutionID,
+			)
+			assert.Nil(t, err)
+
+			// Transactions are never deleted, hidden ones stay out of the trash
+			assert.Equal(t, transactionsBefore, transactionsAfter)
+
+			hiddenTransactions, err := app.db.CountUserInstitutionHiddenTransactions(
+				ctx,
+				test.userInstitutionID,
+			)
+			assert.Nil(t, err)
+
+			if test.expectedTransactionsHidden {
+				assert.NotZero(t, hiddenTransactions)
+				assert.Equal(t, transactionsBefore, hiddenTransactions)
+				return
+			}
+
+			assert.Zero(t, hiddenTransactions)
+		})
+	}
+}
+
+func TestOpenFinanceWebhook(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userInstitutionID = "d237bbc3-8f60-4a78-9282-8e3f1dbe1630"
+		itemID            = "8e7b5824-e232-4f90-b5ee-da347fd659c9"
+	)
+
+	tests := []struct {
+		description string
+		// events are sent in order, the last one is the tested event.
+		events         []string
+		itemID         string
+		secret         bool
+		expectedCode   int
+		expectedStatus string
+		expectedDelete bool
+		expectedSync   bool
+	}{
+		{
+			description:  "fails without secret",
+			events:       []string{pluggy.WebhookEventItemLoginError},
+			itemID:       itemID,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:    "ignores unknown item",
+			events:         []string{pluggy.WebhookEventItemLoginError},
+			itemID:         "c0c3ee9e-7b1b-4b7f-9d39-6a5e1f0f9f52",
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusConnected,
+		},
+		{
+			description:    "ignores unhandled event",
+			events:         []string{pluggy.WebhookEventItemCreated},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusConnected,
+		},
+		{
+			description:    "flags login error",
+			events:         []string{pluggy.WebhookEventItemLoginError},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusNeedsReconnection,
+		},
+		{
+			description:    "flags waiting user input",
+			events:         []string{pluggy.WebhookEventItemWaitingUserInput},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusNeedsReconnection,
+		},
+		{
+			description: "reconnects and syncs on update",
+			events: []string{
+				pluggy.WebhookEventItemLoginError,
+				pluggy.WebhookEventItemUpdated,
+			},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusConnected,
+			expectedSync:   true,
+		},
+		{
+			description:    "deletes on item deleted",
+			events:         []string{pluggy.WebhookEventItemDeleted},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusConnected,
+			expectedDelete: true,
+		},
+		{
+			description:    "deletes on consent revoked",
+			events:         []string{pluggy.WebhookEventItemConsentRevoked},
+			itemID:         itemID,
+			secret:         true,
+			expectedCode:   http.StatusNoContent,
+			expectedStatus: entity.UserInstitutionStatusConnected,
+			expectedDelete: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			var opts []RequestOption
+			if test.secret {
+				opts = append(opts, WithWebhookSecret())
+			}
+
+			var statusCode int
+			var rawBody string
+			for _, event := range test.events {
+				var err error
+				statusCode, rawBody, err = app.MakeRequest(
+					http.MethodPost,
+					"/api/v1/webhooks/open-finance",
+					append(opts, WithBody(dto.HandleOpenFinanceWebhookRequest{
+						HandleOpenFinanceWebhookUseCaseInput: institution.HandleOpenFinanceWebhookUseCaseInput{
+							EventID: uuid.NewString(),
+							Event:   event,
+							ItemID:  test.itemID,
+						},
+					}))...,
+				)
+				assert.Nil(t, err)
+			}
+
+			assert.Equal(t, test.expectedCode, statusCode, rawBody)
+			if test.expectedCode != http.StatusNoContent {
+				return
+			}
+
+			userInstitution, err := app.db.GetUserInstitutionByID(
+				ctx,
+				userInstitutionID,
+			)
+			if !assert.Nil(t, err) {
+				return
+			}
+			assert.Equal(t, test.expectedStatus, userInstitution.Status)
+			assert.Equal(t, test.expectedDelete, userInstitution.DeletedAt != nil)
+
+			if !test.expectedSync {
+				return
+			}
+
+			// The sync runs in background after the webhook is answered
+			signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+			assert.Eventually(t, func() bool {
+				var res dto.GetAccountsSyncStatusResponse
+				_, _, err := app.MakeRequest(
+					http.MethodGet,
+					"/api/v1/accounts/sync-status",
+					WithBearerToken(signInRes.AccessToken),
+					WithResponse(&res),
+				)
+				if err != nil {
+					return false
+				}
+
+				synced := 0
+				for _, status := range res.Accounts {
+					if status.LastSyncAt != nil {
+						synced++
+					}
+				}
+				// Only the accounts of the updated institution are synced
+				return synced == 2
+			}, 30*time.Second, time.Second)
+		})
+	}
+}
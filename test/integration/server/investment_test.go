@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/openfinance/mockpluggy"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInvestments(t *testing.T) {
+	t.Parallel()
+
+	type Test struct {
+		description  string
+		token        string
+		expectedCode int
+	}
+
+	tests := []Test{
+		{
+			description:  "fails without token",
+			token:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "gets investments",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var actualResponse dto.GetInvestmentsResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodGet,
+				"/api/v1/investments",
+				WithBearerToken(signInRes.AccessToken),
+				WithResponse(&actualResponse),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if statusCode != http.StatusOK {
+				return
+			}
+
+			var totalBalance int64
+			for _, investment := range actualResponse.Investments {
+				totalBalance += investment.Balance
+			}
+			assert.Equal(t, totalBalance, actualResponse.TotalBalance)
+			assert.NotNil(t, actualResponse.ByType)
+			assert.NotNil(t, actualResponse.ByInstitution)
+		})
+	}
+}
+
+func TestSyncInvestments(t *testing.T) {
+	t.Parallel()
+
+	const staleExternalID = "stale-investment"
+
+	ctx := context.Background()
+	mockOpenFinance := mockpluggy.NewClient(nil)
+
+	var expectedInvestments int
+	var expectedBalance int64
+	for _, itemID := range []string{
+		"8e7b5824-e232-4f90-b5ee-da347fd659c9",
+		"5de68b44-d82b-482a-a3b6-b2189f201e6b",
+	} {
+		investments, err := mockOpenFinance.ListInvestments(ctx, itemID)
+		if !assert.Nil(t, err) {
+			return
+		}
+		expectedInvestments += len(investments)
+		for _, investment := range investments {
+			expectedBalance += investment.Balance
+		}
+	}
+
+	tests := []struct {
+		description  string
+		basicAuth    bool
+		expectedCode int
+	}{
+		{
+			description:  "fails without basic auth",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			description:  "syncs investments",
+			basicAuth:    true,
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			// An investment the provider no longer returns
+			_, err := app.db.UpsertInvestment(ctx, sqlc.UpsertInvestmentParams{
+				ExternalID:        staleExternalID,
+				Name:              "CDB",
+				Type:              entity.InvestmentTypeFixedIncome,
+				UserInstitutionID: uuid.MustParse("d237bbc3-8f60-4a78-9282-8e3f1dbe1630"),
+			})
+			assert.Nil(t, err)
+
+			var opts []RequestOption
+			if test.basicAuth {
+				opts = append(opts, WithBasicAuth())
+			}
+
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/admin/investments/sync",
+				opts...,
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(t, test.expectedCode, statusCode, rawBody)
+
+			if statusCode != http.StatusNoContent {
+				return
+			}
+
+			signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+			var res dto.GetInvestmentsResponse
+			statusCode, rawBody, err = app.MakeRequest(
+				http.MethodGet,
+				"/api/v1/investments",
+				WithBearerToken(signInRes.AccessToken),
+				WithResponse(&res),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+			assert.Len(t, res.Investments, expectedInvestments)
+			assert.Equal(t, expectedBalance, res.TotalBalance)
+			for _, investment := range res.Investments {
+				assert.NotEqual(t, staleExternalID, investment.ExternalID)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMerchants(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	userID := uuid.MustParse("fdfdc888-da64-4988-8ad3-f739862c4ceb")
+	paymentMethodID := uuid.MustParse("5d140153-c072-42ce-b19c-c5c9b528dba4")
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	ifood, err := app.db.UpsertMerchant(
+		context.Background(),
+		sqlc.UpsertMerchantParams{
+			Key:  "brand:ifood",
+			Name: "iFood",
+			Logo: ptr.New("https://ifood.com.br/favicon.ico"),
+		},
+	)
+	assert.Nil(t, err)
+
+	uber, err := app.db.UpsertMerchant(
+		context.Background(),
+		sqlc.UpsertMerchantParams{
+			Key:  "brand:uber",
+			Name: "Uber",
+		},
+	)
+	assert.Nil(t, err)
+
+	now := time.Now()
+	_, err = app.db.CreateTransactions(
+		context.Background(),
+		[]sqlc.CreateTransactionsParams{
+			{
+				Name:            "IFOOD *IFOOD SAO PAULO BR",
+				Amount:          -5000,
+				PaymentMethodID: paymentMethodID,
+				Date:            now,
+				UserID:          userID,
+				CategoryID:      categoryID,
+				MerchantID:      &ifood.ID,
+				Status:          "POSTED",
+			},
+			{
+				Name:            "IFD*IFOOD.COM",
+				Amount:          -3000,
+				PaymentMethodID: paymentMethodID,
+				Date:            now,
+				UserID:          userID,
+				CategoryID:      categoryID,
+				MerchantID:      &ifood.ID,
+				Status:          "POSTED",
+			},
+			{
+				Name:            "UBER *TRIP HELP.UBER.COM",
+				Amount:          -2000,
+				PaymentMethodID: paymentMethodID,
+				Date:            now,
+				UserID:          userID,
+				CategoryID:      categoryID,
+				MerchantID:      &uber.ID,
+				Status:          "POSTED",
+			},
+		},
+	)
+	assert.Nil(t, err)
+
+	var out dto.ListMerchantsResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/merchants",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	if assert.Len(t, out.Items, 2) {
+		assert.Equal(t, "iFood", out.Items[0].Name)
+		assert.Equal(t, int64(-8000), out.Items[0].Expense)
+		assert.Equal(t, "Uber", out.Items[1].Name)
+		assert.Equal(t, int64(-2000), out.Items[1].Expense)
+	}
+
+	var transactionsOut dto.ListTransactionsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithQueryParams(map[string]string{"merchant_ids": uber.ID.String()}),
+		WithResponse(&transactionsOut),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	if assert.Len(t, transactionsOut.Items, 1) {
+		assert.Equal(t, "Uber", *transactionsOut.Items[0].MerchantName)
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectRecurringSeries(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	now := time.Now()
+	amounts := []int64{-5590, -5590, -5990}
+	for i, amount := range amounts {
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodPost,
+			"/api/v1/transactions",
+			WithBearerToken(signInRes.AccessToken),
+			WithBody(dto.CreateTransactionRequest{
+				CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+					Name:   "Spotify Premium",
+					Amount: amount,
+					PaymentMethodID: uuid.MustParse(
+						"5d140153-c072-42ce-b19c-c5c9b528dba4",
+					),
+					Date: now.AddDate(0, i-len(amounts)+1, 0),
+				},
+			}),
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+	}
+
+	var out dto.ListRecurringSeriesResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/recurring/detect",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	if !assert.Len(t, out.Items, 1) {
+		return
+	}
+	assert.Equal(t, "Spotify Premium", out.Items[0].Name)
+	assert.Equal(t, entity.RecurringFrequencyMonthly, out.Items[0].Frequency)
+	assert.Equal(t, int64(-5990), out.Items[0].Amount)
+	assert.True(t, out.Items[0].HasPriceIncrease)
+	assert.Zero(t, out.Items[0].MissedOccurrences)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/recurring",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, out.Items, 1)
+}
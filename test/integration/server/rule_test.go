@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/rule"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRule(t *testing.T) {
+	t.Parallel()
+
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	tests := []struct {
+		description  string
+		token        string
+		body         dto.CreateRuleRequest
+		expectedCode int
+	}{
+		{
+			description:  "fails without token",
+			token:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "fails without condition",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateRuleRequest{
+				CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+					RuleInput: rule.RuleInput{
+						Name:       "Uber",
+						CategoryID: &categoryID,
+					},
+				},
+			},
+		},
+		{
+			description:  "fails without action",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateRuleRequest{
+				CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+					RuleInput: rule.RuleInput{
+						Name:          "Uber",
+						NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+						NamePattern:   ptr.New("uber"),
+					},
+				},
+			},
+		},
+		{
+			description:  "fails with invalid regex",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateRuleRequest{
+				CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+					RuleInput: rule.RuleInput{
+						Name:          "Uber",
+						NameMatchType: ptr.New(entity.RuleNameMatchTypeRegex),
+						NamePattern:   ptr.New("uber("),
+						CategoryID:    &categoryID,
+					},
+				},
+			},
+		},
+		{
+			description:  "fails with invalid amount range",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateRuleRequest{
+				CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+					RuleInput: rule.RuleInput{
+						Name:       "Small purchases",
+						MinAmount:  ptr.New(int64(1000)),
+						MaxAmount:  ptr.New(int64(100)),
+						CategoryID: &categoryID,
+					},
+				},
+			},
+		},
+		{
+			description:  "creates rule",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusCreated,
+			body: dto.CreateRuleRequest{
+				CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+					RuleInput: rule.RuleInput{
+						Name:          "Uber",
+						Priority:      1,
+						NameMatchType: ptr.New(entity.RuleNameMatchTypeContains),
+						NamePattern:   ptr.New("uber"),
+						Direction:     ptr.New(entity.RuleDirectionExpense),
+						CategoryID:    &categoryID,
+						RenameTo:      ptr.New("Uber"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var actualResponse dto.CreateRuleResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/rules",
+				WithBearerToken(signInRes.AccessToken),
+				WithBody(test.body),
+				WithResponse(&actualResponse),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if test.expectedCode != http.StatusCreated {
+				return
+			}
+
+			assert.NotEqual(t, uuid.Nil, actualResponse.ID)
+			assert.Equal(t, signInRes.User.ID, actualResponse.UserID)
+			assert.Equal(t, test.body.Name, actualResponse.Name)
+			assert.Equal(t, test.body.Priority, actualResponse.Priority)
+			assert.Equal(t, test.body.NamePattern, actualResponse.NamePattern)
+			assert.Equal(t, test.body.CategoryID, actualResponse.CategoryID)
+			assert.Equal(t, test.body.RenameTo, actualResponse.RenameTo)
+		})
+	}
+}
+
+func TestCreateTransactionWithRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+	ruleBody := dto.CreateRuleRequest{
+		CreateRuleUseCaseInput: rule.CreateRuleUseCaseInput{
+			RuleInput: rule.RuleInput{
+				Name:          "Uber",
+				NameMatchType: ptr.New(entity.RuleNameMatchTypeRegex),
+				NamePattern:   ptr.New(`^uber\b`),
+				Direction:     ptr.New(entity.RuleDirectionExpense),
+				CategoryID:    &categoryID,
+				RenameTo:      ptr.New("Uber"),
+				IsIgnored:     ptr.New(true),
+			},
+		},
+	}
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/rules",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(ruleBody),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	transactionBody := dto.CreateTransactionRequest{
+		CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+			Name:   "UBER *TRIP HELP.UBER.COM",
+			Amount: -2590,
+			PaymentMethodID: uuid.MustParse(
+				"5d140153-c072-42ce-b19c-c5c9b528dba4",
+			),
+			Date: time.Now(),
+		},
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(transactionBody),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	transaction, err := app.db.GetLatestTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "Uber", transaction.Name)
+	assert.Equal(t, categoryID, transaction.CategoryID)
+	assert.True(t, transaction.IsIgnored)
+}
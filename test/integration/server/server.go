@@ -3,6 +3,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server"
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/middleware"
 	"github.com/danielmesquitta/api-finance-manager/internal/config"
 	"github.com/danielmesquitta/api-finance-manager/internal/config/env"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
@@ -111,6 +113,8 @@ type RequestOption func(*requestOptions)
 
 type requestOptions struct {
 	body          any
+	rawBody       []byte
+	contentType   string
 	token         string
 	bearerToken   string
 	headers       map[string]string
@@ -126,6 +130,14 @@ func WithBody(body any) RequestOption {
 	}
 }
 
+// WithRawBody sets a non JSON body for the request, such as multipart forms
+func WithRawBody(body []byte, contentType string) RequestOption {
+	return func(o *requestOptions) {
+		o.rawBody = body
+		o.contentType = contentType
+	}
+}
+
 // WithToken sets the authorization token for the request
 func WithToken(token string) RequestOption {
 	return func(o *requestOptions) {
@@ -140,6 +152,26 @@ func WithBearerToken(bearerToken string) RequestOption {
 	}
 }
 
+// WithBasicAuth sets the admin basic auth credentials for the request
+func WithBasicAuth() RequestOption {
+	credentials := base64.StdEncoding.EncodeToString(
+		[]byte(ev.BasicAuthUsername + ":" + ev.BasicAuthPassword),
+	)
+	return func(o *requestOptions) {
+		o.token = "Basic " + credentials
+	}
+}
+
+// WithWebhookSecret sets the open finance webhook secret for the request
+func WithWebhookSecret() RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = map[string]string{}
+		}
+		o.headers[middleware.HeaderWebhookSecret] = ev.OpenFinanceWebhookSecret
+	}
+}
+
 // WithHeaders sets additional headers for the request
 func WithHeaders(headers map[string]string) RequestOption {
 	return func(o *requestOptions) {
@@ -187,6 +219,12 @@ func (ta *TestApp) MakeRequest(
 		}
 	}
 
+	contentType := fiber.MIMEApplicationJSON
+	if options.rawBody != nil {
+		jsonBody = options.rawBody
+		contentType = options.contentType
+	}
+
 	u := &url.URL{Path: path}
 	if len(options.queryParams) > 0 {
 		v := url.Values{}
@@ -202,7 +240,7 @@ func (ta *TestApp) MakeRequest(
 		bytes.NewReader(jsonBody),
 	)
 
-	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	req.Header.Set(fiber.HeaderContentType, contentType)
 	req.Header.Set(fiber.HeaderAccept, fiber.MIMEApplicationJSON)
 
 	if options.token != "" {
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/handler"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/tag"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description  string
+		token        string
+		body         dto.CreateTagRequest
+		expectedCode int
+	}{
+		{
+			description:  "fails without token",
+			token:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "fails without name",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "creates tag",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusCreated,
+			body: dto.CreateTagRequest{
+				CreateTagUseCaseInput: tag.CreateTagUseCaseInput{
+					TagInput: tag.TagInput{
+						Name: "Viagem",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var actualResponse dto.CreateTagResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/tags",
+				WithBearerToken(signInRes.AccessToken),
+				WithBody(test.body),
+				WithResponse(&actualResponse),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if test.expectedCode != http.StatusCreated {
+				return
+			}
+
+			assert.NotEqual(t, uuid.Nil, actualResponse.ID)
+			assert.Equal(t, signInRes.User.ID, actualResponse.UserID)
+			assert.Equal(t, test.body.Name, actualResponse.Name)
+
+			statusCode, rawBody, err = app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/tags",
+				WithBearerToken(signInRes.AccessToken),
+				WithBody(test.body),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+		})
+	}
+}
+
+func TestListTransactionsByTag(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	var tagResponse dto.CreateTagResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/tags",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTagRequest{
+			CreateTagUseCaseInput: tag.CreateTagUseCaseInput{
+				TagInput: tag.TagInput{
+					Name: "Viagem",
+				},
+			},
+		}),
+		WithResponse(&tagResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Hotel",
+				Amount: -50000,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date:   time.Now(),
+				Notes:  ptr.New("Hospedagem em Florianópolis"),
+				TagIDs: []uuid.UUID{tagResponse.ID},
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	tests := []struct {
+		description string
+		queryParams map[string]string
+	}{
+		{
+			description: "filters transactions by tag id",
+			queryParams: map[string]string{
+				handler.QueryParamTagIDs: tagResponse.ID.String(),
+			},
+		},
+		{
+			description: "searches transactions by notes",
+			queryParams: map[string]string{
+				handler.QueryParamSearch: "Hospedagem em Florianópolis",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		var out dto.ListTransactionsResponse
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodGet,
+			"/api/v1/transactions",
+			WithQueryParams(test.queryParams),
+			WithBearerToken(signInRes.AccessToken),
+			WithResponse(&out),
+		)
+		assert.Nil(t, err, test.description)
+		assert.Equal(t, http.StatusOK, statusCode, test.description, rawBody)
+
+		if !assert.Len(t, out.Items, 1, test.description) {
+			continue
+		}
+		assert.Equal(t, "Hotel", out.Items[0].Name, test.description)
+		assert.Len(t, out.Items[0].Tags, 1, test.description)
+	}
+}
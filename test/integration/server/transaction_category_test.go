@@ -4,10 +4,15 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/handler"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transactioncategory"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -129,3 +134,209 @@ func TestListTransactionCategories(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTransactionCategory(t *testing.T) {
+	t.Parallel()
+
+	parentID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	tests := []struct {
+		description  string
+		token        string
+		body         dto.CreateTransactionCategoryRequest
+		expectedCode int
+	}{
+		{
+			description:  "fails without token",
+			token:        "",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description:  "fails without name",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateTransactionCategoryRequest{
+				CreateTransactionCategoryUseCaseInput: transactioncategory.CreateTransactionCategoryUseCaseInput{
+					TransactionCategoryInput: transactioncategory.TransactionCategoryInput{
+						Color: ptr.New("#ff5733"),
+					},
+				},
+			},
+		},
+		{
+			description:  "fails with invalid color",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateTransactionCategoryRequest{
+				CreateTransactionCategoryUseCaseInput: transactioncategory.CreateTransactionCategoryUseCaseInput{
+					TransactionCategoryInput: transactioncategory.TransactionCategoryInput{
+						Name:  "Pets",
+						Color: ptr.New("red"),
+					},
+				},
+			},
+		},
+		{
+			description:  "fails with unknown parent",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusBadRequest,
+			body: dto.CreateTransactionCategoryRequest{
+				CreateTransactionCategoryUseCaseInput: transactioncategory.CreateTransactionCategoryUseCaseInput{
+					TransactionCategoryInput: transactioncategory.TransactionCategoryInput{
+						Name:     "Pets",
+						ParentID: ptr.New(uuid.New()),
+					},
+				},
+			},
+		},
+		{
+			description:  "creates category",
+			token:        mockoauth.PremiumTierMockToken,
+			expectedCode: http.StatusCreated,
+			body: dto.CreateTransactionCategoryRequest{
+				CreateTransactionCategoryUseCaseInput: transactioncategory.CreateTransactionCategoryUseCaseInput{
+					TransactionCategoryInput: transactioncategory.TransactionCategoryInput{
+						Name:     "Pets",
+						Icon:     ptr.New("paw"),
+						Color:    ptr.New("#ff5733"),
+						ParentID: &parentID,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := &dto.SignInResponse{}
+			if test.token != "" {
+				signInRes = app.SignIn(test.token)
+			}
+
+			var actualResponse dto.CreateTransactionCategoryResponse
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodPost,
+				"/api/v1/transactions/categories",
+				WithBearerToken(signInRes.AccessToken),
+				WithBody(test.body),
+				WithResponse(&actualResponse),
+			)
+			assert.Nil(t, err)
+
+			assert.Equal(
+				t,
+				test.expectedCode,
+				statusCode,
+				rawBody,
+			)
+
+			if test.expectedCode != http.StatusCreated {
+				return
+			}
+
+			assert.NotEqual(t, uuid.Nil, actualResponse.ID)
+			assert.Equal(t, &signInRes.User.ID, actualResponse.UserID)
+			assert.Equal(t, test.body.Name, actualResponse.Name)
+			assert.Equal(t, test.body.Icon, actualResponse.Icon)
+			assert.Equal(t, test.body.Color, actualResponse.Color)
+			assert.Equal(t, test.body.ParentID, actualResponse.ParentID)
+		})
+	}
+}
+
+func TestMergeTransactionCategories(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	sourceID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	var category dto.CreateTransactionCategoryResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions/categories",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionCategoryRequest{
+			CreateTransactionCategoryUseCaseInput: transactioncategory.CreateTransactionCategoryUseCaseInput{
+				TransactionCategoryInput: transactioncategory.TransactionCategoryInput{
+					Name: "Casa",
+				},
+			},
+		}),
+		WithResponse(&category),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Aluguel",
+				Amount: -150000,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				CategoryID: &sourceID,
+				Date:       time.Now(),
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions/categories/"+sourceID.String()+"/merge",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.MergeTransactionCategoriesRequest{
+			MergeTransactionCategoriesUseCaseInput: transactioncategory.MergeTransactionCategoriesUseCaseInput{
+				TargetID: category.ID,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	transaction, err := app.db.GetLatestTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, category.ID, transaction.CategoryID)
+
+	var hidden dto.ListTransactionCategoriesResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions/categories",
+		WithQueryParams(map[string]string{
+			handler.QueryParamIsHidden: "true",
+		}),
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&hidden),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	assert.Len(t, hidden.Items, 1)
+	for _, c := range hidden.Items {
+		assert.Equal(t, sourceID, c.ID)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/danielmesquitta/api-finance-manager/internal/app/server/handler"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
 	"github.com/danielmesquitta/api-finance-manager/internal/domain/usecase/transaction"
+	"github.com/danielmesquitta/api-finance-manager/internal/pkg/ptr"
 	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -424,6 +425,12 @@ func TestUpdateTransaction(t *testing.T) {
 					AccountID:       &accountID,
 					InstitutionID:   &institutionID,
 					CategoryID:      categoryID,
+
+					IsNameOverridden:          true,
+					IsAmountOverridden:        true,
+					IsDateOverridden:          true,
+					IsCategoryOverridden:      true,
+					IsPaymentMethodOverridden: true,
 				},
 			}
 		}(),
@@ -465,6 +472,12 @@ func TestUpdateTransaction(t *testing.T) {
 					AccountID:       &accountID,
 					InstitutionID:   &institutionID,
 					CategoryID:      categoryID,
+
+					IsNameOverridden:          true,
+					IsAmountOverridden:        true,
+					IsDateOverridden:          true,
+					IsCategoryOverridden:      false,
+					IsPaymentMethodOverridden: true,
 				},
 			}
 		}(),
@@ -551,6 +564,31 @@ func TestUpdateTransaction(t *testing.T) {
 				test.expectedTransaction.InstitutionID.String(),
 				actualTransaction.InstitutionID.String(),
 			)
+			assert.Equal(
+				t,
+				test.expectedTransaction.IsNameOverridden,
+				actualTransaction.IsNameOverridden,
+			)
+			assert.Equal(
+				t,
+				test.expectedTransaction.IsAmountOverridden,
+				actualTransaction.IsAmountOverridden,
+			)
+			assert.Equal(
+				t,
+				test.expectedTransaction.IsDateOverridden,
+				actualTransaction.IsDateOverridden,
+			)
+			assert.Equal(
+				t,
+				test.expectedTransaction.IsCategoryOverridden,
+				actualTransaction.IsCategoryOverridden,
+			)
+			assert.Equal(
+				t,
+				test.expectedTransaction.IsPaymentMethodOverridden,
+				actualTransaction.IsPaymentMethodOverridden,
+			)
 		})
 	}
 }
@@ -677,3 +715,602 @@ func TestCreateTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitTransaction(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+	otherCategoryID := uuid.MustParse("40086b51-ac58-47c7-9f14-684346af9012")
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Supermercado",
+				Amount: -10000,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date:       time.Now(),
+				CategoryID: &categoryID,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	createdTransaction, err := app.db.GetLatestTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+
+	tests := []struct {
+		description  string
+		splits       []transaction.SplitTransactionUseCaseSplitInput
+		expectedCode int
+	}{
+		{
+			description: "fails with a single part",
+			splits: []transaction.SplitTransactionUseCaseSplitInput{
+				{Amount: -10000, CategoryID: categoryID},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "fails when parts do not sum to the transaction",
+			splits: []transaction.SplitTransactionUseCaseSplitInput{
+				{Amount: -6000, CategoryID: categoryID},
+				{Amount: -3000, CategoryID: otherCategoryID},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "fails with parts of the opposite sign",
+			splits: []transaction.SplitTransactionUseCaseSplitInput{
+				{Amount: -12000, CategoryID: categoryID},
+				{Amount: 2000, CategoryID: otherCategoryID},
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "fails with unknown category",
+			splits: []transaction.SplitTransactionUseCaseSplitInput{
+				{Amount: -6000, CategoryID: categoryID},
+				{Amount: -4000, CategoryID: uuid.New()},
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			description: "splits transaction",
+			splits: []transaction.SplitTransactionUseCaseSplitInput{
+				{Amount: -6000, CategoryID: categoryID},
+				{
+					Amount:     -4000,
+					CategoryID: otherCategoryID,
+					Note:       ptr.New("Farmácia"),
+				},
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		var actualResponse dto.SplitTransactionResponse
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodPost,
+			"/api/v1/transactions/"+createdTransaction.ID.String()+"/splits",
+			WithBearerToken(signInRes.AccessToken),
+			WithBody(dto.SplitTransactionRequest{
+				SplitTransactionUseCaseInput: transaction.SplitTransactionUseCaseInput{
+					Splits: test.splits,
+				},
+			}),
+			WithResponse(&actualResponse),
+		)
+		assert.Nil(t, err, test.description)
+		assert.Equal(t, test.expectedCode, statusCode, test.description, rawBody)
+
+		if test.expectedCode != http.StatusOK {
+			continue
+		}
+
+		assert.Len(t, actualResponse.Splits, len(test.splits))
+		for i, split := range actualResponse.Splits {
+			assert.Equal(t, test.splits[i].Amount, split.Amount)
+			assert.Equal(t, test.splits[i].CategoryID, split.CategoryID)
+			assert.Equal(t, test.splits[i].Note, split.Note)
+		}
+	}
+
+	var getResponse dto.GetTransactionResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions/"+createdTransaction.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&getResponse),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, getResponse.Splits, 2)
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Padaria",
+				Amount: -2500,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date:       time.Now(),
+				CategoryID: &categoryID,
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	createdTransaction, err := app.db.GetLatestTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+	transactionPath := "/api/v1/transactions/" + createdTransaction.ID.String()
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		transactionPath+"/hidden",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		transactionPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		transactionPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode, rawBody)
+
+	var trashOut dto.ListDeletedTransactionsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions/trash",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&trashOut),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	if assert.Len(t, trashOut.Items, 1) {
+		assert.Equal(t, createdTransaction.ID, trashOut.Items[0].ID)
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions/trash/"+createdTransaction.ID.String()+"/restore",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		transactionPath,
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions/trash",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&trashOut),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Empty(t, trashOut.Items)
+}
+
+func TestPurgeDeletedTransactions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.CreateTransactionRequest{
+			CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+				Name:   "Padaria",
+				Amount: -2500,
+				PaymentMethodID: uuid.MustParse(
+					"5d140153-c072-42ce-b19c-c5c9b528dba4",
+				),
+				Date: time.Now(),
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+	manualTransaction, err := app.db.GetLatestTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		"/api/v1/transactions/"+manualTransaction.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	syncedTransaction, err := app.db.GetLatestSyncedTransactionByUserID(
+		ctx,
+		signInRes.User.ID.String(),
+	)
+	assert.Nil(t, err)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		"/api/v1/transactions/"+syncedTransaction.ID.String()+"/hidden",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	err = app.db.PurgeDeletedTransactions(
+		ctx,
+		ptr.New(time.Now().Add(time.Minute)),
+	)
+	assert.Nil(t, err)
+
+	_, err = app.db.GetDeletedTransactionByID(ctx, manualTransaction.ID)
+	assert.NotNil(t, err)
+
+	hiddenTransaction, err := app.db.GetDeletedTransactionByID(
+		ctx,
+		syncedTransaction.ID,
+	)
+	assert.Nil(t, err)
+	assert.True(t, hiddenTransaction.IsHidden)
+
+	hiddenExternalIDs, err := app.db.ListHiddenTransactionExternalIDs(
+		ctx,
+		signInRes.User.ID,
+	)
+	assert.Nil(t, err)
+	assert.Contains(t, hiddenExternalIDs, *syncedTransaction.ExternalID)
+}
+
+func TestBulkUpdateTransactions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+
+	ids := []uuid.UUID{}
+	for _, name := range []string{"Lanchonete", "Sorveteria"} {
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodPost,
+			"/api/v1/transactions",
+			WithBearerToken(signInRes.AccessToken),
+			WithBody(dto.CreateTransactionRequest{
+				CreateTransactionUseCaseInput: transaction.CreateTransactionUseCaseInput{
+					Name:   name,
+					Amount: -1500,
+					PaymentMethodID: uuid.MustParse(
+						"5d140153-c072-42ce-b19c-c5c9b528dba4",
+					),
+					Date:       time.Now(),
+					CategoryID: &categoryID,
+				},
+			}),
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, statusCode, rawBody)
+
+		createdTransaction, err := app.db.GetLatestTransactionByUserID(
+			ctx,
+			signInRes.User.ID.String(),
+		)
+		assert.Nil(t, err)
+		ids = append(ids, createdTransaction.ID)
+	}
+
+	tests := []struct {
+		description      string
+		in               transaction.BulkUpdateTransactionsUseCaseInput
+		queryParams      map[string]string
+		expectedCode     int
+		expectedMatched  int
+		expectedAffected int
+	}{
+		{
+			description: "fails without selector",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "fails with transactions of other users",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+				IDs:       []uuid.UUID{ids[0], ids[1], uuid.New()},
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			description: "counts transactions in dry-run",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+				IDs:       ids,
+				DryRun:    true,
+			},
+			expectedCode:     http.StatusOK,
+			expectedMatched:  2,
+			expectedAffected: 2,
+		},
+		{
+			description: "ignores transactions by ids",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+				IDs:       ids,
+			},
+			expectedCode:     http.StatusOK,
+			expectedMatched:  2,
+			expectedAffected: 2,
+		},
+		{
+			description: "skips transactions already ignored",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+				IDs:       ids,
+			},
+			expectedCode:     http.StatusOK,
+			expectedMatched:  2,
+			expectedAffected: 0,
+		},
+		{
+			description: "deletes transactions by filters",
+			in: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationDelete,
+			},
+			queryParams: map[string]string{
+				"is_ignored": "true",
+				"search":     "Lanchonete",
+			},
+			expectedCode:     http.StatusOK,
+			expectedMatched:  1,
+			expectedAffected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		var out dto.BulkUpdateTransactionsResponse
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodPatch,
+			"/api/v1/transactions",
+			WithBearerToken(signInRes.AccessToken),
+			WithBody(dto.BulkUpdateTransactionsRequest{
+				BulkUpdateTransactionsUseCaseInput: test.in,
+			}),
+			WithQueryParams(test.queryParams),
+			WithResponse(&out),
+		)
+		assert.Nil(t, err, test.description)
+		assert.Equal(t, test.expectedCode, statusCode, test.description, rawBody)
+
+		if test.expectedCode != http.StatusOK {
+			continue
+		}
+
+		assert.Equal(t, test.expectedMatched, out.Matched, test.description)
+		assert.Equal(t, test.expectedAffected, out.Affected, test.description)
+		assert.Equal(t, test.in.DryRun, out.DryRun, test.description)
+	}
+}
+
+func TestBulkIgnoreTransactionsKeepsAfterSync(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+	userID := signInRes.User.ID.String()
+
+	syncTransactions := func() bool {
+		statusCode, rawBody, err := app.MakeRequest(
+			http.MethodPost,
+			"/api/v1/admin/transactions/sync",
+			WithBasicAuth(),
+			WithQueryParams(map[string]string{
+				handler.QueryParamUserIDs: userID,
+			}),
+		)
+		assert.Nil(t, err)
+		return assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+	}
+
+	if !syncTransactions() {
+		return
+	}
+
+	syncedTransaction, err := app.db.GetLatestSyncedTransactionByUserID(
+		ctx,
+		userID,
+	)
+	if !assert.Nil(t, err) || !assert.False(t, syncedTransaction.IsIgnored) {
+		return
+	}
+
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPatch,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithBody(dto.BulkUpdateTransactionsRequest{
+			BulkUpdateTransactionsUseCaseInput: transaction.BulkUpdateTransactionsUseCaseInput{
+				Operation: entity.BulkTransactionOperationSetIgnored,
+				IsIgnored: ptr.New(true),
+				IDs:       []uuid.UUID{syncedTransaction.ID},
+			},
+		}),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	if !syncTransactions() {
+		return
+	}
+
+	var out dto.GetTransactionResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions/"+syncedTransaction.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.True(t, out.IsIgnored)
+	assert.True(t, out.IsCategoryOverridden)
+}
+
+func TestExportTransactions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		description      string
+		queryParams      map[string]string
+		expectedCode     int
+		expectedContents []string
+	}{
+		{
+			description:  "fails with an unsupported format",
+			queryParams:  map[string]string{handler.QueryParamFormat: "pdf"},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			description: "exports filtered transactions as CSV",
+			queryParams: map[string]string{
+				handler.QueryParamFormat:           string(entity.ExportFormatCSV),
+				handler.QueryParamPaymentMethodIDs: "5d140153-c072-42ce-b19c-c5c9b528dba4",
+			},
+			expectedCode: http.StatusOK,
+			expectedContents: []string{
+				"Data;Descrição;Valor;Categoria;Forma de pagamento;Instituição\n",
+			},
+		},
+		{
+			description: "exports transactions as OFX",
+			queryParams: map[string]string{
+				handler.QueryParamFormat: string(entity.ExportFormatOFX),
+			},
+			expectedCode:     http.StatusOK,
+			expectedContents: []string{"<OFX>", "<STMTTRN>", "</OFX>"},
+		},
+		{
+			description: "exports transactions as XLSX",
+			queryParams: map[string]string{
+				handler.QueryParamFormat: string(entity.ExportFormatXLSX),
+			},
+			expectedCode:     http.StatusOK,
+			expectedContents: []string{"PK", "xl/worksheets/sheet1.xml"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			app, cleanUp := NewTestApp(t)
+			defer func() {
+				err := cleanUp(context.Background())
+				assert.Nil(t, err)
+			}()
+
+			signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+			statusCode, rawBody, err := app.MakeRequest(
+				http.MethodGet,
+				"/api/v1/transactions/export",
+				WithQueryParams(test.queryParams),
+				WithBearerToken(signInRes.AccessToken),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedCode, statusCode, rawBody)
+
+			for _, expected := range test.expectedContents {
+				assert.Contains(t, rawBody, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielmesquitta/api-finance-manager/internal/app/server/dto"
+	"github.com/danielmesquitta/api-finance-manager/internal/domain/entity"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/db/sqlc"
+	"github.com/danielmesquitta/api-finance-manager/internal/provider/oauth/mockoauth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransfers(t *testing.T) {
+	t.Parallel()
+
+	app, cleanUp := NewTestApp(t)
+	defer func() {
+		err := cleanUp(context.Background())
+		assert.Nil(t, err)
+	}()
+
+	signInRes := app.SignIn(mockoauth.PremiumTierMockToken)
+
+	userID := uuid.MustParse("fdfdc888-da64-4988-8ad3-f739862c4ceb")
+	paymentMethodID := uuid.MustParse("5d140153-c072-42ce-b19c-c5c9b528dba4")
+	categoryID := uuid.MustParse("373b150b-94bd-44b2-abdd-2aab14e74fad")
+	nubankAccountID := uuid.MustParse("e5f31705-cb65-42a5-9072-2b9b59e338a8")
+	btgAccountID := uuid.MustParse("c3894f46-33a6-47cd-85cf-ceaf4bb10895")
+
+	now := time.Now()
+	_, err := app.db.CreateTransactions(
+		context.Background(),
+		[]sqlc.CreateTransactionsParams{
+			{
+				Name:            "Transferência enviada|John Doe",
+				Amount:          -123456,
+				PaymentMethodID: paymentMethodID,
+				Date:            now.AddDate(0, 0, -2),
+				UserID:          userID,
+				AccountID:       &nubankAccountID,
+				CategoryID:      categoryID,
+				Status:          "POSTED",
+			},
+			{
+				Name:            "Transferência recebida|John Doe",
+				Amount:          123456,
+				PaymentMethodID: paymentMethodID,
+				Date:            now.AddDate(0, 0, -1),
+				UserID:          userID,
+				AccountID:       &btgAccountID,
+				CategoryID:      categoryID,
+				Status:          "POSTED",
+			},
+		},
+	)
+	assert.Nil(t, err)
+
+	var out dto.ListTransfersResponse
+	statusCode, rawBody, err := app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transfers/match",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+
+	if !assert.Len(t, out.Items, 1) {
+		return
+	}
+	transfer := out.Items[0]
+	assert.Equal(t, entity.TransferStatusMatched, transfer.Status)
+	assert.Equal(t, int64(-123456), transfer.OutgoingTransaction.Amount)
+	assert.Equal(t, int64(123456), transfer.IncomingTransaction.Amount)
+
+	var transactionsOut dto.ListTransactionsResponse
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transactions",
+		WithBearerToken(signInRes.AccessToken),
+		WithQueryParams(map[string]string{"is_transfer": "true"}),
+		WithResponse(&transactionsOut),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Len(t, transactionsOut.Items, 2)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		"/api/v1/transfers/"+transfer.ID.String()+"/confirmed",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodGet,
+		"/api/v1/transfers",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	if assert.Len(t, out.Items, 1) {
+		assert.Equal(t, entity.TransferStatusConfirmed, out.Items[0].Status)
+	}
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodDelete,
+		"/api/v1/transfers/"+transfer.ID.String(),
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode, rawBody)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPost,
+		"/api/v1/transfers/match",
+		WithBearerToken(signInRes.AccessToken),
+		WithResponse(&out),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode, rawBody)
+	assert.Empty(t, out.Items)
+
+	statusCode, rawBody, err = app.MakeRequest(
+		http.MethodPut,
+		"/api/v1/transfers/"+transfer.ID.String()+"/confirmed",
+		WithBearerToken(signInRes.AccessToken),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode, rawBody)
+}